@@ -222,6 +222,16 @@ rpc:
   # The default value is 60, recommend set it to 60
   sync_tx_result_timeout: 60
 
+  # Readiness thresholds of /readyz and the grpc health service, 0 disables a check.
+  # UpdateDebugConfig with the HealthThresholds key overrides them until a restart.
+  health:
+    # Most blocks the chain may be behind the highest peer
+    max_sync_lag: 10
+    # Most seconds since the last committed block
+    max_block_idle_seconds: 0
+    # Most ratio of the common txs to the capacity of the tx pool
+    max_tx_pool_fullness: 0.95
+
   # restful api gateway
   gateway:
     # enable restful api
@@ -289,11 +299,43 @@ rpc:
   max_send_msg_size: 100
   max_recv_msg_size: 100
 
+# Event subscriber settings, the dispatch queues of the block and contract events
+subscriber:
+  # Capacity of the queue of each event type
+  queue_size: 1024
+  # What to do when a queue is full: drop_oldest, disconnect the slow subscribers, or block,
+  # which holds the commit of the blocks until the slow subscribers catch up
+  overflow_policy: drop_oldest
+  # Only for disconnect, how long a subscriber may take to receive one event
+  slow_subscriber_timeout: 10s
+
+# Block sync settings, the absent ones use the defaults
+sync:
+  # Number of blocks requested from a peer in a request
+  batch_size_from_one_node: 8
+  # Byte budget of a block sync response, the consecutive blocks within it are sent in a message,
+  # 0 for a block per message
+  max_batch_bytes: 4194304
+  # Number of the outstanding requests to each peer, 0 for a request per scheduling
+  pipeline_windows_per_peer: 2
+  # Strategy to select the peer which the blocks are requested from,
+  # least_loaded, latency_weighted, random or preference_first
+  peer_select_strategy: least_loaded
+
 tx_filter:
-  # default(store) 0; bird's nest 1; map 2; 3 sharding bird's nest
+  # default(store) 0; bird's nest 1; map 2; 3 sharding bird's nest; 4 map window
   # 3 is recommended.
   type: 0
 
+  # map window config, only for type 4
+  map_window:
+    # Keep the tx ids of the last block_window blocks in memory, 0 for no limit
+    block_window: 100000
+    # Number of blocks between two checkpoints under <store_path>/<chain_id>/txfilter_map
+    snapshot_interval: 100
+    # Number of the concurrent store lookups for the tx ids out of the window, 0 for no limit
+    max_store_lookups: 64
+
   # sharding bird's nest config
   # total keys = sharding.length * sharding.birds_nest.length * sharding.birds_nest.cuckoo.max_num_keys
   sharding:
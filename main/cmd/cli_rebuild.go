@@ -32,17 +32,59 @@ func RebuildDbsCMD() *cobra.Command {
 		Long:  "RebuildDbs ChainMaker",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			initLocalConfig(cmd)
-			backupDbs(rebuildChainId, needVerify)
-			rebuildDbsStart()
+			checkpointFile := rebuildCheckpointFile(rebuildChainId)
+			if resumeRebuild {
+				needVerify = resumeDbs(rebuildChainId, needVerify, cmd.Flags().Changed(flagNameOfNeedVerify),
+					checkpointFile)
+			} else {
+				backupDbs(rebuildChainId, needVerify, checkpointFile)
+			}
+			report := rebuildDbsStart(&blockchain.RebuildOptions{
+				NeedVerify:      needVerify,
+				ContinueOnError: continueOnError,
+				CheckpointFile:  checkpointFile,
+			})
 			fmt.Println("ChainMaker exit")
+			if report == nil {
+				os.Exit(1)
+			}
+			file := reportFile
+			if file == "" {
+				file = fmt.Sprintf("rebuild-dbs-%s.json", rebuildChainId)
+			}
+			if err := report.WriteFile(file); err != nil {
+				fmt.Printf("write rebuild report failed, %s\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("rebuild report: %s, committed height: %d, target height: %d, success: %v\n",
+				file, report.CommittedHeight, report.TargetHeight, report.Success)
+			if !report.Success {
+				os.Exit(1)
+			}
+			// the rebuild is done, there is nothing to resume, and the next rebuild must not be refused
+			if err := os.Remove(checkpointFile); err != nil && !os.IsNotExist(err) {
+				fmt.Printf("remove rebuild checkpoint(%s) failed, %s\n", checkpointFile, err)
+			}
 			return nil
 		},
 	}
-	attachFlags(rebuildDbsCmd, []string{flagNameOfConfigFilepath, flagNameOfChainId, flagNameOfNeedVerify})
+	attachFlags(rebuildDbsCmd, []string{flagNameOfConfigFilepath, flagNameOfChainId, flagNameOfNeedVerify,
+		flagNameOfResume, flagNameOfContinueOnError, flagNameOfReportFile})
 	return rebuildDbsCmd
 }
 
-func backupDbs(chainId string, needVerify bool) {
+func backupDbs(chainId string, needVerify bool, checkpointFile string) {
+	config := &conf.StorageConfig{}
+	if err := mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	// the stores without a backup provider, such as tikvdb and sql, can not be rebuilt
+	if err := blockchain.ValidateDbBackupConfig(config); err != nil {
+		fmt.Printf("rebuild-dbs is not supported by the storage config, %s\n", err)
+		os.Exit(1)
+	}
+
 	timeS := strconv.FormatInt(time.Now().UnixNano(), 10)
 	localconf.ChainMakerConfig.StorageConfig["back_path"] = timeS
 	localconf.ChainMakerConfig.StorageConfig["rebuild_chainId"] = chainId
@@ -53,54 +95,76 @@ func backupDbs(chainId string, needVerify bool) {
 		panic(err)
 	}
 
-	config := &conf.StorageConfig{}
-	errThenExit(mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config))
+	if isExists, _ := pathExists(checkpointFile); isExists {
+		fmt.Printf("rebuild checkpoint(%s) is exists, use --%s to resume it or remove it first!\n",
+			checkpointFile, flagNameOfResume)
+		os.Exit(1)
+	}
 
-	if config.BlockDbConfig.Provider != "leveldb" {
-		fmt.Println("Unsupported storage type")
-		os.Exit(0)
+	// every store is snapshotted through its provider and verified before the live data is cleared,
+	// a failure leaves the live data untouched
+	manifest, err := blockchain.BackupDbs(chainId, timeS, config)
+	if err != nil {
+		fmt.Printf("backup dbs failed, %s\n", err)
+		os.Exit(1)
 	}
-	oldStorePath :=
-		path.Join(config.BlockDbConfig.LevelDbConfig["store_path"].(string), chainId) + "-" + timeS
-	isExists, s := pathExists(oldStorePath)
-	if s != "" {
-		fmt.Println(s)
-		os.Exit(0)
+	fmt.Printf("backup dbs of chain %s success, backup id: %s, stores: %d\n",
+		chainId, manifest.BackupId, len(manifest.Stores))
+
+	// remember where the old dbs are, so that an interrupted rebuild can be resumed
+	checkpoint := &blockchain.RebuildCheckpoint{
+		ChainId:    chainId,
+		BackPath:   timeS,
+		NeedVerify: needVerify,
 	}
-	if isExists {
-		fmt.Printf(
-			"back file(%s) is exists!\n",
-			oldStorePath,
-		)
-		os.Exit(0)
+	if err = checkpoint.Save(checkpointFile); err != nil {
+		fmt.Printf("save rebuild checkpoint(%s) failed, %s\n", checkpointFile, err)
+		os.Exit(1)
 	}
+}
 
-	backupDir(config.BlockDbConfig.LevelDbConfig["store_path"].(string), timeS, chainId)
-	backupDir(config.StateDbConfig.LevelDbConfig["store_path"].(string), timeS, chainId)
-	backupDir(config.ResultDbConfig.LevelDbConfig["store_path"].(string), timeS, chainId)
-	backupDir(config.HistoryDbConfig.LevelDbConfig["store_path"].(string), timeS, chainId)
-
-	if config.TxExistDbConfig != nil {
-		backupDir(config.TxExistDbConfig.LevelDbConfig["store_path"].(string), timeS, chainId)
+// resumeDbs reuse the backup recorded in the checkpoint instead of backing up the dbs again,
+// the new store keeps the blocks committed before the interruption. The resumed rebuild verifies the
+// blocks as the interrupted one did, need-verify may only be given again with the same value.
+func resumeDbs(chainId string, needVerify, needVerifySet bool, checkpointFile string) bool {
+	checkpoint, err := blockchain.LoadRebuildCheckpoint(checkpointFile)
+	if err != nil {
+		fmt.Printf("load rebuild checkpoint failed, %s\n", err)
+		os.Exit(1)
+	}
+	if checkpoint.ChainId != chainId {
+		fmt.Printf("rebuild checkpoint(%s) belongs to chain %s, not %s\n",
+			checkpointFile, checkpoint.ChainId, chainId)
+		os.Exit(1)
+	}
+	if needVerifySet && needVerify != checkpoint.NeedVerify {
+		fmt.Printf("rebuild checkpoint(%s) was made with --%s=%v, it can not be resumed with %v\n",
+			checkpointFile, flagNameOfNeedVerify, checkpoint.NeedVerify, needVerify)
+		os.Exit(1)
 	}
+	fmt.Printf("resume rebuild-dbs of chain %s from checkpoint, back path: %s, committed height: %d, "+
+		"need verify: %v\n", chainId, checkpoint.BackPath, checkpoint.CommittedHeight, checkpoint.NeedVerify)
 
-	backupDir(config.StorePath, timeS, chainId)
-}
+	localconf.ChainMakerConfig.StorageConfig["back_path"] = checkpoint.BackPath
+	localconf.ChainMakerConfig.StorageConfig["rebuild_chainId"] = chainId
+	localconf.ChainMakerConfig.StorageConfig["need_verify"] = checkpoint.NeedVerify
 
-func backupDir(oldPath, timeS, chainId string) {
-	newPath := oldPath + "-" + timeS
-	errThenExit(os.Mkdir(newPath, os.ModePerm))
-	errThenExit(os.Rename(path.Join(oldPath, chainId), path.Join(newPath, chainId)))
-	errThenExit(os.RemoveAll(path.Join(oldPath, chainId)))
+	if err = setNodeId(); err != nil {
+		fmt.Println("set node id failed")
+		panic(err)
+	}
+	return checkpoint.NeedVerify
 }
 
-func errThenExit(err error) func() {
-	return func() {
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(0)
-		}
+// rebuildCheckpointFile the checkpoint is kept beside the chain directory of the store path,
+// so it survives the chain directory being moved by the backup.
+func rebuildCheckpointFile(chainId string) string {
+	config := &conf.StorageConfig{}
+	if err := mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
+	return path.Join(config.StorePath, fmt.Sprintf("rebuild-dbs-%s.checkpoint", chainId))
 }
 
 // pathExists is used to determine whether a file or folder exists
@@ -118,7 +182,7 @@ func pathExists(path string) (bool, string) {
 	return false, err.Error()
 }
 
-func rebuildDbsStart() {
+func rebuildDbsStart(opts *blockchain.RebuildOptions) *blockchain.RebuildReport {
 	if localconf.ChainMakerConfig.DebugConfig.IsTraceMemoryUsage {
 		traceMemoryUsage()
 	}
@@ -127,63 +191,47 @@ func rebuildDbsStart() {
 	chainMakerServer := blockchain.NewChainMakerServer()
 
 	chainId, _ := localconf.ChainMakerConfig.StorageConfig["rebuild_chainId"].(string)
-	needVeri, _ := localconf.ChainMakerConfig.StorageConfig["need_verify"].(bool)
 	if err := chainMakerServer.InitForRebuildDbs(chainId); err != nil {
 		log.Errorf("chainmaker server init failed, %s", err.Error())
-		return
+		return nil
 	}
 
-	// init rpc server
-	//rpcServer, err := rpcserver.NewRPCServer(chainMakerServer)
-	//if err != nil {
-	//	log.Errorf("rpc server init failed, %s", err.Error())
-	//	return
-	//}
-
-	// init monitor server
-	//monitorServer := monitor.NewMonitorServer()
-
-	//// p2p callback to validate
-	//txpool.RegisterCallback(rpcServer.Gateway().Invoke)
-
 	// new an error channel to receive errors
 	errorC := make(chan error, 1)
 
 	// handle exit signal in separate go routines
 	go handleExitSignal(errorC)
 
+	// the rebuild stops after the block being processed once stopC is closed
+	stopC := make(chan struct{})
+	opts.StopC = stopC
+
 	// start blockchains in separate go routines
-	if err := chainMakerServer.StartForRebuildDbs(needVeri); err != nil {
+	reportC, err := chainMakerServer.StartForRebuildDbs(opts)
+	if err != nil {
 		log.Errorf("chainmaker server startup failed, %s", err.Error())
-		return
+		return nil
 	}
 
-	// start rpc server and listen in another go routine
-	//if err := rpcServer.Start(); err != nil {
-	//	errorC <- err
-	//}
-
-	// start monitor server and listen in another go routine
-	//if err := monitorServer.Start(); err != nil {
-	//	errorC <- err
-	//}
-
 	if localconf.ChainMakerConfig.PProfConfig.Enabled {
 		startPProf()
 	}
 
-	//printLogo()
-
-	// listen error signal in main function
-	errC := <-errorC
-	if errC != nil {
-		log.Error("chainmaker encounters error ", errC)
+	// wait for the rebuild to finish, an exit signal interrupts it at a block boundary
+	var report *blockchain.RebuildReport
+	select {
+	case report = <-reportC:
+	case errC := <-errorC:
+		if errC != nil {
+			log.Error("chainmaker encounters error ", errC)
+		}
+		close(stopC)
+		report = <-reportC
 	}
 
-	//rpcServer.Stop()
 	chainMakerServer.Stop()
 	log.Info("All is stopped!")
-
+	return report
 }
 
 func setNodeId() error {
@@ -0,0 +1,76 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/store/v2/conf"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cobra"
+)
+
+// RestoreDbsCMD undo a rebuild-dbs by bringing back the backup it made, the node must be stopped.
+func RestoreDbsCMD() *cobra.Command {
+	restoreDbsCmd := &cobra.Command{
+		Use:   "restore-dbs",
+		Short: "RestoreDbs ChainMaker",
+		Long:  "Restore the dbs backed up by rebuild-dbs, the rebuilt dbs are dropped",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			initLocalConfig(cmd)
+			restoreDbs(rebuildChainId, backupId)
+			return nil
+		},
+	}
+	attachFlags(restoreDbsCmd, []string{flagNameOfConfigFilepath, flagNameOfChainId, flagNameOfBackupId})
+	return restoreDbsCmd
+}
+
+func restoreDbs(chainId, backupId string) {
+	config := &conf.StorageConfig{}
+	if err := mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	checkpointFile := rebuildCheckpointFile(chainId)
+	if backupId == "" {
+		checkpoint, err := blockchain.LoadRebuildCheckpoint(checkpointFile)
+		if err != nil {
+			fmt.Printf("no --%s given and no rebuild checkpoint found, %s\n", flagNameOfBackupId, err)
+			os.Exit(1)
+		}
+		backupId = checkpoint.BackPath
+	}
+
+	manifestFile := blockchain.DbBackupManifestFile(config.StorePath, chainId, backupId)
+	manifest, err := blockchain.LoadDbBackupManifest(manifestFile)
+	if err != nil {
+		fmt.Printf("load backup manifest failed, %s\n", err)
+		os.Exit(1)
+	}
+	if manifest.ChainId != chainId {
+		fmt.Printf("backup %s belongs to chain %s, not %s\n", backupId, manifest.ChainId, chainId)
+		os.Exit(1)
+	}
+
+	if err = blockchain.RestoreDbs(manifest); err != nil {
+		fmt.Printf("restore dbs failed, the dbs are left as they were, %s\n", err)
+		os.Exit(1)
+	}
+
+	// the rebuild is undone, it can not be resumed any more
+	if checkpoint, e := blockchain.LoadRebuildCheckpoint(checkpointFile); e == nil && checkpoint.BackPath == backupId {
+		if e = os.Remove(checkpointFile); e != nil {
+			fmt.Printf("remove rebuild checkpoint(%s) failed, %s\n", checkpointFile, e)
+		}
+	}
+	fmt.Printf("restore dbs of chain %s from backup %s success, the backup is kept\n", chainId, backupId)
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/monitor"
 	"chainmaker.org/chainmaker-go/module/rpcserver"
 	"chainmaker.org/chainmaker/localconf/v2"
@@ -77,6 +78,9 @@ func mainStart() {
 	// handle exit signal in separate go routines
 	go handleExitSignal(errorC)
 
+	// reload the config file on SIGHUP
+	go handleReloadSignal()
+
 	// start blockchains in separate go routines
 	if err := chainMakerServer.Start(); err != nil {
 		log.Errorf("chainmaker server startup failed, %s", err.Error())
@@ -124,6 +128,29 @@ func handleExitSignal(exitC chan<- error) {
 	}
 }
 
+// handleReloadSignal reload the config file on SIGHUP, the changed keys which are not applied are logged
+func handleReloadSignal() {
+
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, syscall.SIGHUP)
+	defer signal.Stop(signalChan)
+
+	for sig := range signalChan {
+		log.Infof("received signal: %d (%s), reload config file %s", sig, sig, localconf.ConfigFilepath)
+		report, err := confreload.Reload(localconf.ConfigFilepath)
+		if err != nil {
+			log.Errorf("reload config file failed, %s", err)
+			continue
+		}
+		if len(report.NeedRestart) > 0 {
+			log.Warnf("config keys %v are changed, they take effect after a restart", report.NeedRestart)
+		}
+		if len(report.Failed) > 0 {
+			log.Errorf("config keys failed to reload, %v", report.Failed)
+		}
+	}
+}
+
 func printLogo() {
 	log.Infof(logo())
 }
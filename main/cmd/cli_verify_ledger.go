@@ -0,0 +1,74 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"github.com/spf13/cobra"
+)
+
+// VerifyLedgerCMD audit the ledger of a chain offline, the node should be stopped.
+func VerifyLedgerCMD() *cobra.Command {
+	verifyLedgerCmd := &cobra.Command{
+		Use:   "verify-ledger",
+		Short: "VerifyLedger ChainMaker",
+		Long: "Walk every block of a chain and check the hash chain, the tx merkle root, " +
+			"the DAG hash and the RW-set hash, the result is written as a json report",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			initLocalConfig(cmd)
+			report := verifyLedger(rebuildChainId, &blockchain.VerifyLedgerOptions{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			file := reportFile
+			if file == "" {
+				file = fmt.Sprintf("verify-ledger-%s.json", rebuildChainId)
+			}
+			if err := report.WriteFile(file); err != nil {
+				fmt.Printf("write verify ledger report failed, %s\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("verify ledger report: %s, blocks: %d, failed blocks: %d, success: %v\n",
+				file, report.BlockCount, report.FailedCount, report.Success)
+			if !report.Success {
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+	attachFlags(verifyLedgerCmd, []string{flagNameOfConfigFilepath, flagNameOfChainId,
+		flagNameOfStartHeight, flagNameOfEndHeight, flagNameOfReportFile})
+	return verifyLedgerCmd
+}
+
+func verifyLedger(chainId string, opts *blockchain.VerifyLedgerOptions) *blockchain.VerifyLedgerReport {
+	chainMakerServer := blockchain.NewChainMakerServer()
+	if err := chainMakerServer.InitForVerifyLedger(chainId); err != nil {
+		fmt.Printf("chainmaker server init failed, %s\n", err)
+		os.Exit(1)
+	}
+
+	// an exit signal stops the audit at a block boundary, the report covers the blocks verified so far
+	errorC := make(chan error, 1)
+	go handleExitSignal(errorC)
+	stopC := make(chan struct{})
+	opts.StopC = stopC
+	go func() {
+		<-errorC
+		close(stopC)
+	}()
+
+	report, err := chainMakerServer.VerifyLedger(chainId, opts)
+	if err != nil {
+		fmt.Printf("verify ledger failed, %s\n", err)
+		os.Exit(1)
+	}
+	return report
+}
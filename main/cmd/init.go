@@ -20,11 +20,23 @@ const (
 	flagNameShortHandOFConfigFilepath = "c"
 	flagNameOfChainId                 = "chain-id"
 	flagNameOfNeedVerify              = "need-verify"
+	flagNameOfResume                  = "resume"
+	flagNameOfContinueOnError         = "continue-on-error"
+	flagNameOfReportFile              = "report-file"
+	flagNameOfBackupId                = "backup-id"
+	flagNameOfStartHeight             = "start-height"
+	flagNameOfEndHeight               = "end-height"
 )
 
 var (
-	rebuildChainId string
-	needVerify     bool
+	rebuildChainId  string
+	needVerify      bool
+	resumeRebuild   bool
+	continueOnError bool
+	reportFile      string
+	backupId        string
+	startHeight     uint64
+	endHeight       uint64
 )
 
 func initLocalConfig(cmd *cobra.Command) {
@@ -39,9 +51,25 @@ func initFlagSet() *pflag.FlagSet {
 	flags.StringVarP(&localconf.ConfigFilepath, flagNameOfConfigFilepath, flagNameShortHandOFConfigFilepath,
 		localconf.ConfigFilepath, "specify config file path, if not set, default use ./chainmaker.yml")
 	flags.StringVarP(&rebuildChainId, flagNameOfChainId, "",
-		"chain1", "specify chain-id, this flag only used by rebuild-dbs module")
+		"chain1", "specify chain-id, this flag only used by rebuild-dbs, restore-dbs and verify-ledger module")
 	flags.BoolVarP(&needVerify, flagNameOfNeedVerify, "v",
 		true, "specify need-verify, verify rebuild block whether or not, this flag only used by rebuild-dbs module")
+	flags.BoolVarP(&resumeRebuild, flagNameOfResume, "",
+		false, "resume an interrupted rebuild from its checkpoint, this flag only used by rebuild-dbs module")
+	flags.BoolVarP(&continueOnError, flagNameOfContinueOnError, "",
+		false, "keep checking the hash chain after a block failed, the blocks after it are not committed, "+
+			"this flag only used by rebuild-dbs module")
+	flags.StringVarP(&reportFile, flagNameOfReportFile, "",
+		"", "specify the json report file path, if not set, default use ./<command>-<chain-id>.json, "+
+			"this flag only used by rebuild-dbs and verify-ledger module")
+	flags.StringVarP(&backupId, flagNameOfBackupId, "",
+		"", "specify the backup to restore, if not set, default use the backup of the last rebuild-dbs, "+
+			"this flag only used by restore-dbs module")
+	flags.Uint64VarP(&startHeight, flagNameOfStartHeight, "",
+		0, "specify the first block height to verify, this flag only used by verify-ledger module")
+	flags.Uint64VarP(&endHeight, flagNameOfEndHeight, "",
+		0, "specify the last block height to verify, 0 means the last block, "+
+			"this flag only used by verify-ledger module")
 	return flags
 }
 
@@ -20,6 +20,8 @@ func main() {
 	mainCmd.AddCommand(cmd.VersionCMD())
 	mainCmd.AddCommand(cmd.ConfigCMD())
 	mainCmd.AddCommand(cmd.RebuildDbsCMD())
+	mainCmd.AddCommand(cmd.RestoreDbsCMD())
+	mainCmd.AddCommand(cmd.VerifyLedgerCMD())
 
 	err := mainCmd.Execute()
 	if err != nil {
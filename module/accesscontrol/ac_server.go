@@ -13,6 +13,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"chainmaker.org/chainmaker/common/v2/crypto"
 	"chainmaker.org/chainmaker/common/v2/crypto/asym"
@@ -36,8 +37,14 @@ const (
 	PARAM_ALIAS                  = "alias"
 	PUBLIC_KEYS                  = "pubkey"
 	unsupportedRuleErrorTemplate = "bad configuration: unsupported rule [%s]"
+	// RULE_EXPR_PREFIX the prefix of a rule written in the policy expression language, see policy_expr.go
+	RULE_EXPR_PREFIX = "EXPR:"
 
 	defaultCertCacheSize = 1024
+	// memberCacheTTL how long a verified member is cached, it is verified against the trust roots again after that
+	memberCacheTTL = 30 * time.Minute
+	// memberCacheName the name of the member cache in the metrics
+	memberCacheName = "member"
 )
 
 var notEnoughParticipantsSupportError = "authentication fail: not enough participants support this action"
@@ -242,6 +249,9 @@ type accessControlService struct {
 	pwkNewMember func(member *pbac.Member) (protocol.Member, error)
 
 	getCertVerifyOptions func() *bcx509.VerifyOptions
+
+	// block version of the chain config, the policy expressions are only accepted since blockVersion2380
+	blockVersion uint32
 }
 
 type memberCached struct {
@@ -271,7 +281,7 @@ func initAccessControlService(hashType, authType string, addressType config.Addr
 		exceptionalPolicyMap220:   &sync.Map{},
 		resourceNamePolicyMap2320: &sync.Map{},
 		exceptionalPolicyMap2320:  &sync.Map{},
-		memberCache:               NewShardCache(GetCertCacheSize()),
+		memberCache:               NewShardCacheWithTTL(GetCertCacheSize(), memberCacheTTL),
 		dataStore:                 store,
 		log:                       log,
 		hashType:                  hashType,
@@ -287,6 +297,11 @@ func (acs *accessControlService) initResourcePolicy(resourcePolicies []*config.R
 	acs.loadResourcePolicy(resourcePolicies)
 }
 
+// setBlockVersion record the block version of the chain config before its resource policies are loaded
+func (acs *accessControlService) setBlockVersion(blockVersion uint32) {
+	atomic.StoreUint32(&acs.blockVersion, blockVersion)
+}
+
 func (acs *accessControlService) createDefaultResourcePolicy(localOrgId string) {
 	authType := strings.ToLower(acs.authType)
 	switch authType {
@@ -340,6 +355,9 @@ func (acs *accessControlService) checkResourcePolicyRule(resourcePolicy *config.
 		acs.log.Debugf("delete policy configuration of %s", resourcePolicy.ResourceName)
 		return true
 	default:
+		if isPolicyExprRule(resourcePolicy.Policy.Rule) && atomic.LoadUint32(&acs.blockVersion) >= blockVersion2380 {
+			return acs.checkResourcePolicyRuleExprCase(resourcePolicy.Policy)
+		}
 		return acs.checkResourcePolicyRuleDefaultCase(resourcePolicy.Policy)
 	}
 }
@@ -387,6 +405,28 @@ func (acs *accessControlService) checkResourcePolicyRuleMajorityCase(policy *pba
 	}
 }
 
+func (acs *accessControlService) checkResourcePolicyRuleExprCase(policy *pbac.Policy) bool {
+	if len(policy.OrgList) > 0 || len(policy.RoleList) > 0 {
+		acs.log.Errorf("bad configuration: the organizations and the roles of the policy expression [%s] should be "+
+			"given in the expression instead of the organization list and the role list", policy.Rule)
+		return false
+	}
+	expr, err := parsePolicyExpr(policy.Rule)
+	if err != nil {
+		acs.log.Errorf("bad configuration: invalid policy expression [%s], %s", policy.Rule, err)
+		return false
+	}
+	err = expr.validate(func(orgId string) bool {
+		_, ok := acs.orgList.Load(orgId)
+		return ok
+	})
+	if err != nil {
+		acs.log.Errorf("bad configuration: invalid policy expression [%s], %s", policy.Rule, err)
+		return false
+	}
+	return true
+}
+
 func (acs *accessControlService) checkResourcePolicyRuleDefaultCase(policy *pbac.Policy) bool {
 	nums := strings.Split(policy.Rule, LIMIT_DELIMITER)
 	switch len(nums) {
@@ -685,6 +725,9 @@ func (acs *accessControlService) verifyPrincipalPolicy(principal, refinedPrincip
 	case protocol.RuleAll:
 		return acs.verifyPrincipalPolicyRuleAllCase(p, endorsements)
 	default:
+		if p.expr != nil {
+			return acs.verifyPrincipalPolicyRuleExprCase(p, endorsements)
+		}
 		return acs.verifyPrincipalPolicyRuleDefaultCase(p, endorsements)
 	}
 }
@@ -788,6 +831,34 @@ func (acs *accessControlService) verifyPrincipalPolicyRuleAllCase(p *policy, end
 	return false, fmt.Errorf("authentication fail: not all of the listed organtizations consend to this action")
 }
 
+func (acs *accessControlService) verifyPrincipalPolicyRuleExprCase(p *policy,
+	endorsements []*common.EndorsementEntry) (bool, error) {
+	signers := make([]*policySigner, 0, len(endorsements))
+	for _, endorsement := range endorsements {
+		if endorsement == nil || endorsement.Signer == nil {
+			continue
+		}
+		member := acs.getMemberFromCache(endorsement.Signer)
+		if member == nil {
+			acs.log.Debugf(
+				"authentication warning: the member is not in member cache, memberInfo[%s]",
+				string(endorsement.Signer.MemberInfo))
+			continue
+		}
+		signers = append(signers, &policySigner{
+			uid:   member.GetUid(),
+			orgId: member.GetOrgId(),
+			role:  member.GetRole(),
+		})
+	}
+
+	if p.expr.evaluate(signers) {
+		return true, nil
+	}
+	return false, fmt.Errorf("%s: policy expression [%s] is not satisfied, valid endorsements received per clause: "+
+		"[%s]", notEnoughParticipantsSupportError, p.expr, p.expr.describe(signers))
+}
+
 func (acs *accessControlService) verifyPrincipalPolicyRuleDefaultCase(p *policy,
 	endorsements []*common.EndorsementEntry) (bool, error) {
 	rule := p.GetRule()
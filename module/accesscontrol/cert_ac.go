@@ -13,7 +13,6 @@ import (
 	"crypto/x509/pkix"
 	"encoding/hex"
 	"encoding/pem"
-	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -42,7 +41,7 @@ type certACProvider struct {
 	// local cache for certificates (reduce the size of block)
 	certCache *ShardCache
 
-	// local cache for certificate revocation list and frozen list
+	// local cache for certificate revocation list (the *revocationList of each AKI) and frozen list
 	crl        sync.Map
 	frozenList sync.Map
 
@@ -144,6 +143,7 @@ func newCertACProvider(chainConfig *config.ChainConfig, localOrgId string,
 
 	certACProvider.acService = initAccessControlService(chainConfig.GetCrypto().Hash,
 		chainConfig.AuthType, chainConfig.Vm.AddrType, store, log)
+	certACProvider.acService.memberCache.EnableMetrics(chainConfig.ChainId, memberCacheName)
 	certACProvider.acService.setVerifyOptionsFunc(certACProvider.getVerifyOptions)
 
 	err = certACProvider.initTrustRoots(chainConfig.TrustRoots, localOrgId)
@@ -151,6 +151,7 @@ func newCertACProvider(chainConfig *config.ChainConfig, localOrgId string,
 		return nil, err
 	}
 
+	certACProvider.acService.setBlockVersion(chainConfig.GetBlockVersion())
 	certACProvider.acService.initResourcePolicy(chainConfig.ResourcePolicies, localOrgId)
 
 	certACProvider.opts.KeyUsages = make([]x509.ExtKeyUsage, 1)
@@ -332,7 +333,7 @@ func (cp *certACProvider) storeEffectiveCrls(crlAKIs []string) error {
 			if err != nil {
 				continue
 			}
-			cp.crl.Store(string(aki), crl)
+			cp.indexCRL(aki, crl)
 		}
 	}
 	return nil
@@ -360,7 +361,7 @@ func (cp *certACProvider) storeCrls(crlAKIs []string) error {
 			if err != nil {
 				return fmt.Errorf("fail to load CRL, fail to get AKI from CRL: %v", err)
 			}
-			cp.crl.Store(string(aki), crl)
+			cp.indexCRL(aki, crl)
 		}
 	}
 	return nil
@@ -453,28 +454,6 @@ func (cp *certACProvider) checkCRLAgainstTrustedCerts(crl *pkix.CertificateList,
 	return fmt.Errorf("CRL [AKI: %s] is not signed by ac trusted CA", hex.EncodeToString(aki))
 }
 
-func (cp *certACProvider) checkCRL(certChain []*bcx509.Certificate) error {
-	if len(certChain) < 1 {
-		return fmt.Errorf("given certificate chain is empty")
-	}
-
-	for _, cert := range certChain {
-		akiCert := cert.AuthorityKeyId
-
-		crl, ok := cp.crl.Load(string(akiCert))
-		if ok {
-			// we have ac CRL, check whether the serial number is revoked
-			for _, rc := range crl.(*pkix.CertificateList).TBSCertList.RevokedCertificates {
-				if rc.SerialNumber.Cmp(cert.SerialNumber) == 0 {
-					return errors.New("certificate is revoked")
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
 func (cp *certACProvider) loadCertFrozenList() error {
 	if cp.acService.dataStore == nil {
 		return nil
@@ -523,6 +502,21 @@ func (cp *certACProvider) checkCertFrozenList(certChain []*bcx509.Certificate) e
 	return nil
 }
 
+// removeRevokedMembers evict the cached members whose certificates are revoked or frozen, so that they are
+// verified again rather than trusted by the cache
+func (cp *certACProvider) removeRevokedMembers() {
+	removed := cp.acService.memberCache.RemoveIf(func(_ string, v interface{}) bool {
+		cached, ok := v.(*memberCached)
+		if !ok || len(cached.certChain) == 0 {
+			return false
+		}
+		return cp.checkCRL(cached.certChain) != nil || cp.checkCertFrozenList(cached.certChain) != nil
+	})
+	if removed > 0 {
+		cp.acService.log.Infof("remove %d revoked or frozen members from the member cache", removed)
+	}
+}
+
 // GetHashAlg return hash algorithm the access control provider uses
 func (cp *certACProvider) GetHashAlg() string {
 	return cp.acService.hashType
@@ -713,64 +707,72 @@ func (cp *certACProvider) RefineEndorsements(endorsements []*common.EndorsementE
 
 	refinedSigners := map[string]bool{}
 	var refinedEndorsement []*common.EndorsementEntry
-	var memInfo string
 
 	for _, endorsementEntry := range endorsements {
-		endorsement := &common.EndorsementEntry{
-			Signer: &pbac.Member{
-				OrgId:      endorsementEntry.Signer.OrgId,
-				MemberInfo: endorsementEntry.Signer.MemberInfo,
-				MemberType: endorsementEntry.Signer.MemberType,
-			},
-			Signature: endorsementEntry.Signature,
-		}
-		if endorsement.Signer.MemberType == pbac.MemberType_CERT {
-			cp.acService.log.Debugf("target endorser uses full certificate")
-			memInfo = string(endorsement.Signer.MemberInfo)
+		endorsement, _, err := cp.refineEndorsement(endorsementEntry, msg, true)
+		if err != nil {
+			cp.acService.log.Warnf("refine endorsement failed, [endorsement: %v],[err: %s]",
+				endorsementEntry, err.Error())
+			continue
 		}
-		if endorsement.Signer.MemberType == pbac.MemberType_CERT_HASH ||
-			endorsement.Signer.MemberType == pbac.MemberType_ALIAS {
-			cp.acService.log.Debugf("target endorser uses compressed certificate")
-			memInfoBytes, ok := cp.lookUpCertCache(endorsement.Signer.MemberInfo)
-			if !ok {
-				cp.acService.log.Warnf("authentication failed, unknown signer, the provided certificate ID is not registered")
-				continue
-			}
-			memInfo = string(memInfoBytes)
-			endorsement.Signer.MemberInfo = memInfoBytes
+
+		memInfo := string(endorsement.Signer.MemberInfo)
+		if _, ok := refinedSigners[memInfo]; !ok {
+			refinedSigners[memInfo] = true
+			refinedEndorsement = append(refinedEndorsement, endorsement)
 		}
+	}
+	return refinedEndorsement
+}
 
-		signerInfo, ok := cp.acService.lookUpMemberInCache(memInfo)
+// refineEndorsement resolve and verify the signer of the endorsement, and verify the signature against msg if
+// verifySignature. The returned endorsement carries the full certificate of a compressed one, the returned member
+// is the signer if it is resolved, even when the endorsement is refused.
+func (cp *certACProvider) refineEndorsement(endorsementEntry *common.EndorsementEntry, msg []byte,
+	verifySignature bool) (*common.EndorsementEntry, protocol.Member, error) {
+
+	endorsement := &common.EndorsementEntry{
+		Signer: &pbac.Member{
+			OrgId:      endorsementEntry.Signer.OrgId,
+			MemberInfo: endorsementEntry.Signer.MemberInfo,
+			MemberType: endorsementEntry.Signer.MemberType,
+		},
+		Signature: endorsementEntry.Signature,
+	}
+	if endorsement.Signer.MemberType == pbac.MemberType_CERT {
+		cp.acService.log.Debugf("target endorser uses full certificate")
+	}
+	if endorsement.Signer.MemberType == pbac.MemberType_CERT_HASH ||
+		endorsement.Signer.MemberType == pbac.MemberType_ALIAS {
+		cp.acService.log.Debugf("target endorser uses compressed certificate")
+		memInfoBytes, ok := cp.lookUpCertCache(endorsement.Signer.MemberInfo)
 		if !ok {
-			cp.acService.log.Debugf("certificate not in local cache, should verify it against the trusted root certificates: "+
-				"\n%s", memInfo)
-			remoteMember, certChain, ok, err := cp.verifyPrincipalSignerNotInCache(endorsement, msg, memInfo)
-			if !ok {
-				cp.acService.log.Warnf("verify principal signer not in cache failed, [endorsement: %v],[err: %s]",
-					endorsement, err.Error())
-				continue
-			}
+			return nil, nil, fmt.Errorf("authentication failed, unknown signer, " +
+				"the provided certificate ID is not registered")
+		}
+		endorsement.Signer.MemberInfo = memInfoBytes
+	}
+	memInfo := string(endorsement.Signer.MemberInfo)
 
-			signerInfo = &memberCached{
-				member:    remoteMember,
-				certChain: certChain,
-			}
-			cp.acService.addMemberToCache(endorsement.Signer, signerInfo)
-		} else {
-			flat, err := cp.verifyPrincipalSignerInCache(signerInfo, endorsement, msg, memInfo)
-			if !flat {
-				cp.acService.log.Warnf("verify principal signer in cache failed, [endorsement: %v],[err: %s]",
-					endorsement, err.Error())
-				continue
-			}
+	signerInfo, ok := cp.acService.lookUpMemberInCache(memInfo)
+	if !ok {
+		cp.acService.log.Debugf("certificate not in local cache, should verify it against the trusted root certificates: "+
+			"\n%s", memInfo)
+		remoteMember, certChain, err := cp.verifyPrincipalSignerNotInCache(endorsement, msg, memInfo, verifySignature)
+		if err != nil {
+			return nil, remoteMember, fmt.Errorf("verify principal signer not in cache failed: %s", err.Error())
 		}
 
-		if _, ok := refinedSigners[memInfo]; !ok {
-			refinedSigners[memInfo] = true
-			refinedEndorsement = append(refinedEndorsement, endorsement)
+		signerInfo = &memberCached{
+			member:    remoteMember,
+			certChain: certChain,
 		}
+		cp.acService.addMemberToCache(endorsement.Signer, signerInfo)
+	} else if err := cp.verifyPrincipalSignerInCache(signerInfo, endorsement, msg, memInfo,
+		verifySignature); err != nil {
+		return nil, signerInfo.member, fmt.Errorf("verify principal signer in cache failed: %s", err.Error())
 	}
-	return refinedEndorsement
+	return endorsement, signerInfo.member, nil
 }
 
 func (cp *certACProvider) RefineEndorsementsForCertOptimization(endorsements []*common.EndorsementEntry,
@@ -849,37 +851,33 @@ func (cp *certACProvider) addCertCache(certId string, cert []byte) {
 }
 
 func (cp *certACProvider) verifyPrincipalSignerNotInCache(endorsement *common.EndorsementEntry, msg []byte,
-	memInfo string) (remoteMember protocol.Member, certChain []*bcx509.Certificate, ok bool, err error) {
+	memInfo string, verifySignature bool) (remoteMember protocol.Member, certChain []*bcx509.Certificate, err error) {
 	var isTrustMember bool
 	remoteMember, isTrustMember, err = cp.newNoCacheMember(endorsement.Signer)
 	if err != nil {
-		err = fmt.Errorf("new member failed: [%s]", err.Error())
-		ok = false
-		return
+		return nil, nil, fmt.Errorf("new member failed: [%s]", err.Error())
 	}
 
 	if !isTrustMember {
 		certChain, err = cp.verifyMember(remoteMember)
 		if err != nil {
-			err = fmt.Errorf("verify member failed: [%s]", err.Error())
-			ok = false
-			return
+			return remoteMember, nil, fmt.Errorf("verify member failed: [%s]", err.Error())
 		}
 	}
 
+	if !verifySignature {
+		return remoteMember, certChain, nil
+	}
 	if err = remoteMember.Verify(cp.acService.hashType, msg, endorsement.Signature); err != nil {
-		err = fmt.Errorf("member verify signature failed: [%s]", err.Error())
 		cp.acService.log.Warnf("information for invalid signature:\norganization: %s\ncertificate: %s\nmessage: %s\n"+
 			"signature: %s", endorsement.Signer.OrgId, memInfo, hex.Dump(msg), hex.Dump(endorsement.Signature))
-		ok = false
-		return
+		return remoteMember, nil, fmt.Errorf("member verify signature failed: [%s]", err.Error())
 	}
-	ok = true
-	return
+	return remoteMember, certChain, nil
 }
 
 func (cp *certACProvider) verifyPrincipalSignerInCache(signerInfo *memberCached, endorsement *common.EndorsementEntry,
-	msg []byte, memInfo string) (bool, error) {
+	msg []byte, memInfo string, verifySignature bool) error {
 	// check CRL and certificate frozen list
 
 	_, isTrustMember := cp.loadTrustMembers(memInfo)
@@ -887,27 +885,28 @@ func (cp *certACProvider) verifyPrincipalSignerInCache(signerInfo *memberCached,
 	if !isTrustMember {
 		err := cp.checkCRL(signerInfo.certChain)
 		if err != nil {
-			return false, fmt.Errorf("check CRL, error: [%s]", err.Error())
+			return fmt.Errorf("check CRL, error: [%s]", err.Error())
 		}
 		err = cp.checkCertFrozenList(signerInfo.certChain)
 		if err != nil {
-			return false, fmt.Errorf("check cert forzen list, error: [%s]", err.Error())
+			return fmt.Errorf("check cert forzen list, error: [%s]", err.Error())
 		}
 		cp.acService.log.Debugf("certificate is already seen, no need to verify against the trusted root certificates")
 
 		if endorsement.Signer.OrgId != signerInfo.member.GetOrgId() {
-			err := fmt.Errorf("authentication failed, signer does not belong to the organization it claims "+
+			return fmt.Errorf("authentication failed, signer does not belong to the organization it claims "+
 				"[claim: %s, root cert: %s]", endorsement.Signer.OrgId, signerInfo.member.GetOrgId())
-			return false, err
 		}
 	}
+	if !verifySignature {
+		return nil
+	}
 	if err := signerInfo.member.Verify(cp.acService.hashType, msg, endorsement.Signature); err != nil {
-		err = fmt.Errorf("signer member verify signature failed: [%s]", err.Error())
 		cp.acService.log.Warnf("information for invalid signature:\norganization: %s\ncertificate: %s\nmessage: %s\n"+
 			"signature: %s", endorsement.Signer.OrgId, memInfo, hex.Dump(msg), hex.Dump(endorsement.Signature))
-		return false, err
+		return fmt.Errorf("signer member verify signature failed: [%s]", err.Error())
 	}
-	return true, nil
+	return nil
 }
 
 // Check whether the provided member is a valid member of this group
@@ -168,6 +168,7 @@ func (cp *certACProvider) onMessageCertFreeze(msg *msgbus.Message) {
 		cp.frozenList.Store(string(certBlock.Bytes), true)
 		certBlock, rest = pem.Decode(rest)
 	}
+	cp.removeRevokedMembers()
 }
 
 func (cp *certACProvider) onMessageCertUnFreeze(msg *msgbus.Message) {
@@ -227,8 +228,9 @@ func (cp *certACProvider) onMessageCertRevoke(msg *msgbus.Message) {
 			err = fmt.Errorf("update CRL failed: %v", err)
 			cp.acService.log.Error(err)
 		}
-		cp.crl.Store(string(aki), crl)
+		cp.indexCRL(aki, crl)
 	}
+	cp.removeRevokedMembers()
 }
 
 func (cp *certACProvider) onMessageCertDelete(msg *msgbus.Message) {
@@ -21,6 +21,7 @@ const CONSENSUS = "consensus"
 
 func (cp *certACProvider) messageChainConfig(chainConfig *config.ChainConfig, fromMaxBFT bool) {
 	cp.acService.hashType = chainConfig.GetCrypto().GetHash()
+	cp.acService.setBlockVersion(chainConfig.GetBlockVersion())
 	cp.acService.initResourcePolicy(chainConfig.ResourcePolicies, cp.localOrg.id)
 
 	updateTrustRootAndMemberFunc := func() {
@@ -166,5 +167,6 @@ func (cp *certACProvider) updateFrozenAndCRL(epochConfig *maxbft.GovernanceContr
 			return fmt.Errorf("fail to update CRL list: %v", err)
 		}
 	}
+	cp.removeRevokedMembers()
 	return nil
 }
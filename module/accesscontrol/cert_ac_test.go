@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"chainmaker.org/chainmaker/pb-go/v2/config"
 	"chainmaker.org/chainmaker/pb-go/v2/syscontract"
+	"chainmaker.org/chainmaker/protocol/v2"
 	"chainmaker.org/chainmaker/utils/v2"
 
 	acPb "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
@@ -375,3 +377,60 @@ func testCert_VerifyPolicySelf(blockVersion uint32, t *testing.T) {
 	fmt.Printf("Got expected error, err = %v \n\n", err)
 
 }
+
+func TestCert_VerifyPolicyExpr(t *testing.T) {
+	testCertOrgMember := testInitCertFunc(t)
+	orgMemberInfo1 := testCertOrgMember[testOrg1]
+	orgMemberInfo2 := testCertOrgMember[testOrg2]
+	orgMemberInfo3 := testCertOrgMember[testOrg3]
+	orgMemberInfo4 := testCertOrgMember[testOrg4]
+
+	exprPolicy := &config.ResourcePolicy{
+		ResourceName: "TEST_CONTRACT-TEST_METHOD_EXPR",
+		Policy: &acPb.Policy{
+			Rule: fmt.Sprintf("%s 1 ADMIN OF %s AND (1 CONSENSUS OF [%s, %s] OR 3 ADMIN WEIGHTED [%s:2, %s:1, %s:1])",
+				RULE_EXPR_PREFIX, testOrg1, testOrg2, testOrg3, testOrg2, testOrg3, testOrg4),
+		},
+	}
+	chainConfig := *testChainConfig
+	chainConfig.ResourcePolicies = append([]*config.ResourcePolicy{exprPolicy}, testChainConfig.ResourcePolicies...)
+
+	// the policy expression is not accepted by the chains of the former block versions
+	chainConfig.BlockVersion = blockVersion2330
+	acProvider, err := newCertACProvider(&chainConfig, testOrg1, nil, &test.GoLogger{})
+	require.Nil(t, err)
+	require.False(t, acProvider.ValidateResourcePolicy(exprPolicy))
+
+	chainConfig.BlockVersion = blockVersion2380
+	acProvider, err = newCertACProvider(&chainConfig, testOrg1, nil, &test.GoLogger{})
+	require.Nil(t, err)
+	require.True(t, acProvider.ValidateResourcePolicy(exprPolicy))
+
+	tests := []struct {
+		sender    protocol.SigningMember
+		endorsers []protocol.SigningMember
+		allowed   bool
+	}{
+		{orgMemberInfo1.admin, []protocol.SigningMember{orgMemberInfo3.consensus}, true},
+		{orgMemberInfo1.admin, []protocol.SigningMember{orgMemberInfo2.admin, orgMemberInfo4.admin}, true},
+		{orgMemberInfo1.admin, []protocol.SigningMember{orgMemberInfo3.admin, orgMemberInfo4.admin}, false},
+		{orgMemberInfo1.client, []protocol.SigningMember{orgMemberInfo2.consensus}, false},
+	}
+	for i, tt := range tests {
+		tx := testCreateTx("TEST_CONTRACT", "TEST_METHOD_EXPR", "test-txid-12345")
+		err = testAppendSender2Tx(tx, testPKHashType, tt.sender)
+		require.Nil(t, err)
+		for _, endorser := range tt.endorsers {
+			err = testAppendEndorsement2Tx(tx, testPKHashType, endorser)
+			require.Nil(t, err)
+		}
+
+		var ok bool
+		ok, err = acProvider.VerifyTxPrincipal(tx, utils.GetTxResourceName(tx), blockVersion2380)
+		require.Equal(t, tt.allowed, ok, "case %d", i)
+		if !tt.allowed {
+			require.NotNil(t, err)
+			fmt.Printf("Got expected error, err = %v \n\n", err)
+		}
+	}
+}
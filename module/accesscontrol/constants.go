@@ -4,4 +4,7 @@ const (
 	blockVersion220  = uint32(220)
 	blockVersion2320 = uint32(2030200)
 	blockVersion2330 = uint32(2030300)
+	// the block versions up to 2030799 are stamped by the releases built on protocol v2.3.7 without the policy
+	// expressions, see TestPolicyExprBlockVersion
+	blockVersion2380 = uint32(2030800)
 )
@@ -0,0 +1,235 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package accesscontrol
+
+import (
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	bcx509 "chainmaker.org/chainmaker/common/v2/crypto/x509"
+	pbac "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// the policies applied to a CRL past its NextUpdate, see SetCRLExpiryPolicy. The verification of the txs and blocks
+// always uses the expired CRL, so that the nodes agree on the blocks whatever their policies and clocks are.
+const (
+	// CRLExpiryPolicyIgnore use the expired CRL silently
+	CRLExpiryPolicyIgnore = "ignore"
+	// CRLExpiryPolicyWarn use the expired CRL, and warn once for each CRL
+	CRLExpiryPolicyWarn = "warn"
+	// CRLExpiryPolicyReject warn as CRLExpiryPolicyWarn, and the node refuses the txs submitted to it whose sender or
+	// endorsers are issued by the CA whose CRL is expired, see RevocationListProvider.CheckRevocationListsExpiry
+	CRLExpiryPolicyReject = "reject"
+)
+
+// crlExpiryPolicy the policy of the node, it applies to all the chains
+var crlExpiryPolicy atomic.Value
+
+func init() {
+	crlExpiryPolicy.Store(CRLExpiryPolicyWarn)
+}
+
+// IsValidCRLExpiryPolicy check whether the policy is supported
+func IsValidCRLExpiryPolicy(policy string) bool {
+	switch policy {
+	case CRLExpiryPolicyIgnore, CRLExpiryPolicyWarn, CRLExpiryPolicyReject:
+		return true
+	}
+	return false
+}
+
+// SetCRLExpiryPolicy change the policy applied to the CRLs past their NextUpdate on all the chains of the node
+func SetCRLExpiryPolicy(policy string) error {
+	if !IsValidCRLExpiryPolicy(policy) {
+		return fmt.Errorf("unknown CRL expiry policy: %s", policy)
+	}
+	crlExpiryPolicy.Store(policy)
+	return nil
+}
+
+// GetCRLExpiryPolicy the policy applied to the CRLs past their NextUpdate
+func GetCRLExpiryPolicy() string {
+	return crlExpiryPolicy.Load().(string)
+}
+
+// RevocationListProvider the access control provider which keeps the CRLs of the CAs
+type RevocationListProvider interface {
+	// GetRevocationLists returns the status of the CRLs, sorted by the AKI
+	GetRevocationLists() []*RevocationListStatus
+	// CheckRevocationListsExpiry returns an error if the policy is CRLExpiryPolicyReject and the certificate chain
+	// of the member has a CRL past its NextUpdate. It is for the admission of the txs only, never for the blocks.
+	CheckRevocationListsExpiry(member *pbac.Member) error
+}
+
+// RevocationListStatus the status of the CRL of a CA
+type RevocationListStatus struct {
+	// the authority key identifier in hex
+	AKI        string    `json:"aki"`
+	Issuer     string    `json:"issuer"`
+	Count      int       `json:"count"`
+	ThisUpdate time.Time `json:"this_update"`
+	// zero if the CRL does not tell
+	NextUpdate time.Time `json:"next_update"`
+	Expired    bool      `json:"expired"`
+}
+
+var _ RevocationListProvider = (*certACProvider)(nil)
+
+// revocationList a CRL indexed by the serial numbers of the revoked certificates
+type revocationList struct {
+	aki        []byte
+	issuer     string
+	thisUpdate time.Time
+	nextUpdate time.Time
+	revoked    map[string]struct{}
+	// set once the expiry is warned
+	warned int32
+}
+
+func newRevocationList(aki []byte, crl *pkix.CertificateList) *revocationList {
+	list := &revocationList{
+		aki:        aki,
+		issuer:     crl.TBSCertList.Issuer.String(),
+		thisUpdate: crl.TBSCertList.ThisUpdate,
+		nextUpdate: crl.TBSCertList.NextUpdate,
+		revoked:    make(map[string]struct{}, len(crl.TBSCertList.RevokedCertificates)),
+	}
+	for _, rc := range crl.TBSCertList.RevokedCertificates {
+		list.revoked[serialKey(rc.SerialNumber)] = struct{}{}
+	}
+	return list
+}
+
+// serialKey the key of the serial number in the index, the sign is kept as big.Int.Cmp does
+func serialKey(serial *big.Int) string {
+	if serial == nil {
+		return ""
+	}
+	if serial.Sign() < 0 {
+		return "-" + string(serial.Bytes())
+	}
+	return string(serial.Bytes())
+}
+
+func (l *revocationList) isRevoked(serial *big.Int) bool {
+	_, ok := l.revoked[serialKey(serial)]
+	return ok
+}
+
+func (l *revocationList) isExpired(now time.Time) bool {
+	return !l.nextUpdate.IsZero() && now.After(l.nextUpdate)
+}
+
+// warnExpiry warn once if the CRL is expired at now, unless the policy is to ignore it
+func (l *revocationList) warnExpiry(now time.Time, log protocol.Logger) {
+	if !l.isExpired(now) || GetCRLExpiryPolicy() == CRLExpiryPolicyIgnore {
+		return
+	}
+	if atomic.CompareAndSwapInt32(&l.warned, 0, 1) {
+		log.Warnf("CRL [AKI: %s] of [%s] is expired since %s, it is still used until a new one is stored",
+			hex.EncodeToString(l.aki), l.issuer, l.nextUpdate.Format(time.RFC3339))
+	}
+}
+
+func (l *revocationList) expiredError() error {
+	return fmt.Errorf("CRL [AKI: %s] of [%s] is expired since %s", hex.EncodeToString(l.aki), l.issuer,
+		l.nextUpdate.Format(time.RFC3339))
+}
+
+func (l *revocationList) status(now time.Time) *RevocationListStatus {
+	return &RevocationListStatus{
+		AKI:        hex.EncodeToString(l.aki),
+		Issuer:     l.issuer,
+		Count:      len(l.revoked),
+		ThisUpdate: l.thisUpdate,
+		NextUpdate: l.nextUpdate,
+		Expired:    l.isExpired(now),
+	}
+}
+
+// indexCRL replace the CRL of the AKI with the index of the given one
+func (cp *certACProvider) indexCRL(aki []byte, crl *pkix.CertificateList) {
+	cp.crl.Store(string(aki), newRevocationList(aki, crl))
+}
+
+func (cp *certACProvider) checkCRL(certChain []*bcx509.Certificate) error {
+	if len(certChain) < 1 {
+		return fmt.Errorf("given certificate chain is empty")
+	}
+
+	now := time.Now()
+	for _, cert := range certChain {
+		value, ok := cp.crl.Load(string(cert.AuthorityKeyId))
+		if !ok {
+			continue
+		}
+		list, _ := value.(*revocationList)
+		list.warnExpiry(now, cp.acService.log)
+		if list.isRevoked(cert.SerialNumber) {
+			return errors.New("certificate is revoked")
+		}
+	}
+	return nil
+}
+
+// CheckRevocationListsExpiry see RevocationListProvider
+func (cp *certACProvider) CheckRevocationListsExpiry(pbMember *pbac.Member) error {
+	if GetCRLExpiryPolicy() != CRLExpiryPolicyReject {
+		return nil
+	}
+	member, err := cp.NewMember(pbMember)
+	if err != nil {
+		return err
+	}
+	certMember, ok := member.(*certificateMember)
+	if !ok {
+		return nil
+	}
+	certChain, err := cp.verifyMember(certMember)
+	if err != nil {
+		// the trusted members are not issued by the trusted CAs
+		certChain = []*bcx509.Certificate{certMember.cert}
+	}
+	return cp.checkCRLExpiry(certChain, time.Now())
+}
+
+// checkCRLExpiry returns an error if any certificate of the chain is issued by a CA whose CRL is expired at now
+func (cp *certACProvider) checkCRLExpiry(certChain []*bcx509.Certificate, now time.Time) error {
+	for _, cert := range certChain {
+		value, ok := cp.crl.Load(string(cert.AuthorityKeyId))
+		if !ok {
+			continue
+		}
+		if list, _ := value.(*revocationList); list.isExpired(now) {
+			return list.expiredError()
+		}
+	}
+	return nil
+}
+
+// GetRevocationLists returns the status of the CRLs, see RevocationListProvider
+func (cp *certACProvider) GetRevocationLists() []*RevocationListStatus {
+	now := time.Now()
+	var lists []*RevocationListStatus
+	cp.crl.Range(func(_, value interface{}) bool {
+		if list, ok := value.(*revocationList); ok {
+			lists = append(lists, list.status(now))
+		}
+		return true
+	})
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].AKI < lists[j].AKI
+	})
+	return lists
+}
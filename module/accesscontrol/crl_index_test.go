@@ -0,0 +1,61 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package accesscontrol
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	bcx509 "chainmaker.org/chainmaker/common/v2/crypto/x509"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCert_CheckCRL(t *testing.T) {
+	defer func() {
+		require.Nil(t, SetCRLExpiryPolicy(CRLExpiryPolicyWarn))
+	}()
+	testCertOrgMember := testInitCertFunc(t)
+	cp, ok := testCertOrgMember[testOrg1].acProvider.(*certACProvider)
+	require.True(t, ok)
+
+	block, _ := pem.Decode([]byte(testCRL))
+	crl, err := x509.ParseCRL(block.Bytes)
+	require.Nil(t, err)
+	aki, _, err := bcx509.GetAKIFromExtensions(crl.TBSCertList.Extensions)
+	require.Nil(t, err)
+	cp.indexCRL(aki, crl)
+
+	revoked, ok := new(big.Int).SetString("264ebb0d04763b18", 16)
+	require.True(t, ok)
+	revokedChain := []*bcx509.Certificate{{AuthorityKeyId: aki, SerialNumber: revoked}}
+	validChain := []*bcx509.Certificate{{AuthorityKeyId: aki, SerialNumber: big.NewInt(1)}}
+	require.NotNil(t, cp.checkCRL(revokedChain))
+	require.Nil(t, cp.checkCRL(validChain))
+	require.NotNil(t, cp.checkCRL(nil))
+
+	// the CRL is expired since 2021-06-18 09:41:28, which never fails the verification
+	require.Nil(t, SetCRLExpiryPolicy(CRLExpiryPolicyReject))
+	require.Nil(t, cp.checkCRL(validChain))
+	require.NotNil(t, cp.checkCRL(revokedChain))
+	err = cp.checkCRLExpiry(validChain, time.Now())
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "expired")
+	require.Nil(t, cp.checkCRLExpiry(validChain, time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)))
+	require.Nil(t, SetCRLExpiryPolicy(CRLExpiryPolicyIgnore))
+	require.Nil(t, cp.checkCRL(validChain))
+	require.NotNil(t, SetCRLExpiryPolicy("deny"))
+
+	lists := cp.GetRevocationLists()
+	require.Len(t, lists, 1)
+	require.Equal(t, 1, lists[0].Count)
+	require.True(t, lists[0].Expired)
+	require.Contains(t, lists[0].Issuer, "ca.org")
+	require.Equal(t, time.Date(2021, 6, 18, 9, 41, 28, 0, time.UTC), lists[0].NextUpdate.UTC())
+}
@@ -0,0 +1,195 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package accesscontrol
+
+import (
+	"fmt"
+
+	pbac "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	"chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// AuthorizationExplainer the access control provider which explains how the endorsements are judged by the
+// endorsement policy of a resource, without submitting anything
+type AuthorizationExplainer interface {
+	// ExplainAuthorization judge the endorsements by the endorsement policy of the resource as of the block
+	// version. The signatures are verified against the message unless it is nil, targetOrgId is the owner of the
+	// affected target required by the SELF rule.
+	ExplainAuthorization(resourceName string, endorsements []*common.EndorsementEntry, message []byte,
+		targetOrgId string, blockVersion uint32) (*AuthorizationExplanation, error)
+}
+
+// AuthorizationExplanation the endorsement policy resolved for a resource, the verdict of each endorsement and the
+// overall decision
+type AuthorizationExplanation struct {
+	ResourceName string `json:"resource_name"`
+	// nil if the resource has no endorsement policy, which allows any endorsements
+	Policy            *pbac.Policy          `json:"policy,omitempty"`
+	SignatureVerified bool                  `json:"signature_verified"`
+	Endorsements      []*EndorsementVerdict `json:"endorsements"`
+	Allowed           bool                  `json:"allowed"`
+	// why the endorsements are rejected, or why they are allowed without being judged
+	Reason string `json:"reason,omitempty"`
+}
+
+// EndorsementVerdict whether an endorsement is counted by the policy, and why not if it is not
+type EndorsementVerdict struct {
+	Index int `json:"index"`
+	// the organization the signer claims, and the member id and the role of the signer if it is resolved
+	OrgId    string `json:"org_id"`
+	MemberId string `json:"member_id,omitempty"`
+	Role     string `json:"role,omitempty"`
+	Counted  bool   `json:"counted"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+var _ AuthorizationExplainer = (*certACProvider)(nil)
+var _ AuthorizationExplainer = (*permissionedPkACProvider)(nil)
+
+// ExplainAuthorization judge the endorsements by the endorsement policy of the resource, see AuthorizationExplainer
+func (cp *certACProvider) ExplainAuthorization(resourceName string, endorsements []*common.EndorsementEntry,
+	message []byte, targetOrgId string, blockVersion uint32) (*AuthorizationExplanation, error) {
+	return explainAuthorization(cp, cp.acService, cp.refineEndorsement, resourceName, endorsements, message,
+		targetOrgId, blockVersion)
+}
+
+// ExplainAuthorization judge the endorsements by the endorsement policy of the resource, see AuthorizationExplainer
+func (pp *permissionedPkACProvider) ExplainAuthorization(resourceName string,
+	endorsements []*common.EndorsementEntry, message []byte, targetOrgId string,
+	blockVersion uint32) (*AuthorizationExplanation, error) {
+	return explainAuthorization(pp, pp.acService, pp.refineEndorsement, resourceName, endorsements, message,
+		targetOrgId, blockVersion)
+}
+
+// explainAuthorization give a verdict on each endorsement, then decide by verifyPrincipalPolicy with the
+// endorsements whose signers are valid, the same way as verifyEndorsementsPrincipal does. The signers are refined
+// by refineEndorsement, the helper of RefineEndorsements, which returns why an endorsement is refused.
+func explainAuthorization(p acProvider, acs *accessControlService,
+	refineEndorsement func(*common.EndorsementEntry, []byte, bool) (*common.EndorsementEntry, protocol.Member, error),
+	resourceName string, endorsements []*common.EndorsementEntry, message []byte, targetOrgId string,
+	blockVersion uint32) (*AuthorizationExplanation, error) {
+	if blockVersion < blockVersion2330 {
+		return nil, fmt.Errorf("explaining the authorization is not supported by block version %d", blockVersion)
+	}
+	pol, err := p.findFromEndorsementsPolicies(resourceName, blockVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &AuthorizationExplanation{
+		ResourceName:      resourceName,
+		SignatureVerified: message != nil,
+		Endorsements:      make([]*EndorsementVerdict, 0, len(endorsements)),
+	}
+	if pol == nil {
+		explanation.Allowed = true
+		explanation.Reason = "the resource has no endorsement policy"
+		return explanation, nil
+	}
+	explanation.Policy = pol.GetPbPolicy()
+
+	var refined []*common.EndorsementEntry
+	signers := make(map[string]int)
+	for i, entry := range endorsements {
+		verdict := &EndorsementVerdict{Index: i}
+		explanation.Endorsements = append(explanation.Endorsements, verdict)
+		if entry == nil || entry.Signer == nil {
+			verdict.Reason = "empty signer"
+			continue
+		}
+		verdict.OrgId = entry.Signer.OrgId
+
+		endorsement, member, signerErr := refineEndorsement(entry, message, message != nil)
+		if member != nil {
+			verdict.MemberId, verdict.Role = member.GetMemberId(), string(member.GetRole())
+		}
+		if signerErr != nil {
+			verdict.Reason = signerErr.Error()
+			continue
+		}
+		signer := string(endorsement.Signer.MemberInfo)
+		if first, exist := signers[signer]; exist {
+			verdict.Reason = fmt.Sprintf("duplicated signer of endorsement %d", first)
+			continue
+		}
+		signers[signer] = i
+		refined = append(refined, endorsement)
+
+		verdict.Reason = explainPolicyMatch(pol, member, targetOrgId)
+		verdict.Counted = verdict.Reason == ""
+	}
+	if len(refined) == 0 {
+		explanation.Reason = "all endorsers have failed verification"
+		return explanation, nil
+	}
+
+	// a principal requires a message, the signatures are not verified by verifyPrincipalPolicy anyway
+	msg := message
+	if msg == nil {
+		msg = []byte{}
+	}
+	var principal protocol.Principal
+	if pol.GetRule() == protocol.RuleSelf {
+		principal, err = p.CreatePrincipalForTargetOrg(resourceName, refined, msg, targetOrgId)
+	} else {
+		principal, err = p.CreatePrincipal(resourceName, refined, msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	explanation.Allowed, err = p.verifyPrincipalPolicy(principal, principal, pol)
+	if err != nil {
+		explanation.Reason = err.Error()
+	}
+	acs.log.Debugf("explain authorization of [%s]: allowed: %v, %s", resourceName, explanation.Allowed,
+		explanation.Reason)
+	return explanation, nil
+}
+
+// explainPolicyMatch returns why the policy does not count the valid signer, empty if it does. Whether the counted
+// signers are enough is decided by the policy.
+func explainPolicyMatch(pol *policy, member protocol.Member, targetOrgId string) string {
+	orgId, role := member.GetOrgId(), member.GetRole()
+	switch pol.GetRule() {
+	case protocol.RuleForbidden:
+		return "the resource is forbidden to access"
+	case protocol.RuleSelf:
+		if orgId != targetOrgId {
+			return fmt.Sprintf("signer's organization [%s] is not the target organization [%s]", orgId, targetOrgId)
+		}
+		return explainRoleMatch(role, []protocol.Role{protocol.RoleAdmin})
+	case protocol.RuleMajority:
+		return explainRoleMatch(role, []protocol.Role{protocol.RoleAdmin})
+	}
+
+	if pol.expr != nil {
+		signer := &policySigner{uid: member.GetUid(), orgId: orgId, role: role}
+		if !pol.expr.matchesAny(signer) {
+			return fmt.Sprintf("signer of organization [%s] and role [%s] matches no clause of the policy "+
+				"expression", orgId, role)
+		}
+		return ""
+	}
+	orgList, _ := buildOrgListRoleListOfPolicyForVerifyPrincipal(pol)
+	if len(orgList) > 0 && !orgList[orgId] {
+		return fmt.Sprintf("signer's organization [%s] is not permitted, requires %v", orgId, pol.GetOrgList())
+	}
+	if len(pol.GetRoleList()) > 0 {
+		return explainRoleMatch(role, pol.GetRoleList())
+	}
+	return ""
+}
+
+func explainRoleMatch(role protocol.Role, roleList []protocol.Role) string {
+	for _, r := range roleList {
+		if r == role {
+			return ""
+		}
+	}
+	return fmt.Sprintf("signer's role [%s] is not permitted, requires %v", role, roleList)
+}
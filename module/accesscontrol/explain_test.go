@@ -0,0 +1,87 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package accesscontrol
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func testEndorsement(t *testing.T, signer protocol.SigningMember, msg []byte) *common.EndorsementEntry {
+	signature, err := signer.Sign(testPKHashType, msg)
+	require.Nil(t, err)
+	member, err := signer.GetMember()
+	require.Nil(t, err)
+	return &common.EndorsementEntry{Signer: member, Signature: signature}
+}
+
+func TestCert_ExplainAuthorization(t *testing.T) {
+	testCertOrgMember := testInitCertFunc(t)
+	orgMemberInfo1 := testCertOrgMember[testOrg1]
+	orgMemberInfo2 := testCertOrgMember[testOrg2]
+	orgMemberInfo3 := testCertOrgMember[testOrg3]
+	explainer, ok := orgMemberInfo1.acProvider.(AuthorizationExplainer)
+	require.True(t, ok)
+
+	msg := []byte(testMsg)
+	endorsements := []*common.EndorsementEntry{
+		testEndorsement(t, orgMemberInfo1.admin, msg),
+		testEndorsement(t, orgMemberInfo2.client, msg),
+		testEndorsement(t, orgMemberInfo1.admin, msg),
+		testEndorsement(t, orgMemberInfo3.admin, []byte("another message")),
+		nil,
+	}
+
+	// ALL of the admins of the organizations
+	explanation, err := explainer.ExplainAuthorization("TEST_CONTRACT-TEST_METHOD_ALL", endorsements, msg, "",
+		blockVersion2330)
+	require.Nil(t, err)
+	require.Equal(t, string(protocol.RuleAll), explanation.Policy.Rule)
+	require.True(t, explanation.SignatureVerified)
+	require.False(t, explanation.Allowed)
+	require.NotEmpty(t, explanation.Reason)
+	require.Len(t, explanation.Endorsements, len(endorsements))
+
+	verdicts := explanation.Endorsements
+	require.True(t, verdicts[0].Counted)
+	require.Equal(t, testOrg1, verdicts[0].OrgId)
+	require.Equal(t, string(protocol.RoleAdmin), verdicts[0].Role)
+	require.False(t, verdicts[1].Counted)
+	require.Contains(t, verdicts[1].Reason, "role")
+	require.False(t, verdicts[2].Counted)
+	require.Contains(t, verdicts[2].Reason, "duplicated")
+	require.False(t, verdicts[3].Counted)
+	require.Contains(t, verdicts[3].Reason, "signature")
+	require.False(t, verdicts[4].Counted)
+
+	// the signers judged by the policy are the ones RefineEndorsements keeps
+	refined := orgMemberInfo1.acProvider.(acProvider).RefineEndorsements(endorsements[:4], msg)
+	require.Len(t, refined, 2)
+	require.Equal(t, verdicts[0].OrgId, refined[0].Signer.OrgId)
+	require.Equal(t, verdicts[1].OrgId, refined[1].Signer.OrgId)
+
+	// the signatures are not verified without the message
+	explanation, err = explainer.ExplainAuthorization("TEST_CONTRACT-TEST_METHOD_ALL", endorsements[3:4], nil, "",
+		blockVersion2330)
+	require.Nil(t, err)
+	require.False(t, explanation.SignatureVerified)
+	require.True(t, explanation.Endorsements[0].Counted)
+
+	// the resource without an endorsement policy
+	explanation, err = explainer.ExplainAuthorization("TEST_CONTRACT-TEST_METHOD_NONE", endorsements, msg, "",
+		blockVersion2330)
+	require.Nil(t, err)
+	require.True(t, explanation.Allowed)
+	require.Nil(t, explanation.Policy)
+
+	_, err = explainer.ExplainAuthorization("TEST_CONTRACT-TEST_METHOD_ALL", endorsements, msg, "",
+		blockVersion2320)
+	require.NotNil(t, err)
+}
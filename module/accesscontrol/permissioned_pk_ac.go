@@ -88,6 +88,7 @@ func newPermissionedPkACProvider(chainConfig *config.ChainConfig, localOrgId str
 	chainConfig.AuthType = strings.ToLower(chainConfig.AuthType)
 	ppacProvider.acService = initAccessControlService(chainConfig.GetCrypto().Hash,
 		chainConfig.AuthType, chainConfig.Vm.AddrType, store, log)
+	ppacProvider.acService.memberCache.EnableMetrics(chainConfig.ChainId, memberCacheName)
 	ppacProvider.acService.pwkNewMember = ppacProvider.NewMemberFromAcs
 
 	err := ppacProvider.initAdminMembers(chainConfig.TrustRoots)
@@ -100,6 +101,7 @@ func newPermissionedPkACProvider(chainConfig *config.ChainConfig, localOrgId str
 		return nil, err
 	}
 
+	ppacProvider.acService.setBlockVersion(chainConfig.GetBlockVersion())
 	ppacProvider.acService.initResourcePolicy(chainConfig.ResourcePolicies, localOrgId)
 
 	return ppacProvider, nil
@@ -192,30 +194,13 @@ func (pp *permissionedPkACProvider) RefineEndorsements(endorsements []*common.En
 		if endorsementEntry == nil || endorsementEntry.Signer == nil {
 			continue
 		}
-		endorsement := &common.EndorsementEntry{
-			Signer: &pbac.Member{
-				OrgId:      endorsementEntry.Signer.OrgId,
-				MemberInfo: endorsementEntry.Signer.MemberInfo,
-				MemberType: endorsementEntry.Signer.MemberType,
-			},
-			Signature: endorsementEntry.Signature,
-		}
-
-		memInfo := string(endorsement.Signer.MemberInfo)
-
-		remoteMember, err := pp.NewMember(endorsement.Signer)
+		endorsement, _, err := pp.refineEndorsement(endorsementEntry, msg, true)
 		if err != nil {
-			pp.acService.log.Infof("new member failed: [%s]", err.Error())
-			continue
-		}
-
-		if err := remoteMember.Verify(pp.GetHashAlg(), msg, endorsement.Signature); err != nil {
-			pp.acService.log.Infof("signer member verify signature failed: [%s]", err.Error())
-			pp.acService.log.Debugf("information for invalid signature:\norganization: %s\npubkey: %s\nmessage: %s\n"+
-				"signature: %s", endorsement.Signer.OrgId, memInfo, hex.Dump(msg), hex.Dump(endorsement.Signature))
+			pp.acService.log.Info(err.Error())
 			continue
 		}
 
+		memInfo := string(endorsement.Signer.MemberInfo)
 		if _, ok := refinedSigners[memInfo]; !ok {
 			refinedSigners[memInfo] = true
 			refinedEndorsement = append(refinedEndorsement, endorsement)
@@ -224,6 +209,37 @@ func (pp *permissionedPkACProvider) RefineEndorsements(endorsements []*common.En
 	return refinedEndorsement
 }
 
+// refineEndorsement resolve the signer of the endorsement, and verify the signature against msg if
+// verifySignature. The returned member is the signer if it is resolved, even when the endorsement is refused.
+func (pp *permissionedPkACProvider) refineEndorsement(endorsementEntry *common.EndorsementEntry, msg []byte,
+	verifySignature bool) (*common.EndorsementEntry, protocol.Member, error) {
+
+	endorsement := &common.EndorsementEntry{
+		Signer: &pbac.Member{
+			OrgId:      endorsementEntry.Signer.OrgId,
+			MemberInfo: endorsementEntry.Signer.MemberInfo,
+			MemberType: endorsementEntry.Signer.MemberType,
+		},
+		Signature: endorsementEntry.Signature,
+	}
+
+	remoteMember, err := pp.NewMember(endorsement.Signer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("new member failed: [%s]", err.Error())
+	}
+
+	if !verifySignature {
+		return endorsement, remoteMember, nil
+	}
+	if err := remoteMember.Verify(pp.GetHashAlg(), msg, endorsement.Signature); err != nil {
+		pp.acService.log.Debugf("information for invalid signature:\norganization: %s\npubkey: %s\nmessage: %s\n"+
+			"signature: %s", endorsement.Signer.OrgId, string(endorsement.Signer.MemberInfo), hex.Dump(msg),
+			hex.Dump(endorsement.Signature))
+		return nil, remoteMember, fmt.Errorf("signer member verify signature failed: [%s]", err.Error())
+	}
+	return endorsement, remoteMember, nil
+}
+
 // NewMember creates a member from pb Member
 func (pp *permissionedPkACProvider) NewMember(member *pbac.Member) (protocol.Member, error) {
 	return pp.acService.newPkMember(member, pp.adminMember, pp.consensusMember)
@@ -67,6 +67,7 @@ func (pp *permissionedPkACProvider) onMessageChainConfig(msg *msgbus.Message) {
 		pp.acService.log.Error(err)
 	}
 
+	pp.acService.setBlockVersion(chainConfig.GetBlockVersion())
 	pp.acService.initResourcePolicy(chainConfig.ResourcePolicies, pp.localOrg)
 
 	pp.acService.memberCache.Clear()
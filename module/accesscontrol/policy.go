@@ -18,6 +18,8 @@ type policy struct {
 	rule     protocol.Rule
 	orgList  []string
 	roleList []protocol.Role
+	// the parsed rule if it is a policy expression
+	expr *policyExpr
 }
 
 func (p *policy) GetRule() protocol.Rule {
@@ -66,6 +68,11 @@ func newPolicyFromPb(input *pbac.Policy) *policy {
 		p.roleList = append(p.roleList, protocol.Role(role))
 	}
 
+	// the expression is validated with the resource policy before the policy is loaded
+	if isPolicyExprRule(input.Rule) {
+		p.expr, _ = parsePolicyExpr(input.Rule)
+	}
+
 	return p
 }
 
@@ -0,0 +1,609 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package accesscontrol
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// A policy expression combines the endorsement requirements of several organizations and roles. The rule of the
+// policy is RULE_EXPR_PREFIX followed by the expression:
+//
+//	expr    := and { OR and }
+//	and     := unary { AND unary }
+//	unary   := NOT unary | "(" expr ")" | clause
+//	clause  := threshold roles OF orgs            at least threshold distinct signers of the roles and the orgs
+//	         | threshold roles WEIGHTED weights   the orgs with a signer of the roles weigh at least threshold
+//	roles   := "*" | role | "[" role { "," role } "]"
+//	orgs    := "*" | orgId | "[" orgId { "," orgId } "]"
+//	weights := "[" orgId ":" weight { "," orgId ":" weight } "]"
+//
+// The keywords and the roles are case-insensitive, e.g.
+//
+//	EXPR: 2 ADMIN OF org1 AND (1 CONSENSUS OF [org2, org3] OR 3 ADMIN WEIGHTED [org2:2, org3:1, org4:1])
+//
+// A NOT only narrows the endorsements required by the other operands of an AND, an expression which is satisfied
+// without any clause being satisfied, such as NOT 1 ADMIN OF org2, is rejected.
+
+type policyExprOp int
+
+const (
+	policyExprOpClause policyExprOp = iota
+	policyExprOpAnd
+	policyExprOpOr
+	policyExprOpNot
+)
+
+const (
+	policyExprKeywordAnd      = "AND"
+	policyExprKeywordOr       = "OR"
+	policyExprKeywordNot      = "NOT"
+	policyExprKeywordOf       = "OF"
+	policyExprKeywordWeighted = "WEIGHTED"
+	policyExprWildcard        = "*"
+
+	// the maximum nesting of the NOT operators and the parentheses
+	maxPolicyExprDepth = 16
+)
+
+// the roles allowed in a policy expression
+var policyExprRoles = map[protocol.Role]bool{
+	protocol.RoleAdmin:         true,
+	protocol.RoleClient:        true,
+	protocol.RoleLight:         true,
+	protocol.RoleConsensusNode: true,
+	protocol.RoleCommonNode:    true,
+}
+
+// policyExpr a node of a parsed policy expression
+type policyExpr struct {
+	op       policyExprOp
+	children []*policyExpr
+	clause   *policyExprClause
+}
+
+// policyExprClause the requirement of a clause, the empty roles or orgs match any of them
+type policyExprClause struct {
+	threshold int
+	roles     []protocol.Role
+	orgs      []string
+	// the weights of the orgs, not nil for a WEIGHTED clause
+	weights map[string]int
+}
+
+// policySigner the verified identity of an endorsement
+type policySigner struct {
+	uid   string
+	orgId string
+	role  protocol.Role
+}
+
+// isPolicyExprRule check whether the rule is a policy expression
+func isPolicyExprRule(rule string) bool {
+	return strings.HasPrefix(rule, RULE_EXPR_PREFIX)
+}
+
+// parsePolicyExpr parse the rule of a policy expression
+func parsePolicyExpr(rule string) (*policyExpr, error) {
+	if !isPolicyExprRule(rule) {
+		return nil, fmt.Errorf("policy expression should start with %s", RULE_EXPR_PREFIX)
+	}
+	tokens, err := tokenizePolicyExpr(strings.TrimPrefix(rule, RULE_EXPR_PREFIX))
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty policy expression")
+	}
+	parser := &policyExprParser{tokens: tokens}
+	expr, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !parser.done() {
+		return nil, fmt.Errorf("unexpected [%s] at position %d", parser.peek(), parser.pos)
+	}
+	return expr, nil
+}
+
+// tokenizePolicyExpr split the expression into the punctuations and the words between them
+func tokenizePolicyExpr(s string) ([]string, error) {
+	var (
+		tokens []string
+		word   strings.Builder
+	)
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case unicode.IsSpace(r):
+			flush()
+		case strings.ContainsRune("()[],:*", r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsPrint(r):
+			word.WriteRune(r)
+		default:
+			return nil, fmt.Errorf("invalid character %q in policy expression", r)
+		}
+	}
+	flush()
+	return tokens, nil
+}
+
+type policyExprParser struct {
+	tokens []string
+	pos    int
+	depth  int
+}
+
+func (p *policyExprParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *policyExprParser) peek() string {
+	if p.done() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *policyExprParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+// isKeyword check whether the next token is the keyword
+func (p *policyExprParser) isKeyword(keyword string) bool {
+	return strings.EqualFold(p.peek(), keyword)
+}
+
+func (p *policyExprParser) expect(token string) error {
+	if p.done() {
+		return fmt.Errorf("expect [%s] but the expression ends", token)
+	}
+	if !strings.EqualFold(p.peek(), token) {
+		return fmt.Errorf("expect [%s] but got [%s] at position %d", token, p.peek(), p.pos)
+	}
+	p.pos++
+	return nil
+}
+
+// enter a NOT or a parenthesized expression, the caller decreases the depth when it is parsed
+func (p *policyExprParser) enter() error {
+	if p.depth >= maxPolicyExprDepth {
+		return fmt.Errorf("policy expression is nested deeper than %d at position %d", maxPolicyExprDepth, p.pos)
+	}
+	p.depth++
+	return nil
+}
+
+func (p *policyExprParser) parseOr() (*policyExpr, error) {
+	return p.parseBinary(policyExprOpOr, policyExprKeywordOr, p.parseAnd)
+}
+
+func (p *policyExprParser) parseAnd() (*policyExpr, error) {
+	return p.parseBinary(policyExprOpAnd, policyExprKeywordAnd, p.parseUnary)
+}
+
+// parseBinary parse the operands joined by the keyword, a single operand is returned as it is
+func (p *policyExprParser) parseBinary(op policyExprOp, keyword string,
+	parseOperand func() (*policyExpr, error)) (*policyExpr, error) {
+	operand, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*policyExpr{operand}
+	for p.isKeyword(keyword) {
+		p.pos++
+		if operand, err = parseOperand(); err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &policyExpr{op: op, children: operands}, nil
+}
+
+func (p *policyExprParser) parseUnary() (*policyExpr, error) {
+	switch {
+	case p.done():
+		return nil, fmt.Errorf("expect a clause but the expression ends")
+	case p.isKeyword(policyExprKeywordNot):
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer func() { p.depth-- }()
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &policyExpr{op: policyExprOpNot, children: []*policyExpr{operand}}, nil
+	case p.peek() == "(":
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer func() { p.depth-- }()
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	default:
+		clause, err := p.parseClause()
+		if err != nil {
+			return nil, err
+		}
+		return &policyExpr{op: policyExprOpClause, clause: clause}, nil
+	}
+}
+
+func (p *policyExprParser) parseClause() (*policyExprClause, error) {
+	pos := p.pos
+	threshold, err := strconv.Atoi(p.next())
+	if err != nil || threshold <= 0 {
+		return nil, fmt.Errorf("expect a positive threshold at position %d", pos)
+	}
+	clause := &policyExprClause{threshold: threshold}
+	roles, err := p.parseList()
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		clause.roles = append(clause.roles, protocol.Role(strings.ToUpper(role)))
+	}
+
+	switch {
+	case p.isKeyword(policyExprKeywordOf):
+		p.pos++
+		clause.orgs, err = p.parseList()
+		if err != nil {
+			return nil, err
+		}
+	case p.isKeyword(policyExprKeywordWeighted):
+		p.pos++
+		clause.weights, err = p.parseWeights()
+		if err != nil {
+			return nil, err
+		}
+		for orgId := range clause.weights {
+			clause.orgs = append(clause.orgs, orgId)
+		}
+		sort.Strings(clause.orgs)
+	default:
+		return nil, fmt.Errorf("expect [%s] or [%s] but got [%s] at position %d", policyExprKeywordOf,
+			policyExprKeywordWeighted, p.peek(), p.pos)
+	}
+	return clause, nil
+}
+
+// parseList parse a word, a bracketed list of the words, or the wildcard which is returned as an empty list
+func (p *policyExprParser) parseList() ([]string, error) {
+	if p.peek() == policyExprWildcard {
+		p.pos++
+		return nil, nil
+	}
+	if p.peek() != "[" {
+		word, err := p.parseWord()
+		if err != nil {
+			return nil, err
+		}
+		return []string{word}, nil
+	}
+	p.pos++
+	var words []string
+	for {
+		word, err := p.parseWord()
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, word)
+		if p.peek() != "," {
+			break
+		}
+		p.pos++
+	}
+	return words, p.expect("]")
+}
+
+func (p *policyExprParser) parseWeights() (map[string]int, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+	weights := make(map[string]int)
+	for {
+		orgId, err := p.parseWord()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(":"); err != nil {
+			return nil, err
+		}
+		pos := p.pos
+		weight, err := strconv.Atoi(p.next())
+		if err != nil || weight <= 0 {
+			return nil, fmt.Errorf("expect a positive weight at position %d", pos)
+		}
+		if _, exist := weights[orgId]; exist {
+			return nil, fmt.Errorf("duplicated organization [%s] in weights", orgId)
+		}
+		weights[orgId] = weight
+		if p.peek() != "," {
+			break
+		}
+		p.pos++
+	}
+	return weights, p.expect("]")
+}
+
+func (p *policyExprParser) parseWord() (string, error) {
+	if p.done() {
+		return "", fmt.Errorf("expect a role or an organization but the expression ends")
+	}
+	word := p.peek()
+	if len(word) == 1 && strings.Contains("()[],:*", word) {
+		return "", fmt.Errorf("expect a role or an organization but got [%s] at position %d", word, p.pos)
+	}
+	p.pos++
+	return word, nil
+}
+
+// validate check that the expression requires an endorsement, the roles and the orgs of the clauses, and that the
+// WEIGHTED clauses can be satisfied
+func (e *policyExpr) validate(isKnownOrg func(orgId string) bool) error {
+	if !e.requiresEndorsement() {
+		return fmt.Errorf("policy expression [%s] is satisfied without any clause, "+
+			"a NOT should be an operand of an AND with a clause which is not negated", e)
+	}
+	return e.validateClauses(isKnownOrg)
+}
+
+// requiresEndorsement check that the expression is only satisfied along with one of its clauses which is not
+// negated, otherwise the endorsements unrelated to the expression would satisfy it
+func (e *policyExpr) requiresEndorsement() bool {
+	switch e.op {
+	case policyExprOpAnd:
+		for _, child := range e.children {
+			if child.requiresEndorsement() {
+				return true
+			}
+		}
+		return false
+	case policyExprOpOr:
+		for _, child := range e.children {
+			if !child.requiresEndorsement() {
+				return false
+			}
+		}
+		return true
+	case policyExprOpNot:
+		return false
+	default:
+		return true
+	}
+}
+
+// validateClauses check the roles and the orgs of the clauses, and that the WEIGHTED clauses can be satisfied
+func (e *policyExpr) validateClauses(isKnownOrg func(orgId string) bool) error {
+	if e.op != policyExprOpClause {
+		for _, child := range e.children {
+			if err := child.validateClauses(isKnownOrg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	c := e.clause
+	roles := make(map[protocol.Role]bool, len(c.roles))
+	for _, role := range c.roles {
+		if !policyExprRoles[role] {
+			return fmt.Errorf("unknown role [%s] in clause [%s]", role, c)
+		}
+		if roles[role] {
+			return fmt.Errorf("duplicated role [%s] in clause [%s]", role, c)
+		}
+		roles[role] = true
+	}
+	orgs := make(map[string]bool, len(c.orgs))
+	for _, orgId := range c.orgs {
+		if !isKnownOrg(orgId) {
+			return fmt.Errorf("unknown organization [%s] in clause [%s]", orgId, c)
+		}
+		if orgs[orgId] {
+			return fmt.Errorf("duplicated organization [%s] in clause [%s]", orgId, c)
+		}
+		orgs[orgId] = true
+	}
+	if c.weights != nil {
+		total := 0
+		for _, weight := range c.weights {
+			total += weight
+		}
+		if total < c.threshold {
+			return fmt.Errorf("threshold %d exceeds the total weight %d in clause [%s]", c.threshold, total, c)
+		}
+	}
+	return nil
+}
+
+// evaluate check whether the signers satisfy the expression
+func (e *policyExpr) evaluate(signers []*policySigner) bool {
+	switch e.op {
+	case policyExprOpAnd:
+		for _, child := range e.children {
+			if !child.evaluate(signers) {
+				return false
+			}
+		}
+		return true
+	case policyExprOpOr:
+		for _, child := range e.children {
+			if child.evaluate(signers) {
+				return true
+			}
+		}
+		return false
+	case policyExprOpNot:
+		return !e.children[0].evaluate(signers)
+	default:
+		return e.clause.count(signers) >= e.clause.threshold
+	}
+}
+
+// count the distinct signers of the clause, or the total weight of their orgs for a WEIGHTED clause
+func (c *policyExprClause) count(signers []*policySigner) int {
+	counted := make(map[string]bool)
+	total := 0
+	for _, signer := range signers {
+		if !c.matches(signer) {
+			continue
+		}
+		key := signer.orgId + "/" + signer.uid
+		if c.weights != nil {
+			key = signer.orgId
+		}
+		if counted[key] {
+			continue
+		}
+		counted[key] = true
+		if c.weights != nil {
+			total += c.weights[signer.orgId]
+		} else {
+			total++
+		}
+	}
+	return total
+}
+
+// matchesAny check whether the signer matches any of the clauses, otherwise it never counts for the expression
+func (e *policyExpr) matchesAny(signer *policySigner) bool {
+	if e.op == policyExprOpClause {
+		return e.clause.matches(signer)
+	}
+	for _, child := range e.children {
+		if child.matchesAny(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *policyExprClause) matches(signer *policySigner) bool {
+	if len(c.orgs) > 0 && !containsString(c.orgs, signer.orgId) {
+		return false
+	}
+	if len(c.roles) == 0 {
+		return true
+	}
+	for _, role := range c.roles {
+		if role == signer.role {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// String the expression in the canonical form
+func (e *policyExpr) String() string {
+	return e.format(nil)
+}
+
+// describe the expression with how much each clause is satisfied by the signers
+func (e *policyExpr) describe(signers []*policySigner) string {
+	if signers == nil {
+		signers = []*policySigner{}
+	}
+	return e.format(signers)
+}
+
+func (e *policyExpr) format(signers []*policySigner) string {
+	switch e.op {
+	case policyExprOpAnd, policyExprOpOr:
+		keyword := policyExprKeywordAnd
+		if e.op == policyExprOpOr {
+			keyword = policyExprKeywordOr
+		}
+		operands := make([]string, 0, len(e.children))
+		for _, child := range e.children {
+			operand := child.format(signers)
+			// AND binds tighter than OR, so only an OR inside an AND needs the parentheses
+			if child.op == policyExprOpOr && e.op == policyExprOpAnd {
+				operand = "(" + operand + ")"
+			}
+			operands = append(operands, operand)
+		}
+		return strings.Join(operands, " "+keyword+" ")
+	case policyExprOpNot:
+		operand := e.children[0].format(signers)
+		if e.children[0].op == policyExprOpAnd || e.children[0].op == policyExprOpOr {
+			operand = "(" + operand + ")"
+		}
+		return policyExprKeywordNot + " " + operand
+	default:
+		if signers == nil {
+			return e.clause.String()
+		}
+		return fmt.Sprintf("%s {%d}", e.clause, e.clause.count(signers))
+	}
+}
+
+// String the clause in the canonical form
+func (c *policyExprClause) String() string {
+	roles := make([]string, 0, len(c.roles))
+	for _, role := range c.roles {
+		roles = append(roles, string(role))
+	}
+	if c.weights == nil {
+		return fmt.Sprintf("%d %s %s %s", c.threshold, formatPolicyExprList(roles), policyExprKeywordOf,
+			formatPolicyExprList(c.orgs))
+	}
+	weights := make([]string, 0, len(c.orgs))
+	for _, orgId := range c.orgs {
+		weights = append(weights, fmt.Sprintf("%s:%d", orgId, c.weights[orgId]))
+	}
+	return fmt.Sprintf("%d %s %s [%s]", c.threshold, formatPolicyExprList(roles), policyExprKeywordWeighted,
+		strings.Join(weights, ", "))
+}
+
+func formatPolicyExprList(list []string) string {
+	switch len(list) {
+	case 0:
+		return policyExprWildcard
+	case 1:
+		return list[0]
+	default:
+		return "[" + strings.Join(list, ", ") + "]"
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package accesscontrol
+
+import (
+	"strings"
+	"testing"
+
+	"chainmaker.org/chainmaker/protocol/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicyExpr(t *testing.T) {
+	tests := []struct {
+		rule      string
+		canonical string
+	}{
+		{"EXPR: 2 admin OF org1", "2 ADMIN OF org1"},
+		{"EXPR:1 * of *", "1 * OF *"},
+		{"EXPR: 1 [admin,client] OF [org1, org2]", "1 [ADMIN, CLIENT] OF [org1, org2]"},
+		{"EXPR: 3 ADMIN WEIGHTED [org3:1, org1:2]", "3 ADMIN WEIGHTED [org1:2, org3:1]"},
+		{"EXPR: 1 ADMIN OF a and 1 ADMIN OF b or 1 ADMIN OF c",
+			"1 ADMIN OF a AND 1 ADMIN OF b OR 1 ADMIN OF c"},
+		{"EXPR: 1 ADMIN OF a AND (1 ADMIN OF b OR 1 ADMIN OF c)",
+			"1 ADMIN OF a AND (1 ADMIN OF b OR 1 ADMIN OF c)"},
+		{"EXPR: NOT NOT (1 CLIENT OF a AND 1 ADMIN OF b)", "NOT NOT (1 CLIENT OF a AND 1 ADMIN OF b)"},
+		{"EXPR: ((1 ADMIN OF wx-org1.chainmaker.org))", "1 ADMIN OF wx-org1.chainmaker.org"},
+	}
+	for _, tt := range tests {
+		expr, err := parsePolicyExpr(tt.rule)
+		require.NoError(t, err, tt.rule)
+		require.Equal(t, tt.canonical, expr.String(), tt.rule)
+
+		// the canonical form is parsed into the same expression
+		reparsed, err := parsePolicyExpr(RULE_EXPR_PREFIX + expr.String())
+		require.NoError(t, err)
+		require.Equal(t, expr, reparsed)
+	}
+
+	invalid := []string{
+		"2 ADMIN OF org1",
+		"EXPR:",
+		"EXPR: 0 ADMIN OF org1",
+		"EXPR: two ADMIN OF org1",
+		"EXPR: 2 ADMIN org1",
+		"EXPR: 2 ADMIN OF",
+		"EXPR: 2 ADMIN OF [org1,",
+		"EXPR: 2 ADMIN OF [org1] AND",
+		"EXPR: (2 ADMIN OF org1",
+		"EXPR: 2 ADMIN OF org1)",
+		"EXPR: 2 ADMIN WEIGHTED org1",
+		"EXPR: 2 ADMIN WEIGHTED [org1:0]",
+		"EXPR: 2 ADMIN WEIGHTED [org1:1, org1:2]",
+		"EXPR: 2 ADMIN OF org1 org2",
+		"EXPR: " + strings.Repeat("(", maxPolicyExprDepth+1) + "1 ADMIN OF org1" +
+			strings.Repeat(")", maxPolicyExprDepth+1),
+		"EXPR: " + strings.Repeat("NOT ", maxPolicyExprDepth+1) + "1 ADMIN OF org1",
+	}
+	for _, rule := range invalid {
+		_, err := parsePolicyExpr(rule)
+		require.Error(t, err, rule)
+	}
+}
+
+func TestValidatePolicyExpr(t *testing.T) {
+	isKnownOrg := func(orgId string) bool {
+		return orgId == "org1" || orgId == "org2"
+	}
+	tests := []struct {
+		rule  string
+		valid bool
+	}{
+		{"EXPR: 1 [admin, consensus, common, client, light] OF [org1, org2]", true},
+		{"EXPR: 3 ADMIN WEIGHTED [org1:2, org2:1]", true},
+		{"EXPR: 1 ADMIN OF org3", false},
+		{"EXPR: 1 ADMIN OF org1 OR NOT 1 ADMIN OF org3", false},
+		{"EXPR: 1 ADMIN OF [org1, org1]", false},
+		{"EXPR: 1 OWNER OF org1", false},
+		{"EXPR: 1 [ADMIN, admin] OF org1", false},
+		{"EXPR: 4 ADMIN WEIGHTED [org1:2, org2:1]", false},
+		{"EXPR: 1 ADMIN OF org1 AND NOT 1 CLIENT OF org2", true},
+		{"EXPR: 1 ADMIN OF org1 AND (1 ADMIN OF org2 OR NOT 1 CLIENT OF org2)", true},
+		{"EXPR: (1 ADMIN OF org1 AND NOT 1 CLIENT OF org2) OR 1 ADMIN OF org2", true},
+		// satisfied without any clause, e.g. by an endorsement of org1
+		{"EXPR: NOT 1 ADMIN OF org2", false},
+		{"EXPR: NOT NOT 1 ADMIN OF org2", false},
+		{"EXPR: 1 ADMIN OF org1 OR NOT 1 ADMIN OF org2", false},
+		{"EXPR: NOT 1 ADMIN OF org1 AND NOT 1 ADMIN OF org2", false},
+		{"EXPR: " + strings.Repeat("(", maxPolicyExprDepth) + "1 ADMIN OF org1" +
+			strings.Repeat(")", maxPolicyExprDepth), true},
+	}
+	for _, tt := range tests {
+		expr, err := parsePolicyExpr(tt.rule)
+		require.NoError(t, err, tt.rule)
+		err = expr.validate(isKnownOrg)
+		if tt.valid {
+			require.NoError(t, err, tt.rule)
+		} else {
+			require.Error(t, err, tt.rule)
+		}
+	}
+}
+
+func TestEvaluatePolicyExpr(t *testing.T) {
+	admin1a := &policySigner{uid: "a1", orgId: "org1", role: protocol.RoleAdmin}
+	admin1b := &policySigner{uid: "b1", orgId: "org1", role: protocol.RoleAdmin}
+	consensus2 := &policySigner{uid: "c2", orgId: "org2", role: protocol.RoleConsensusNode}
+	consensus3 := &policySigner{uid: "c3", orgId: "org3", role: protocol.RoleConsensusNode}
+	admin2 := &policySigner{uid: "a2", orgId: "org2", role: protocol.RoleAdmin}
+	admin3 := &policySigner{uid: "a3", orgId: "org3", role: protocol.RoleAdmin}
+	client4 := &policySigner{uid: "d4", orgId: "org4", role: protocol.RoleClient}
+
+	expr, err := parsePolicyExpr("EXPR: 2 ADMIN OF org1 AND (1 CONSENSUS OF [org2, org3] OR " +
+		"3 ADMIN WEIGHTED [org2:2, org3:1, org4:1]) AND NOT 1 CLIENT OF org4")
+	require.NoError(t, err)
+
+	tests := []struct {
+		signers   []*policySigner
+		satisfied bool
+	}{
+		{[]*policySigner{admin1a, admin1b, consensus3}, true},
+		{[]*policySigner{admin1a, admin1b, admin2, admin3}, true},
+		// the same signer is counted once
+		{[]*policySigner{admin1a, admin1a, consensus2}, false},
+		// a weighted org is counted once however many signers it has
+		{[]*policySigner{admin1a, admin1b, admin3, {uid: "b3", orgId: "org3", role: protocol.RoleAdmin}}, false},
+		{[]*policySigner{admin1a, admin1b, consensus2, client4}, false},
+		{nil, false},
+	}
+	for i, tt := range tests {
+		require.Equal(t, tt.satisfied, expr.evaluate(tt.signers), "case %d", i)
+	}
+
+	require.Equal(t, "2 ADMIN OF org1 {1} AND (1 CONSENSUS OF [org2, org3] {1} OR "+
+		"3 ADMIN WEIGHTED [org2:2, org3:1, org4:1] {0}) AND NOT 1 CLIENT OF org4 {1}",
+		expr.describe([]*policySigner{admin1a, consensus2, client4}))
+}
+
+func TestPolicyExprBlockVersion(t *testing.T) {
+	// the chains created by the releases without the policy expressions never reach the block version
+	require.Less(t, protocol.DefaultBlockVersion, blockVersion2380)
+}
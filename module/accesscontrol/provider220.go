@@ -51,6 +51,7 @@ func (cp *certACProvider) Watch(chainConfig *config.ChainConfig) error {
 		return err
 	}
 
+	cp.acService.setBlockVersion(chainConfig.GetBlockVersion())
 	cp.acService.initResourcePolicy(chainConfig.ResourcePolicies, cp.localOrg.id)
 	cp.acService.initResourcePolicy_220(chainConfig.ResourcePolicies, cp.localOrg.id)
 
@@ -116,6 +117,7 @@ func (cp *certACProvider) systemContractCallbackCertManagementCertFreezeCase(pay
 
 				certBlock, rest = pem.Decode(rest)
 			}
+			cp.removeRevokedMembers()
 			return nil
 		}
 	}
@@ -153,8 +155,9 @@ func (cp *certACProvider) systemContractCallbackCertManagementCertRevokeCase(pay
 				if err != nil {
 					return fmt.Errorf("update CRL failed: %v", err)
 				}
-				cp.crl.Store(string(aki), crl)
+				cp.indexCRL(aki, crl)
 			}
+			cp.removeRevokedMembers()
 			return nil
 		}
 	}
@@ -241,6 +244,7 @@ func (pp *permissionedPkACProvider) Watch(chainConfig *config.ChainConfig) error
 		return fmt.Errorf("update chainconfig error: %s", err.Error())
 	}
 
+	pp.acService.setBlockVersion(chainConfig.GetBlockVersion())
 	pp.acService.initResourcePolicy(chainConfig.ResourcePolicies, pp.localOrg)
 	pp.acService.initResourcePolicy_220(chainConfig.ResourcePolicies, pp.localOrg)
 
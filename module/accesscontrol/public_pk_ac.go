@@ -178,7 +178,7 @@ func newPkACProvider(chainConfig *config.ChainConfig,
 		addressType:               chainConfig.Vm.AddrType,
 		adminMember:               &sync.Map{},
 		consensusMember:           &sync.Map{},
-		memberCache:               NewShardCache(GetCertCacheSize()),
+		memberCache:               NewShardCacheWithTTL(GetCertCacheSize(), memberCacheTTL),
 		log:                       log,
 		dataStore:                 store,
 		txTypePolicyMap:           &sync.Map{},
@@ -192,6 +192,7 @@ func newPkACProvider(chainConfig *config.ChainConfig,
 		latestPolicyMap:           &sync.Map{},
 		payerList:                 NewShardCache(GetCertCacheSize()),
 	}
+	pkAcProvider.memberCache.EnableMetrics(chainConfig.ChainId, memberCacheName)
 
 	if chainConfig.Consensus.Type == consensus.ConsensusType_DPOS {
 
@@ -8,7 +8,13 @@
 package accesscontrol
 
 import (
+	"container/list"
 	"sync"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	"chainmaker.org/chainmaker/common/v2/monitor"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -17,11 +23,40 @@ const (
 	KeysPerShardDefault = 1024
 )
 
+// the reasons of the evictions of the ShardCache metrics
+const (
+	evictReasonCapacity    = "capacity"
+	evictReasonExpired     = "expired"
+	evictReasonInvalidated = "invalidated"
+)
+
+const (
+	subsystemAccessControl = "accesscontrol"
+	metricCacheRequests    = "metric_cache_requests"
+	metricCacheEvictions   = "metric_cache_evictions"
+	helpCacheRequests      = "the lookups of the access control cache, by the result of hit or miss"
+	helpCacheEvictions     = "the entries evicted from the access control cache, by the reason"
+)
+
+// ShardCache a bounded cache sharded by the key, each shard evicts its least recently used entry once it is full,
+// and the entries expire after the ttl if it is positive
 type ShardCache struct {
 	shards []*Shard
+	ttl    time.Duration
+
+	metricHit       prometheus.Counter
+	metricMiss      prometheus.Counter
+	metricEvictions *prometheus.CounterVec
+	metricLabels    []string
 }
 
+// NewShardCache create a cache of about totalCap entries without expiration
 func NewShardCache(totalCap int) *ShardCache {
+	return NewShardCacheWithTTL(totalCap, 0)
+}
+
+// NewShardCacheWithTTL create a cache of about totalCap entries which expire after the ttl
+func NewShardCacheWithTTL(totalCap int, ttl time.Duration) *ShardCache {
 	shards := make([]*Shard, ShardNum)
 	capPerShard := totalCap / ShardNum
 	if capPerShard < 64 {
@@ -32,83 +67,194 @@ func NewShardCache(totalCap int) *ShardCache {
 	}
 	return &ShardCache{
 		shards: shards,
+		ttl:    ttl,
+	}
+}
+
+// EnableMetrics report the hits, misses and evictions of the cache to the monitor if it is enabled, it should be
+// called before the cache is used
+func (s *ShardCache) EnableMetrics(chainId, cacheName string) {
+	if !confreload.Config().MonitorConfig.Enabled {
+		return
 	}
+	requests := monitor.NewCounterVec(subsystemAccessControl, metricCacheRequests, helpCacheRequests,
+		"chainId", "cache", "result")
+	s.metricHit = requests.WithLabelValues(chainId, cacheName, "hit")
+	s.metricMiss = requests.WithLabelValues(chainId, cacheName, "miss")
+	s.metricEvictions = monitor.NewCounterVec(subsystemAccessControl, metricCacheEvictions, helpCacheEvictions,
+		"chainId", "cache", "reason")
+	s.metricLabels = []string{chainId, cacheName}
 }
 
 func (s *ShardCache) Get(k string) (interface{}, bool) {
 	shard := s.shards[shardNum(k, ShardNum)]
-	return shard.Get(k)
+	v, ok, expired := shard.Get(k, time.Now())
+	if expired {
+		s.observeEvictions(evictReasonExpired, 1)
+	}
+	if ok {
+		if s.metricHit != nil {
+			s.metricHit.Inc()
+		}
+	} else if s.metricMiss != nil {
+		s.metricMiss.Inc()
+	}
+	return v, ok
 }
 
 func (s *ShardCache) Add(k string, v interface{}) {
 	shard := s.shards[shardNum(k, ShardNum)]
-	shard.Put(k, v)
+	var expireAt time.Time
+	if s.ttl > 0 {
+		expireAt = time.Now().Add(s.ttl)
+	}
+	if shard.Put(k, v, expireAt) {
+		s.observeEvictions(evictReasonCapacity, 1)
+	}
 }
 
 func (s *ShardCache) Clear() {
+	removed := 0
 	for i := 0; i < ShardNum; i++ {
-		s.shards[i].Clear()
+		removed += s.shards[i].Clear()
 	}
+	s.observeEvictions(evictReasonInvalidated, removed)
 }
 
 func (s *ShardCache) Remove(k string) {
+	if s.shards[shardNum(k, ShardNum)].Remove(k) {
+		s.observeEvictions(evictReasonInvalidated, 1)
+	}
+}
+
+// RemoveIf remove the entries the function returns true for, and returns how many are removed
+func (s *ShardCache) RemoveIf(f func(k string, v interface{}) bool) int {
+	removed := 0
 	for i := 0; i < ShardNum; i++ {
-		s.shards[i].Remove(k)
+		removed += s.shards[i].RemoveIf(f)
 	}
+	s.observeEvictions(evictReasonInvalidated, removed)
+	return removed
 }
 
+// Len the number of the entries in the cache, including the expired ones not evicted yet
+func (s *ShardCache) Len() int {
+	n := 0
+	for i := 0; i < ShardNum; i++ {
+		n += s.shards[i].Len()
+	}
+	return n
+}
+
+func (s *ShardCache) observeEvictions(reason string, n int) {
+	if s.metricEvictions == nil || n == 0 {
+		return
+	}
+	s.metricEvictions.WithLabelValues(s.metricLabels[0], s.metricLabels[1], reason).Add(float64(n))
+}
+
+// Shard a LRU list of the entries, the front one is the most recently used
 type Shard struct {
-	sync.RWMutex
+	sync.Mutex
 	cap int
-	m   map[string]interface{}
+	m   map[string]*list.Element
+	lru *list.List
+}
+
+type shardEntry struct {
+	key   string
+	value interface{}
+	// zero if the entry never expires
+	expireAt time.Time
 }
 
 func newShard(cap int) *Shard {
 	return &Shard{
 		cap: cap,
-		m:   make(map[string]interface{}, KeysPerShardDefault),
+		m:   make(map[string]*list.Element, KeysPerShardDefault),
+		lru: list.New(),
 	}
 }
 
-func (s *Shard) Get(k string) (interface{}, bool) {
-	s.RLock()
-	defer s.RUnlock()
-	return s.get(k)
+// Get returns the value of the key if it is not expired at now, and whether an expired entry is evicted
+func (s *Shard) Get(k string, now time.Time) (v interface{}, ok bool, expired bool) {
+	s.Lock()
+	defer s.Unlock()
+	elem, exist := s.m[k]
+	if !exist {
+		return nil, false, false
+	}
+	entry, _ := elem.Value.(*shardEntry)
+	if !entry.expireAt.IsZero() && now.After(entry.expireAt) {
+		s.remove(elem)
+		return nil, false, true
+	}
+	s.lru.MoveToFront(elem)
+	return entry.value, true, false
 }
 
-func (s *Shard) Remove(k string) {
+func (s *Shard) Remove(k string) bool {
 	s.Lock()
 	defer s.Unlock()
-	delete(s.m, k)
+	elem, exist := s.m[k]
+	if exist {
+		s.remove(elem)
+	}
+	return exist
 }
 
-func (s *Shard) Put(k string, v interface{}) {
+// Put add or replace the entry of the key, and returns whether the least recently used entry is evicted for it
+func (s *Shard) Put(k string, v interface{}, expireAt time.Time) bool {
 	s.Lock()
 	defer s.Unlock()
-	// 判断容量是否已经达到
-	if len(s.m) > s.cap {
-		// 清理map
-		s.m = make(map[string]interface{}, KeysPerShardDefault)
+	if elem, exist := s.m[k]; exist {
+		entry, _ := elem.Value.(*shardEntry)
+		entry.value, entry.expireAt = v, expireAt
+		s.lru.MoveToFront(elem)
+		return false
+	}
+	s.m[k] = s.lru.PushFront(&shardEntry{key: k, value: v, expireAt: expireAt})
+	if s.lru.Len() > s.cap {
+		s.remove(s.lru.Back())
+		return true
 	}
-	s.put(k, v)
+	return false
 }
 
-func (s *Shard) Clear() {
+func (s *Shard) RemoveIf(f func(k string, v interface{}) bool) int {
 	s.Lock()
 	defer s.Unlock()
-	// 清理map
-	s.m = make(map[string]interface{}, KeysPerShardDefault)
+	removed := 0
+	for elem := s.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry, _ := elem.Value.(*shardEntry)
+		if f(entry.key, entry.value) {
+			s.remove(elem)
+			removed++
+		}
+		elem = next
+	}
+	return removed
 }
 
-func (s *Shard) get(k string) (interface{}, bool) {
-	if v, exist := s.m[k]; exist {
-		return v, exist
-	}
-	return nil, false
+func (s *Shard) Clear() int {
+	s.Lock()
+	defer s.Unlock()
+	removed := s.lru.Len()
+	s.m = make(map[string]*list.Element, KeysPerShardDefault)
+	s.lru.Init()
+	return removed
+}
+
+func (s *Shard) Len() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.lru.Len()
 }
 
-func (s *Shard) put(k string, v interface{}) {
-	s.m[k] = v
+func (s *Shard) remove(elem *list.Element) {
+	entry, _ := s.lru.Remove(elem).(*shardEntry)
+	delete(s.m, entry.key)
 }
 
 func shardNum(key string, shardedNum int) int {
@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) THL A29 Limited, a Tencent company. All rights reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package accesscontrol
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_LRU(t *testing.T) {
+	shard := newShard(2)
+	require.False(t, shard.Put("a", 1, time.Time{}))
+	require.False(t, shard.Put("b", 2, time.Time{}))
+
+	// a is used more recently than b, which is evicted for c
+	_, ok, _ := shard.Get("a", time.Now())
+	require.True(t, ok)
+	require.True(t, shard.Put("c", 3, time.Time{}))
+	_, ok, _ = shard.Get("b", time.Now())
+	require.False(t, ok)
+	v, ok, _ := shard.Get("a", time.Now())
+	require.True(t, ok)
+	require.Equal(t, 1, v)
+
+	// replacing an entry evicts nothing
+	require.False(t, shard.Put("c", 4, time.Time{}))
+	v, _, _ = shard.Get("c", time.Now())
+	require.Equal(t, 4, v)
+	require.Equal(t, 2, shard.Len())
+}
+
+func TestShard_TTL(t *testing.T) {
+	shard := newShard(2)
+	now := time.Now()
+	shard.Put("a", 1, now.Add(time.Minute))
+
+	_, ok, expired := shard.Get("a", now)
+	require.True(t, ok)
+	require.False(t, expired)
+	_, ok, expired = shard.Get("a", now.Add(2*time.Minute))
+	require.False(t, ok)
+	require.True(t, expired)
+	require.Equal(t, 0, shard.Len())
+}
+
+func TestShardCache(t *testing.T) {
+	cache := NewShardCacheWithTTL(0, time.Hour)
+	// the capacity of each shard is at least 64, the cache is never wiped out as a whole
+	for i := 0; i < ShardNum*64*2; i++ {
+		cache.Add(strconv.Itoa(i), i)
+	}
+	require.Equal(t, ShardNum*64, cache.Len())
+	v, ok := cache.Get(strconv.Itoa(ShardNum*64*2 - 1))
+	require.True(t, ok)
+	require.Equal(t, ShardNum*64*2-1, v)
+
+	cache.Remove(strconv.Itoa(ShardNum*64*2 - 1))
+	_, ok = cache.Get(strconv.Itoa(ShardNum*64*2 - 1))
+	require.False(t, ok)
+
+	removed := cache.RemoveIf(func(_ string, v interface{}) bool {
+		return v.(int)%2 == 0
+	})
+	require.Equal(t, ShardNum*64-1-removed, cache.Len())
+	cache.RemoveIf(func(_ string, v interface{}) bool {
+		require.NotZero(t, v.(int)%2)
+		return false
+	})
+
+	cache.Clear()
+	require.Equal(t, 0, cache.Len())
+}
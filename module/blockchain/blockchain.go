@@ -9,6 +9,8 @@ SPDX-License-Identifier: Apache-2.0
 package blockchain
 
 import (
+	"sync"
+
 	"chainmaker.org/chainmaker-go/module/subscriber"
 	"chainmaker.org/chainmaker/common/v2/msgbus"
 	"chainmaker.org/chainmaker/logger/v2"
@@ -93,8 +95,12 @@ type Blockchain struct {
 
 	txFilter protocol.TxFilter
 
+	// modulesLock guard initModules and startModules between the lifecycle methods and the health reports
+	modulesLock  sync.RWMutex
 	initModules  map[string]struct{}
 	startModules map[string]struct{}
+	// pausedModules the modules stopped by Pause, nil if the chain is not paused
+	pausedModules map[string]struct{}
 }
 
 // NewBlockchain create a new Blockchain instance.
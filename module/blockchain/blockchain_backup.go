@@ -0,0 +1,577 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"chainmaker.org/chainmaker/store/v2/conf"
+)
+
+const (
+	dbBackupStoreLedger   = "ledger"
+	dbBackupStoreBlock    = "block"
+	dbBackupStoreState    = "state"
+	dbBackupStoreHistory  = "history"
+	dbBackupStoreResult   = "result"
+	dbBackupStoreTxExist  = "txexist"
+	dbBackupProviderFile  = "file"
+	dbBackupConfStorePath = "store_path"
+)
+
+// DbBackupProvider snapshots the data that one storage provider keeps for a chain. rebuild-dbs
+// backs up every configured store through its provider before replaying the old ledger, and
+// restore-dbs brings the backup back to undo the rebuild.
+type DbBackupProvider interface {
+	// Backup copy the live data of the chain into the backup named backupId.
+	Backup(chainId, backupId string, config map[string]interface{}) error
+	// Verify check that the backup is a complete copy of the live data of the chain.
+	Verify(chainId, backupId string, config map[string]interface{}) error
+	// RemoveBackup delete the backup named backupId.
+	RemoveBackup(chainId, backupId string, config map[string]interface{}) error
+	// Restore copy the backup named backupId back as the live data, the live data must be detached.
+	Restore(chainId, backupId string, config map[string]interface{}) error
+	// Detach move the live data of the chain aside under tag, so that it can be reattached on failure.
+	Detach(chainId, tag string, config map[string]interface{}) error
+	// Reattach undo Detach.
+	Reattach(chainId, tag string, config map[string]interface{}) error
+	// Purge delete the live data moved aside by Detach.
+	Purge(chainId, tag string, config map[string]interface{}) error
+	// BackupConfig returns the provider config that opens the backup instead of the live data.
+	BackupConfig(backupId string, config map[string]interface{}) map[string]interface{}
+}
+
+var dbBackupProviders = map[string]DbBackupProvider{
+	dbBackupProviderFile: &dirBackupProvider{},
+	"leveldb":            &dirBackupProvider{},
+	"badgerdb":           &dirBackupProvider{},
+}
+
+// dbBackupUnsupportedProviders the storage providers which keep the data of a chain out of the store path, so that
+// no backup provider is built in for them, by where the data is kept
+var dbBackupUnsupportedProviders = map[string]string{
+	"tikvdb": "the tikv cluster",
+	"sql":    "the sql database",
+}
+
+// RegisterDbBackupProvider register the backup provider of a storage provider. None is built in for tikvdb and
+// sql, whose data is not kept under the store path, so the chains stored by them are rejected by
+// ValidateDbBackupConfig until one is registered.
+func RegisterDbBackupProvider(provider string, p DbBackupProvider) {
+	dbBackupProviders[strings.ToLower(provider)] = p
+}
+
+func getDbBackupProvider(provider string) (DbBackupProvider, error) {
+	p, ok := dbBackupProviders[strings.ToLower(provider)]
+	if ok {
+		return p, nil
+	}
+	if where, unsupported := dbBackupUnsupportedProviders[strings.ToLower(provider)]; unsupported {
+		return nil, fmt.Errorf("storage provider %s is not supported, its data is kept in %s rather than the store "+
+			"path, back it up with the tools of the database instead", provider, where)
+	}
+	return nil, fmt.Errorf("no backup provider registered for storage provider %s", provider)
+}
+
+// DbBackupStore one store of a chain recorded in the backup manifest.
+type DbBackupStore struct {
+	Name     string                 `json:"name"`
+	Provider string                 `json:"provider"`
+	Config   map[string]interface{} `json:"config"`
+}
+
+// DbBackupManifest describes a backup made by BackupDbs, it is all restore-dbs needs to undo a rebuild.
+type DbBackupManifest struct {
+	ChainId    string           `json:"chain_id"`
+	BackupId   string           `json:"backup_id"`
+	CreateTime string           `json:"create_time"`
+	Stores     []*DbBackupStore `json:"stores"`
+}
+
+// DbBackupManifestFile the manifest of a backup is kept beside the chain directory of the store path.
+func DbBackupManifestFile(storePath, chainId, backupId string) string {
+	return path.Join(storePath, fmt.Sprintf("backup-dbs-%s-%s.json", chainId, backupId))
+}
+
+// LoadDbBackupManifest read a backup manifest from file.
+func LoadDbBackupManifest(file string) (*DbBackupManifest, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &DbBackupManifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal backup manifest %s failed, %s", file, err)
+	}
+	return manifest, nil
+}
+
+// Save write the manifest to file.
+func (m *DbBackupManifest) Save(file string) error {
+	return writeJSONFile(file, m)
+}
+
+// ValidateDbBackupConfig check that every store configured in config has a backup provider, it is called before
+// a rebuild or an archive is started, so that an unsupported store, such as tikvdb or sql, is rejected before
+// anything is stopped.
+func ValidateDbBackupConfig(config *conf.StorageConfig) error {
+	for _, store := range dbBackupStores(config) {
+		if _, err := getDbBackupProvider(store.Provider); err != nil {
+			return fmt.Errorf("%s db can not be backed up, %s", store.Name, err)
+		}
+	}
+	return nil
+}
+
+// BackupDbs snapshot every store of the chain configured in config and clear the live data, so that
+// the ledger can be rebuilt from the backup. Either all stores are backed up, verified and cleared,
+// or the live data is left untouched and the partial backups are removed. The manifest is saved
+// before the live data is purged, so the backup can always be restored once the live data is gone.
+func BackupDbs(chainId, backupId string, config *conf.StorageConfig) (*DbBackupManifest, error) {
+	if err := ValidateDbBackupConfig(config); err != nil {
+		return nil, err
+	}
+	manifest := &DbBackupManifest{
+		ChainId:    chainId,
+		BackupId:   backupId,
+		CreateTime: time.Now().Format(time.RFC3339),
+		Stores:     dbBackupStores(config),
+	}
+	providers := make([]DbBackupProvider, 0, len(manifest.Stores))
+	for _, store := range manifest.Stores {
+		p, _ := getDbBackupProvider(store.Provider)
+		providers = append(providers, p)
+	}
+
+	// 1) copy and verify all stores, the live data is untouched until every copy is checked
+	for i, store := range manifest.Stores {
+		if err := providers[i].Backup(chainId, backupId, store.Config); err != nil {
+			removeDbBackups(manifest, providers, i)
+			return nil, fmt.Errorf("backup %s db failed, %s", store.Name, err)
+		}
+		if err := providers[i].Verify(chainId, backupId, store.Config); err != nil {
+			removeDbBackups(manifest, providers, i+1)
+			return nil, fmt.Errorf("verify backup of %s db failed, %s", store.Name, err)
+		}
+		log.Infof("backup %s db of chain[%s] to %s success", store.Name, chainId, backupId)
+	}
+
+	// 2) detach the live data, reattach on failure
+	for i, store := range manifest.Stores {
+		if err := providers[i].Detach(chainId, backupId, store.Config); err != nil {
+			reattachDbs(manifest, providers, backupId, i)
+			removeDbBackups(manifest, providers, len(manifest.Stores))
+			return nil, fmt.Errorf("detach %s db failed, %s", store.Name, err)
+		}
+	}
+
+	// 3) record the backup, it is the only way back once the detached data is purged
+	if err := manifest.Save(DbBackupManifestFile(config.StorePath, chainId, backupId)); err != nil {
+		reattachDbs(manifest, providers, backupId, len(manifest.Stores))
+		removeDbBackups(manifest, providers, len(manifest.Stores))
+		return nil, fmt.Errorf("save backup manifest failed, %s", err)
+	}
+
+	// 4) the backups are complete, the detached data is no longer needed
+	purgeDbs(manifest, providers, backupId)
+	return manifest, nil
+}
+
+// RestoreDbs replace the live data of every store in the manifest by its backup. Either all stores
+// are restored, or the live data is left as it was.
+func RestoreDbs(manifest *DbBackupManifest) error {
+	chainId := manifest.ChainId
+	tag := "restore-" + manifest.BackupId
+	providers := make([]DbBackupProvider, 0, len(manifest.Stores))
+	for _, store := range manifest.Stores {
+		p, err := getDbBackupProvider(store.Provider)
+		if err != nil {
+			return fmt.Errorf("restore %s db failed, %s", store.Name, err)
+		}
+		providers = append(providers, p)
+	}
+
+	// 1) detach the rebuilt data
+	for i, store := range manifest.Stores {
+		if err := providers[i].Detach(chainId, tag, store.Config); err != nil {
+			reattachDbs(manifest, providers, tag, i)
+			return fmt.Errorf("detach %s db failed, %s", store.Name, err)
+		}
+	}
+
+	// 2) copy the backups back as the live data and check them
+	for i, store := range manifest.Stores {
+		err := providers[i].Restore(chainId, manifest.BackupId, store.Config)
+		if err == nil {
+			err = providers[i].Verify(chainId, manifest.BackupId, store.Config)
+		}
+		if err != nil {
+			undoTag := "undo-" + manifest.BackupId
+			for j := 0; j <= i; j++ {
+				if e := providers[j].Detach(chainId, undoTag, manifest.Stores[j].Config); e == nil {
+					_ = providers[j].Purge(chainId, undoTag, manifest.Stores[j].Config)
+				}
+			}
+			reattachDbs(manifest, providers, tag, len(manifest.Stores))
+			return fmt.Errorf("restore %s db failed, %s", store.Name, err)
+		}
+		log.Infof("restore %s db of chain[%s] from %s success", store.Name, chainId, manifest.BackupId)
+	}
+
+	// 3) drop the rebuilt data
+	purgeDbs(manifest, providers, tag)
+	return nil
+}
+
+// BackupStorageConfig returns a copy of config in which every store opens its backup.
+func BackupStorageConfig(config *conf.StorageConfig, backupId string) (*conf.StorageConfig, error) {
+	backup := *config
+	backup.StorePath = (&dirBackupProvider{}).backupDir(config.StorePath, backupId)
+	dbConfigs := map[string]**conf.DbConfig{
+		dbBackupStoreBlock:   &backup.BlockDbConfig,
+		dbBackupStoreState:   &backup.StateDbConfig,
+		dbBackupStoreResult:  &backup.ResultDbConfig,
+		dbBackupStoreTxExist: &backup.TxExistDbConfig,
+	}
+	for name, dbConfig := range dbConfigs {
+		if *dbConfig == nil {
+			continue
+		}
+		db := **dbConfig
+		if err := setBackupDbConfig(&db, backupId); err != nil {
+			return nil, fmt.Errorf("%s db: %s", name, err)
+		}
+		*dbConfig = &db
+	}
+	if config.HistoryDbConfig != nil {
+		history := *config.HistoryDbConfig
+		if err := setBackupDbConfig(&history.DbConfig, backupId); err != nil {
+			return nil, fmt.Errorf("%s db: %s", dbBackupStoreHistory, err)
+		}
+		backup.HistoryDbConfig = &history
+	}
+	return &backup, nil
+}
+
+func setBackupDbConfig(db *conf.DbConfig, backupId string) error {
+	p, err := getDbBackupProvider(db.Provider)
+	if err != nil {
+		return err
+	}
+	backupConfig := p.BackupConfig(backupId, dbProviderConfig(db))
+	switch strings.ToLower(db.Provider) {
+	case "leveldb":
+		db.LevelDbConfig = backupConfig
+	case "badgerdb":
+		db.BadgerDbConfig = backupConfig
+	case "tikvdb":
+		db.TikvDbConfig = backupConfig
+	}
+	return nil
+}
+
+// dbBackupStores the stores of a chain that rebuild-dbs replaces.
+func dbBackupStores(config *conf.StorageConfig) []*DbBackupStore {
+	stores := make([]*DbBackupStore, 0)
+	add := func(name string, db *conf.DbConfig) {
+		if db == nil {
+			return
+		}
+		stores = append(stores, &DbBackupStore{Name: name, Provider: db.Provider, Config: dbProviderConfig(db)})
+	}
+	add(dbBackupStoreBlock, config.BlockDbConfig)
+	add(dbBackupStoreState, config.StateDbConfig)
+	if config.HistoryDbConfig != nil {
+		add(dbBackupStoreHistory, &config.HistoryDbConfig.DbConfig)
+	}
+	add(dbBackupStoreResult, config.ResultDbConfig)
+	add(dbBackupStoreTxExist, config.TxExistDbConfig)
+	stores = append(stores, &DbBackupStore{
+		Name:     dbBackupStoreLedger,
+		Provider: dbBackupProviderFile,
+		Config:   map[string]interface{}{dbBackupConfStorePath: config.StorePath},
+	})
+	return stores
+}
+
+func dbProviderConfig(db *conf.DbConfig) map[string]interface{} {
+	switch strings.ToLower(db.Provider) {
+	case "leveldb":
+		return db.LevelDbConfig
+	case "badgerdb":
+		return db.BadgerDbConfig
+	case "tikvdb":
+		return db.TikvDbConfig
+	default:
+		return nil
+	}
+}
+
+func removeDbBackups(manifest *DbBackupManifest, providers []DbBackupProvider, n int) {
+	for i := 0; i < n; i++ {
+		store := manifest.Stores[i]
+		if err := providers[i].RemoveBackup(manifest.ChainId, manifest.BackupId, store.Config); err != nil {
+			log.Errorf("remove backup of %s db failed, %s", store.Name, err)
+		}
+	}
+}
+
+func reattachDbs(manifest *DbBackupManifest, providers []DbBackupProvider, tag string, n int) {
+	for i := 0; i < n; i++ {
+		store := manifest.Stores[i]
+		if err := providers[i].Reattach(manifest.ChainId, tag, store.Config); err != nil {
+			log.Errorf("reattach %s db failed, %s", store.Name, err)
+		}
+	}
+}
+
+func purgeDbs(manifest *DbBackupManifest, providers []DbBackupProvider, tag string) {
+	for i, store := range manifest.Stores {
+		if err := providers[i].Purge(manifest.ChainId, tag, store.Config); err != nil {
+			log.Warnf("purge detached %s db failed, %s", store.Name, err)
+		}
+	}
+}
+
+// dirBackupProvider backs up the stores that keep the data of a chain in <store_path>/<chainId>,
+// such as leveldb and badgerdb. The backup is kept in <store_path>-<backupId>/<chainId>.
+type dirBackupProvider struct{}
+
+func (p *dirBackupProvider) storePath(config map[string]interface{}) (string, error) {
+	storePath, _ := config[dbBackupConfStorePath].(string)
+	if storePath == "" {
+		return "", fmt.Errorf("%s is not configured", dbBackupConfStorePath)
+	}
+	return storePath, nil
+}
+
+func (p *dirBackupProvider) backupDir(storePath, backupId string) string {
+	return storePath + "-" + backupId
+}
+
+func (p *dirBackupProvider) paths(chainId, backupId string,
+	config map[string]interface{}) (string, string, error) {
+	storePath, err := p.storePath(config)
+	if err != nil {
+		return "", "", err
+	}
+	return path.Join(storePath, chainId), path.Join(p.backupDir(storePath, backupId), chainId), nil
+}
+
+func (p *dirBackupProvider) Backup(chainId, backupId string, config map[string]interface{}) error {
+	live, backup, err := p.paths(chainId, backupId, config)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(backup); err == nil {
+		return fmt.Errorf("backup %s is exists", backup)
+	}
+	if _, err = os.Stat(live); os.IsNotExist(err) {
+		return nil
+	}
+	if err = copyDir(live, backup); err != nil {
+		_ = os.RemoveAll(backup)
+		return err
+	}
+	return nil
+}
+
+func (p *dirBackupProvider) Verify(chainId, backupId string, config map[string]interface{}) error {
+	live, backup, err := p.paths(chainId, backupId, config)
+	if err != nil {
+		return err
+	}
+	return compareDir(live, backup)
+}
+
+func (p *dirBackupProvider) RemoveBackup(chainId, backupId string, config map[string]interface{}) error {
+	_, backup, err := p.paths(chainId, backupId, config)
+	if err != nil {
+		return err
+	}
+	if err = os.RemoveAll(backup); err != nil {
+		return err
+	}
+	// the backup dir only holds chain dirs, remove it once the last one is gone
+	if files, err := ioutil.ReadDir(filepath.Dir(backup)); err == nil && len(files) == 0 {
+		return os.Remove(filepath.Dir(backup))
+	}
+	return nil
+}
+
+func (p *dirBackupProvider) Restore(chainId, backupId string, config map[string]interface{}) error {
+	live, backup, err := p.paths(chainId, backupId, config)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(backup); os.IsNotExist(err) {
+		return nil
+	}
+	restoring := live + ".restoring"
+	if err = os.RemoveAll(restoring); err != nil {
+		return err
+	}
+	if err = copyDir(backup, restoring); err != nil {
+		_ = os.RemoveAll(restoring)
+		return err
+	}
+	return os.Rename(restoring, live)
+}
+
+func (p *dirBackupProvider) detachedDir(chainId, tag string,
+	config map[string]interface{}) (string, string, error) {
+	storePath, err := p.storePath(config)
+	if err != nil {
+		return "", "", err
+	}
+	return path.Join(storePath, chainId), path.Join(storePath, chainId+".detached-"+tag), nil
+}
+
+func (p *dirBackupProvider) Detach(chainId, tag string, config map[string]interface{}) error {
+	live, detached, err := p.detachedDir(chainId, tag, config)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(live); os.IsNotExist(err) {
+		return nil
+	}
+	return os.Rename(live, detached)
+}
+
+func (p *dirBackupProvider) Reattach(chainId, tag string, config map[string]interface{}) error {
+	live, detached, err := p.detachedDir(chainId, tag, config)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(detached); os.IsNotExist(err) {
+		return nil
+	}
+	return os.Rename(detached, live)
+}
+
+func (p *dirBackupProvider) Purge(chainId, tag string, config map[string]interface{}) error {
+	_, detached, err := p.detachedDir(chainId, tag, config)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(detached)
+}
+
+func (p *dirBackupProvider) BackupConfig(backupId string, config map[string]interface{}) map[string]interface{} {
+	backupConfig := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		backupConfig[k] = v
+	}
+	if storePath, err := p.storePath(config); err == nil {
+		backupConfig[dbBackupConfStorePath] = p.backupDir(storePath, backupId)
+	}
+	return backupConfig
+}
+
+// copyDir copy the regular files of src into dst, which must not exist.
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, file)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(file, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err = out.Sync(); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// compareDir check that dir a and dir b hold the same regular files with the same content.
+func compareDir(a, b string) error {
+	digestsA, err := dirDigests(a)
+	if err != nil {
+		return err
+	}
+	digestsB, err := dirDigests(b)
+	if err != nil {
+		return err
+	}
+	if len(digestsA) != len(digestsB) {
+		return fmt.Errorf("%s has %d files, %s has %d files", a, len(digestsA), b, len(digestsB))
+	}
+	for file, digest := range digestsA {
+		if !bytes.Equal(digest, digestsB[file]) {
+			return fmt.Errorf("file %s differs between %s and %s", file, a, b)
+		}
+	}
+	return nil
+}
+
+func dirDigests(dir string) (map[string][]byte, error) {
+	digests := make(map[string][]byte)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return digests, nil
+	}
+	err := filepath.Walk(dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		h := sha256.New()
+		if _, err = io.Copy(h, f); err != nil {
+			return err
+		}
+		digests[rel] = h.Sum(nil)
+		return nil
+	})
+	return digests, err
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"chainmaker.org/chainmaker/store/v2/conf"
+	"github.com/stretchr/testify/require"
+)
+
+func newBackupTestConfig(t *testing.T, root string) *conf.StorageConfig {
+	levelDb := func(name string) *conf.DbConfig {
+		return &conf.DbConfig{
+			Provider:      "leveldb",
+			LevelDbConfig: map[string]interface{}{"store_path": filepath.Join(root, name)},
+		}
+	}
+	config := &conf.StorageConfig{
+		StorePath:     filepath.Join(root, "ledgerData1"),
+		BlockDbConfig: levelDb("blocks"),
+		StateDbConfig: &conf.DbConfig{
+			Provider:       "badgerdb",
+			BadgerDbConfig: map[string]interface{}{"store_path": filepath.Join(root, "statedb")},
+		},
+		HistoryDbConfig: &conf.HistoryDbConfig{DbConfig: *levelDb("history")},
+		ResultDbConfig:  levelDb("result"),
+	}
+	for _, dir := range []string{"ledgerData1", "blocks", "statedb", "history", "result"} {
+		writeBackupTestFile(t, filepath.Join(root, dir, "chain1", "000001.ldb"), dir)
+	}
+	return config
+}
+
+func writeBackupTestFile(t *testing.T, file, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), os.ModePerm))
+	require.NoError(t, ioutil.WriteFile(file, []byte(content), 0600))
+}
+
+func TestBackupAndRestoreDbs(t *testing.T) {
+	root := t.TempDir()
+	config := newBackupTestConfig(t, root)
+
+	manifest, err := BackupDbs("chain1", "100", config)
+	require.NoError(t, err)
+	require.Len(t, manifest.Stores, 5)
+
+	// the live data is cleared and the backup is readable through the backup config
+	_, err = os.Stat(filepath.Join(root, "blocks", "chain1"))
+	require.True(t, os.IsNotExist(err))
+	data, err := ioutil.ReadFile(filepath.Join(root, "statedb-100", "chain1", "000001.ldb"))
+	require.NoError(t, err)
+	require.Equal(t, "statedb", string(data))
+
+	backupConfig, err := BackupStorageConfig(config, "100")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(root, "blocks-100"), backupConfig.BlockDbConfig.LevelDbConfig["store_path"])
+	require.Equal(t, filepath.Join(root, "statedb-100"), backupConfig.StateDbConfig.BadgerDbConfig["store_path"])
+	require.Equal(t, filepath.Join(root, "history"), config.HistoryDbConfig.LevelDbConfig["store_path"])
+
+	// rebuilt data is dropped by the restore
+	writeBackupTestFile(t, filepath.Join(root, "blocks", "chain1", "000002.ldb"), "rebuilt")
+
+	loaded, err := LoadDbBackupManifest(DbBackupManifestFile(config.StorePath, "chain1", "100"))
+	require.NoError(t, err)
+	require.NoError(t, RestoreDbs(loaded))
+
+	_, err = os.Stat(filepath.Join(root, "blocks", "chain1", "000002.ldb"))
+	require.True(t, os.IsNotExist(err))
+	data, err = ioutil.ReadFile(filepath.Join(root, "blocks", "chain1", "000001.ldb"))
+	require.NoError(t, err)
+	require.Equal(t, "blocks", string(data))
+}
+
+func TestBackupDbs_UnsupportedProvider(t *testing.T) {
+	root := t.TempDir()
+	config := newBackupTestConfig(t, root)
+	config.ResultDbConfig = &conf.DbConfig{Provider: "sql"}
+
+	_, err := BackupDbs("chain1", "100", config)
+	require.Error(t, err)
+
+	// nothing is touched
+	_, err = os.Stat(filepath.Join(root, "blocks", "chain1", "000001.ldb"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(root, "blocks-100"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestValidateDbBackupConfig(t *testing.T) {
+	config := newBackupTestConfig(t, t.TempDir())
+	require.NoError(t, ValidateDbBackupConfig(config))
+
+	for _, provider := range []string{"sql", "tikvdb", "TiKVDB"} {
+		config.StateDbConfig = &conf.DbConfig{Provider: provider}
+		err := ValidateDbBackupConfig(config)
+		require.Error(t, err, provider)
+		require.Contains(t, err.Error(), "is not supported", provider)
+	}
+}
+
+func TestBackupDbs_SaveManifestFailed(t *testing.T) {
+	root := t.TempDir()
+	config := newBackupTestConfig(t, root)
+	// the manifest can not be renamed onto a directory
+	require.NoError(t, os.MkdirAll(filepath.Join(DbBackupManifestFile(config.StorePath, "chain1", "100"), "x"),
+		os.ModePerm))
+
+	_, err := BackupDbs("chain1", "100", config)
+	require.Error(t, err)
+
+	// the live data is reattached rather than purged, and the backups are removed
+	for _, dir := range []string{"ledgerData1", "blocks", "statedb", "history", "result"} {
+		_, err = os.Stat(filepath.Join(root, dir, "chain1", "000001.ldb"))
+		require.NoError(t, err, dir)
+		_, err = os.Stat(filepath.Join(root, dir+"-100"))
+		require.True(t, os.IsNotExist(err), dir)
+	}
+}
+
+func TestBackupDbs_Rollback(t *testing.T) {
+	root := t.TempDir()
+	config := newBackupTestConfig(t, root)
+	// the backup of the result db already exists, so the backup fails after some stores were copied
+	writeBackupTestFile(t, filepath.Join(root, "result-100", "chain1", "000001.ldb"), "other")
+
+	_, err := BackupDbs("chain1", "100", config)
+	require.Error(t, err)
+
+	for _, dir := range []string{"blocks", "statedb", "history", "result"} {
+		_, err = os.Stat(filepath.Join(root, dir, "chain1", "000001.ldb"))
+		require.NoError(t, err)
+	}
+	_, err = os.Stat(filepath.Join(root, "blocks-100"))
+	require.True(t, os.IsNotExist(err))
+	data, err := ioutil.ReadFile(filepath.Join(root, "result-100", "chain1", "000001.ldb"))
+	require.NoError(t, err)
+	require.Equal(t, "other", string(data))
+}
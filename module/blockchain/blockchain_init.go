@@ -16,6 +16,7 @@ import (
 	gasutils "chainmaker.org/chainmaker/utils/v2/gas"
 
 	"chainmaker.org/chainmaker-go/module/accesscontrol"
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/consensus"
 	"chainmaker.org/chainmaker-go/module/core"
 	"chainmaker.org/chainmaker-go/module/core/cache"
@@ -53,6 +54,9 @@ const (
 
 // Init all the modules.
 func (bc *Blockchain) Init() (err error) {
+	bc.modulesLock.Lock()
+	defer bc.modulesLock.Unlock()
+
 	chainConfig, err := chainconf.Genesis(bc.genesis)
 	if err != nil {
 		bc.log.Errorf("invoke chain config genesis failed, %s", err)
@@ -233,14 +237,19 @@ func (bc *Blockchain) initStore() (err error) {
 		bc.log.Infof("store module existed, ignore.")
 		return
 	}
-	var storeFactory store.Factory // nolint: typecheck
-	storeLogger := logger.GetLoggerByChain(logger.MODULE_STORAGE, bc.chainId)
-	err = container.Register(func() protocol.Logger { return storeLogger }, container.Name("store"))
+	config, err := conf.NewStorageConfig(localconf.ChainMakerConfig.StorageConfig)
+	//err = mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config)
 	if err != nil {
 		return err
 	}
-	config, err := conf.NewStorageConfig(localconf.ChainMakerConfig.StorageConfig)
-	//err = mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config)
+	return bc.openStore(config)
+}
+
+// openStore open the store of the chain with the storage config
+func (bc *Blockchain) openStore(config *conf.StorageConfig) (err error) {
+	var storeFactory store.Factory // nolint: typecheck
+	storeLogger := logger.GetLoggerByChain(logger.MODULE_STORAGE, bc.chainId)
+	err = container.Register(func() protocol.Logger { return storeLogger }, container.Name("store"))
 	if err != nil {
 		return err
 	}
@@ -280,22 +289,13 @@ func (bc *Blockchain) initOldStore() (err error) {
 	if err != nil {
 		return err
 	}
-	config := &conf.StorageConfig{}
-	err = mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, config)
-	timeS, _ := localconf.ChainMakerConfig.StorageConfig["back_path"].(string)
-	config.StorePath = config.StorePath + "-" + timeS
-	config.BlockDbConfig.LevelDbConfig["store_path"] =
-		config.BlockDbConfig.LevelDbConfig["store_path"].(string) + "-" + timeS
-	config.StateDbConfig.LevelDbConfig["store_path"] =
-		config.StateDbConfig.LevelDbConfig["store_path"].(string) + "-" + timeS
-	config.ResultDbConfig.LevelDbConfig["store_path"] =
-		config.ResultDbConfig.LevelDbConfig["store_path"].(string) + "-" + timeS
-	config.HistoryDbConfig.LevelDbConfig["store_path"] =
-		config.HistoryDbConfig.LevelDbConfig["store_path"].(string) + "-" + timeS
-	if config.TxExistDbConfig != nil {
-		config.TxExistDbConfig.LevelDbConfig["store_path"] =
-			config.TxExistDbConfig.LevelDbConfig["store_path"].(string) + "-" + timeS
+	liveConfig := &conf.StorageConfig{}
+	if err = mapstructure.Decode(localconf.ChainMakerConfig.StorageConfig, liveConfig); err != nil {
+		return err
 	}
+	// the old store reads the backup made before the rebuild
+	timeS, _ := localconf.ChainMakerConfig.StorageConfig["back_path"].(string)
+	config, err := BackupStorageConfig(liveConfig, timeS)
 	if err != nil {
 		return err
 	}
@@ -554,7 +554,7 @@ func (bc *Blockchain) initTxPool() (err error) {
 		bc.ac,
 		bc.netService,
 		txPoolLogger,
-		localconf.ChainMakerConfig.MonitorConfig.Enabled,
+		confreload.Config().MonitorConfig.Enabled,
 		localconf.ChainMakerConfig.TxPoolConfig,
 	)
 
@@ -724,6 +724,10 @@ func (bc *Blockchain) initCore() (err error) {
 		bc.log.Infof("core engine module existed, ignore.")
 		return
 	}
+	if bc.pausedModules != nil {
+		bc.log.Infof("chain paused, core engine module will be created on resume.")
+		return
+	}
 	var log = logger.GetLoggerByChain(logger.MODULE_SNAPSHOT, bc.chainId)
 	// create snapshot manager
 	var snapshotFactory snapshot.Factory
@@ -794,6 +798,10 @@ func (bc *Blockchain) initConsensus() (err error) {
 		bc.log.Infof("consensus module existed, ignore.")
 		return
 	}
+	if bc.pausedModules != nil {
+		bc.log.Infof("chain paused, consensus module will be created on resume.")
+		return
+	}
 
 	config := &consensusUtils.ConsensusImplConfig{
 		ChainId:       bc.chainId,
@@ -848,7 +856,17 @@ func (bc *Blockchain) initSubscriber() error {
 		bc.log.Infof("subscriber module existed, ignore.")
 		return nil
 	}
-	bc.eventSubscriber = subscriber.NewSubscriber(bc.msgBus)
+	config := subscriber.DefaultConfig()
+	if err := confreload.UnmarshalExtension(subscriber.ConfigKey, config); err != nil {
+		bc.log.Errorf("load subscriber config failed, %s", err)
+		return err
+	}
+	eventSubscriber, err := subscriber.NewSubscriberWithConfig(bc.chainId, bc.msgBus, config, bc.log)
+	if err != nil {
+		bc.log.Errorf("init subscriber failed, %s", err)
+		return err
+	}
+	bc.eventSubscriber = eventSubscriber
 	bc.initModules[moduleNameSubscriber] = struct{}{}
 	return nil
 }
@@ -868,7 +886,8 @@ func (bc *Blockchain) initTxFilter() error {
 	if err != nil {
 		return err
 	}
-	bc.txFilter = txFilter
+	// wrapped so that it can be rebuilt when the local config is reloaded
+	bc.txFilter = txfilter.NewReloadableTxFilter(txFilter)
 	bc.initModules[moduleNameTxFilter] = struct{}{}
 	return nil
 }
@@ -7,101 +7,354 @@ SPDX-License-Identifier: Apache-2.0
 package blockchain
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"time"
 
+	commonErrors "chainmaker.org/chainmaker/common/v2/errors"
 	"chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/pb-go/v2/common"
+)
 
-	commonErrors "chainmaker.org/chainmaker/common/v2/errors"
+const (
+	// RebuildStatusCommitted the block was verified (if required) and committed into the new store
+	RebuildStatusCommitted = "committed"
+	// RebuildStatusSkipped the block had already been committed into the new store
+	RebuildStatusSkipped = "skipped"
+	// RebuildStatusReadFailed the block could not be read from the old store
+	RebuildStatusReadFailed = "read_failed"
+	// RebuildStatusHashMismatch the pre block hash of the block does not match the hash of its parent
+	RebuildStatusHashMismatch = "hash_mismatch"
+	// RebuildStatusVerifyFailed the block failed verification
+	RebuildStatusVerifyFailed = "verify_failed"
+	// RebuildStatusCommitFailed the block could not be committed into the new store
+	RebuildStatusCommitFailed = "commit_failed"
+	// RebuildStatusNotCommitted the block follows a failed block, only its hash chain was checked
+	RebuildStatusNotCommitted = "not_committed"
+
+	// rebuildCheckpointInterval the checkpoint is persisted every rebuildCheckpointInterval committed blocks
+	rebuildCheckpointInterval = 100
 )
 
-// Start all the modules.
-func (bc *Blockchain) RebuildDbs(needVerify bool) {
-	fmt.Printf("###########################")
-	fmt.Printf("###start rebuild-dbs....###")
-	fmt.Printf("###########################")
+// RebuildOptions controls how RebuildDbs replays the blocks of the old store into the new store.
+type RebuildOptions struct {
+	// NeedVerify verify every block with the core engine before commit it
+	NeedVerify bool
+	// ContinueOnError keep checking the hash chain of the blocks after a failed block, so that the report lists
+	// every broken height. The blocks after the first failure are never committed. By default the rebuild stops
+	// on the first failure.
+	ContinueOnError bool
+	// CheckpointFile where the rebuild progress is persisted, empty means no checkpoint
+	CheckpointFile string
+	// StopC closed when the rebuild should stop after the block being processed
+	StopC <-chan struct{}
+}
+
+// RebuildCheckpoint records the progress of rebuild-dbs, so that an interrupted rebuild can be resumed
+// from the last committed height instead of replaying the chain from height 1.
+type RebuildCheckpoint struct {
+	ChainId         string `json:"chain_id"`
+	BackPath        string `json:"back_path"`
+	NeedVerify      bool   `json:"need_verify"`
+	TargetHeight    uint64 `json:"target_height"`
+	CommittedHeight uint64 `json:"committed_height"`
+	UpdateTime      int64  `json:"update_time"`
+}
+
+// LoadRebuildCheckpoint read the checkpoint of rebuild-dbs from file.
+func LoadRebuildCheckpoint(file string) (*RebuildCheckpoint, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &RebuildCheckpoint{}
+	if err = json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("unmarshal rebuild checkpoint %s failed, %s", file, err)
+	}
+	if checkpoint.BackPath == "" {
+		return nil, fmt.Errorf("rebuild checkpoint %s has no back path", file)
+	}
+	return checkpoint, nil
+}
+
+// Save write the checkpoint to file. The content is written to a temporary file first and then renamed,
+// so that a crash never leaves a truncated checkpoint behind.
+func (c *RebuildCheckpoint) Save(file string) error {
+	c.UpdateTime = time.Now().Unix()
+	return writeJSONFile(file, c)
+}
+
+// RebuildBlockResult the outcome of rebuilding one block.
+type RebuildBlockResult struct {
+	Height    uint64 `json:"height"`
+	Status    string `json:"status"`
+	BlockHash string `json:"block_hash,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RebuildReport the machine-readable result of rebuild-dbs.
+type RebuildReport struct {
+	ChainId         string                `json:"chain_id"`
+	NeedVerify      bool                  `json:"need_verify"`
+	ContinueOnError bool                  `json:"continue_on_error"`
+	StartHeight     uint64                `json:"start_height"`
+	TargetHeight    uint64                `json:"target_height"`
+	CommittedHeight uint64                `json:"committed_height"`
+	FailedCount     int                   `json:"failed_count"`
+	Success         bool                  `json:"success"`
+	Interrupted     bool                  `json:"interrupted"`
+	Error           string                `json:"error,omitempty"`
+	StartTime       string                `json:"start_time"`
+	EndTime         string                `json:"end_time"`
+	Blocks          []*RebuildBlockResult `json:"blocks"`
+}
+
+// WriteFile write the report to file as json.
+func (r *RebuildReport) WriteFile(file string) error {
+	return writeJSONFile(file, r)
+}
+
+func (r *RebuildReport) addBlock(result *RebuildBlockResult) {
+	r.Blocks = append(r.Blocks, result)
+	if result.Status != RebuildStatusCommitted && result.Status != RebuildStatusSkipped &&
+		result.Status != RebuildStatusNotCommitted {
+		r.FailedCount++
+		if r.Error == "" {
+			r.Error = fmt.Sprintf("block[%d] %s, %s", result.Height, result.Status, result.Error)
+		}
+	}
+}
+
+func (r *RebuildReport) fail(err error) {
+	r.Error = err.Error()
+}
+
+func (r *RebuildReport) finish() {
+	r.Success = r.Error == "" && r.FailedCount == 0 && !r.Interrupted
+	r.EndTime = time.Now().Format(time.RFC3339)
+}
+
+// RebuildDbs replay the blocks of the old store into the new store. The rebuild resumes from the last
+// height committed into the new store and stops committing on the first block that breaks the hash chain
+// or fails verification, with ContinueOnError it goes on checking the hash chain of the remaining blocks.
+func (bc *Blockchain) RebuildDbs(opts *RebuildOptions) *RebuildReport {
+	report := &RebuildReport{
+		ChainId:         bc.chainId,
+		NeedVerify:      opts.NeedVerify,
+		ContinueOnError: opts.ContinueOnError,
+		StartTime:       time.Now().Format(time.RFC3339),
+		Blocks:          make([]*RebuildBlockResult, 0),
+	}
+	defer report.finish()
+
 	bc.log.Infof("###########################")
 	bc.log.Infof("###start rebuild-dbs....###")
 	bc.log.Infof("###########################")
-	lastBlock, err1 := bc.oldStore.GetLastBlock()
-	if err1 != nil {
-		bc.log.Errorf("get lastblockerr(%s)", err1.Error())
-	} else {
-		bc.log.Infof("lastBlock=%d", lastBlock.Header.BlockHeight)
-	}
-	var i, height uint64
-	var preHash []byte
-	bHeight, _ := localconf.ChainMakerConfig.StorageConfig["rebuild_block_height"].(int)
-	if bHeight <= 0 {
-		bc.log.Warnf("error block_height!")
-		height = lastBlock.GetHeader().BlockHeight
-	} else {
-		if uint64(bHeight) <= lastBlock.GetHeader().BlockHeight {
-			height = uint64(bHeight)
-		} else {
-			height = lastBlock.GetHeader().BlockHeight
-		}
+
+	lastBlock, err := bc.oldStore.GetLastBlock()
+	if err != nil {
+		bc.log.Errorf("get last block of old store failed, %s", err)
+		report.fail(fmt.Errorf("get last block of old store failed, %s", err))
+		return report
+	}
+	report.TargetHeight = rebuildTargetHeight(lastBlock.Header.BlockHeight)
+
+	committedBlock, err := bc.store.GetLastBlock()
+	if err != nil {
+		bc.log.Errorf("get last block of new store failed, %s", err)
+		report.fail(fmt.Errorf("get last block of new store failed, %s", err))
+		return report
+	}
+	report.CommittedHeight = committedBlock.Header.BlockHeight
+	report.StartHeight = report.CommittedHeight + 1
+	bc.log.Infof("rebuild-dbs from height %d to %d", report.StartHeight, report.TargetHeight)
+
+	// the parent of the first block to rebuild must be the same in both stores, or the checkpoint
+	// does not belong to this backup
+	parent, err := bc.oldStore.GetBlock(report.CommittedHeight)
+	if err != nil {
+		report.fail(fmt.Errorf("get block[%d] of old store failed, %s", report.CommittedHeight, err))
+		return report
 	}
-	for i = 1; i <= height; i++ {
-		block, err2 := bc.oldStore.GetBlock(i)
-		if err2 != nil {
-			bc.log.Errorf("get block %d err(%s)", i, err2.Error())
+	if report.CommittedHeight > 0 && !bytes.Equal(parent.Header.BlockHash, committedBlock.Header.BlockHash) {
+		report.fail(fmt.Errorf("block[%d] hash mismatch between old store(%x) and new store(%x), "+
+			"can not resume", report.CommittedHeight, parent.Header.BlockHash, committedBlock.Header.BlockHash))
+		return report
+	}
+
+	checkpoint := &RebuildCheckpoint{
+		ChainId:         bc.chainId,
+		BackPath:        rebuildBackPath(),
+		NeedVerify:      opts.NeedVerify,
+		TargetHeight:    report.TargetHeight,
+		CommittedHeight: report.CommittedHeight,
+	}
+	defer bc.saveRebuildCheckpoint(checkpoint, opts.CheckpointFile)
+
+	preHash := parent.Header.BlockHash
+	// the committed height and the checkpoint stop at the block before the first failure, so that a resumed
+	// rebuild starts from the failed block
+	var failed bool
+	for height := report.StartHeight; height <= report.TargetHeight; height++ {
+		select {
+		case <-opts.StopC:
+			bc.log.Warnf("rebuild-dbs interrupted before block[%d]", height)
+			report.Interrupted = true
+			return report
+		default:
 		}
-		bc.log.Debugf("block %d hash is %x", i, block.GetHeader().BlockHash)
-		bc.log.Debugf("block %d prehash is %x", i, block.GetHeader().PreBlockHash)
-		if preHash != nil && string(preHash) != string(block.GetHeader().PreBlockHash) {
-			bc.log.Errorf("\npreHash=%x\nprehash=%x", []byte(preHash), block.GetHeader().PreBlockHash)
-			bc.log.Errorf("\nError!!!!\n")
-		} else {
-			bc.log.Debugf("\npreHash=%x\nprehash=%x", []byte(preHash), block.GetHeader().PreBlockHash)
+
+		result, block := bc.rebuildBlock(height, preHash, !failed, opts.NeedVerify)
+		report.addBlock(result)
+		preHash = nil
+		if block != nil {
+			preHash = block.Header.BlockHash
 		}
-		preHash = block.GetHeader().BlockHash
-
-		var err3 error
-		if needVerify {
-			err3 = bc.coreEngine.GetBlockVerifier().VerifyBlock(block, -1)
-		} else {
-			blockRwSets, err31 := bc.oldStore.GetBlockWithRWSets(block.Header.BlockHeight)
-			if err31 == nil {
-				err3 = bc.coreEngine.GetBlockVerifier().VerifyBlockWithRwSets(
-					blockRwSets.GetBlock(), blockRwSets.GetTxRWSets(), -1)
+		switch result.Status {
+		case RebuildStatusCommitted, RebuildStatusSkipped:
+			report.CommittedHeight = height
+			checkpoint.CommittedHeight = height
+			if height%rebuildCheckpointInterval == 0 {
+				bc.saveRebuildCheckpoint(checkpoint, opts.CheckpointFile)
 			}
-		}
-
-		if err3 != nil {
-			if err3 == commonErrors.ErrBlockHadBeenCommited {
-				bc.log.Errorf("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-			} else {
-				fmt.Printf("block[%d] verify success.", block.Header.BlockHeight)
-				bc.log.Infof("block[%d] verify success.", block.Header.BlockHeight)
+			fmt.Printf("block[%d] rebuild %s.\n", height, result.Status)
+			bc.log.Infof("block[%d] rebuild %s.", height, result.Status)
+		case RebuildStatusNotCommitted:
+			bc.log.Infof("block[%d] %s.", height, result.Status)
+		default:
+			fmt.Printf("block[%d] rebuild failed, %s: %s\n", height, result.Status, result.Error)
+			bc.log.Errorf("block[%d] rebuild failed, %s: %s", height, result.Status, result.Error)
+			if !opts.ContinueOnError {
+				return report
 			}
-		} else {
-			fmt.Printf("block[%d] verify success.", block.Header.BlockHeight)
-			bc.log.Infof("block[%d] verify success.", block.Header.BlockHeight)
+			failed = true
 		}
+	}
 
-		//time.Sleep(500*time.Millisecond)
-		if err4 := bc.coreEngine.GetBlockCommitter().AddBlock(block); err4 != nil {
-			if err4 == commonErrors.ErrBlockHadBeenCommited {
-				bc.log.Errorf("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-			} else {
-				fmt.Printf("block[%d] rebuild success.", block.Header.BlockHeight)
-				bc.log.Infof("block[%d] rebuild success.", block.Header.BlockHeight)
-			}
+	bc.log.Infof("###########################")
+	bc.log.Infof("###rebuild-dbs finished!###")
+	bc.log.Infof("###########################")
+	return report
+}
+
+// rebuildBlock verify and commit the block of the given height, the block read from the old store
+// is returned as well so that the caller can follow the hash chain. If not commit, only the hash chain of
+// the block is checked, a nil preHash is not checked.
+func (bc *Blockchain) rebuildBlock(height uint64, preHash []byte, commit,
+	needVerify bool) (*RebuildBlockResult, *common.Block) {
+	result := &RebuildBlockResult{Height: height}
+	block, err := bc.oldStore.GetBlock(height)
+	if err != nil || block == nil {
+		result.Status = RebuildStatusReadFailed
+		result.Error = fmt.Sprintf("get block from old store failed, %v", err)
+		return result, nil
+	}
+	result.BlockHash = fmt.Sprintf("%x", block.Header.BlockHash)
+
+	if (commit || preHash != nil) && !bytes.Equal(preHash, block.Header.PreBlockHash) {
+		result.Status = RebuildStatusHashMismatch
+		result.Error = fmt.Sprintf("expect pre block hash %x, got %x", preHash, block.Header.PreBlockHash)
+		return result, block
+	}
+	if !commit {
+		result.Status = RebuildStatusNotCommitted
+		return result, block
+	}
 
-		} else {
-			bc.log.Infof("block[%d] rebuild success.", block.Header.BlockHeight)
-			fmt.Printf("block[%d] rebuild success.", block.Header.BlockHeight)
+	if err = bc.verifyRebuildBlock(block, needVerify); err != nil {
+		if err == commonErrors.ErrBlockHadBeenCommited {
+			result.Status = RebuildStatusSkipped
+			return result, block
 		}
-		//time.Sleep(500 * time.Millisecond)
+		result.Status = RebuildStatusVerifyFailed
+		result.Error = err.Error()
+		return result, block
+	}
 
+	if err = bc.coreEngine.GetBlockCommitter().AddBlock(block); err != nil {
+		if err == commonErrors.ErrBlockHadBeenCommited {
+			result.Status = RebuildStatusSkipped
+			return result, block
+		}
+		result.Status = RebuildStatusCommitFailed
+		result.Error = err.Error()
+		return result, block
 	}
-	fmt.Printf("###########################")
-	fmt.Printf("###rebuild-dbs finished!###")
-	fmt.Printf("###########################")
-	bc.log.Infof("###########################")
-	bc.log.Infof("###rebuild-dbs finished!###")
-	bc.log.Infof("###########################")
-	bc.Stop()
-	os.Exit(0)
+	result.Status = RebuildStatusCommitted
+	return result, block
+}
+
+func (bc *Blockchain) verifyRebuildBlock(block *common.Block, needVerify bool) error {
+	if needVerify {
+		return bc.coreEngine.GetBlockVerifier().VerifyBlock(block, -1)
+	}
+	blockRwSets, err := bc.oldStore.GetBlockWithRWSets(block.Header.BlockHeight)
+	if err != nil {
+		return fmt.Errorf("get rw sets from old store failed, %s", err)
+	}
+	return bc.coreEngine.GetBlockVerifier().VerifyBlockWithRwSets(
+		blockRwSets.GetBlock(), blockRwSets.GetTxRWSets(), -1)
+}
+
+func (bc *Blockchain) saveRebuildCheckpoint(checkpoint *RebuildCheckpoint, file string) {
+	if file == "" {
+		return
+	}
+	if err := checkpoint.Save(file); err != nil {
+		bc.log.Errorf("save rebuild checkpoint at height %d failed, %s", checkpoint.CommittedHeight, err)
+	}
+}
+
+// rebuildTargetHeight the last height to rebuild, limited by storage config rebuild_block_height.
+func rebuildTargetHeight(lastHeight uint64) uint64 {
+	bHeight, _ := localconf.ChainMakerConfig.StorageConfig["rebuild_block_height"].(int)
+	if bHeight > 0 && uint64(bHeight) <= lastHeight {
+		return uint64(bHeight)
+	}
+	return lastHeight
+}
+
+func rebuildBackPath() string {
+	backPath, _ := localconf.ChainMakerConfig.StorageConfig["back_path"].(string)
+	return backPath
+}
+
+// writeJSONFile write v to file as indented json.
+func writeJSONFile(file string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(file, data)
+}
+
+func writeFileAtomic(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		// the content is on disk before the rename makes it visible
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err = os.Rename(tmp, file); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,151 @@
+/*
+Copyright (C) THL A29 Limited, a Tencent company. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebuildCheckpoint_SaveAndLoad(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ledgerData1", "rebuild-dbs-chain1.checkpoint")
+
+	_, err := LoadRebuildCheckpoint(file)
+	require.Error(t, err)
+
+	checkpoint := &RebuildCheckpoint{
+		ChainId:         "chain1",
+		BackPath:        "1650000000000000000",
+		NeedVerify:      true,
+		TargetHeight:    100,
+		CommittedHeight: 42,
+	}
+	require.NoError(t, checkpoint.Save(file))
+
+	loaded, err := LoadRebuildCheckpoint(file)
+	require.NoError(t, err)
+	require.Equal(t, checkpoint.ChainId, loaded.ChainId)
+	require.Equal(t, checkpoint.BackPath, loaded.BackPath)
+	require.Equal(t, uint64(42), loaded.CommittedHeight)
+	require.NotZero(t, loaded.UpdateTime)
+
+	require.NoError(t, (&RebuildCheckpoint{ChainId: "chain1"}).Save(file))
+	_, err = LoadRebuildCheckpoint(file)
+	require.Error(t, err)
+}
+
+func TestRebuildReport_Finish(t *testing.T) {
+	report := &RebuildReport{ChainId: "chain1"}
+	report.addBlock(&RebuildBlockResult{Height: 1, Status: RebuildStatusCommitted})
+	report.addBlock(&RebuildBlockResult{Height: 2, Status: RebuildStatusSkipped})
+	report.finish()
+	require.True(t, report.Success)
+	require.Equal(t, 0, report.FailedCount)
+
+	report = &RebuildReport{ChainId: "chain1"}
+	report.addBlock(&RebuildBlockResult{Height: 1, Status: RebuildStatusCommitted})
+	report.addBlock(&RebuildBlockResult{Height: 2, Status: RebuildStatusHashMismatch, Error: "mismatch"})
+	report.addBlock(&RebuildBlockResult{Height: 3, Status: RebuildStatusVerifyFailed, Error: "invalid"})
+	report.finish()
+	require.False(t, report.Success)
+	require.Equal(t, 2, report.FailedCount)
+	require.Contains(t, report.Error, "block[2]")
+
+	report = &RebuildReport{ChainId: "chain1", Interrupted: true}
+	report.finish()
+	require.False(t, report.Success)
+
+	file := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, report.WriteFile(file))
+}
+
+// newRebuildChain a chain whose old store holds the blocks, and whose new store holds the genesis block
+func newRebuildChain(t *testing.T, blocks []*commonPb.Block, committed *[]uint64) *Blockchain {
+	ctrl := gomock.NewController(t)
+	oldStore := mock.NewMockBlockchainStore(ctrl)
+	oldStore.EXPECT().GetLastBlock().Return(blocks[len(blocks)-1], nil).AnyTimes()
+	oldStore.EXPECT().GetBlock(gomock.Any()).DoAndReturn(func(height uint64) (*commonPb.Block, error) {
+		return blocks[height], nil
+	}).AnyTimes()
+	store := mock.NewMockBlockchainStore(ctrl)
+	store.EXPECT().GetLastBlock().Return(blocks[0], nil).AnyTimes()
+
+	verifier := mock.NewMockBlockVerifier(ctrl)
+	verifier.EXPECT().VerifyBlock(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	committer := mock.NewMockBlockCommitter(ctrl)
+	committer.EXPECT().AddBlock(gomock.Any()).DoAndReturn(func(block *commonPb.Block) error {
+		*committed = append(*committed, block.Header.BlockHeight)
+		return nil
+	}).AnyTimes()
+	coreEngine := mock.NewMockCoreEngine(ctrl)
+	coreEngine.EXPECT().GetBlockVerifier().Return(verifier).AnyTimes()
+	coreEngine.EXPECT().GetBlockCommitter().Return(committer).AnyTimes()
+
+	chain := NewBlockchain("", "chain1", nil, nil)
+	chain.oldStore, chain.store, chain.coreEngine = oldStore, store, coreEngine
+	return chain
+}
+
+func newRebuildBlocks(count int) []*commonPb.Block {
+	blocks := make([]*commonPb.Block, 0, count)
+	for height := 0; height < count; height++ {
+		header := &commonPb.BlockHeader{BlockHeight: uint64(height), BlockHash: []byte{byte(height)}}
+		if height > 0 {
+			header.PreBlockHash = []byte{byte(height - 1)}
+		}
+		blocks = append(blocks, &commonPb.Block{Header: header})
+	}
+	return blocks
+}
+
+func TestRebuildDbs_StopOnFirstFailure(t *testing.T) {
+	blocks := newRebuildBlocks(5)
+	blocks[2].Header.PreBlockHash = []byte("broken")
+	var committed []uint64
+	file := filepath.Join(t.TempDir(), "rebuild-dbs-chain1.checkpoint")
+
+	report := newRebuildChain(t, blocks, &committed).RebuildDbs(&RebuildOptions{NeedVerify: true,
+		CheckpointFile: file})
+	require.False(t, report.Success)
+	require.Equal(t, []uint64{1}, committed)
+	require.EqualValues(t, 1, report.CommittedHeight)
+	require.Equal(t, 1, report.FailedCount)
+	require.Len(t, report.Blocks, 2)
+	require.Equal(t, RebuildStatusHashMismatch, report.Blocks[1].Status)
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	checkpoint := &RebuildCheckpoint{}
+	require.NoError(t, json.Unmarshal(data, checkpoint))
+	require.EqualValues(t, 1, checkpoint.CommittedHeight)
+}
+
+func TestRebuildDbs_ContinueOnError(t *testing.T) {
+	blocks := newRebuildBlocks(6)
+	blocks[2].Header.PreBlockHash = []byte("broken")
+	blocks[4].Header.PreBlockHash = []byte("broken")
+	var committed []uint64
+
+	report := newRebuildChain(t, blocks, &committed).RebuildDbs(&RebuildOptions{NeedVerify: true,
+		ContinueOnError: true})
+	require.False(t, report.Success)
+	// nothing is committed after the first failure, and the committed height does not pass it
+	require.Equal(t, []uint64{1}, committed)
+	require.EqualValues(t, 1, report.CommittedHeight)
+	require.Equal(t, 2, report.FailedCount)
+	require.Len(t, report.Blocks, 5)
+	require.Equal(t, RebuildStatusHashMismatch, report.Blocks[1].Status)
+	require.Equal(t, RebuildStatusNotCommitted, report.Blocks[2].Status)
+	require.Equal(t, RebuildStatusHashMismatch, report.Blocks[3].Status)
+	require.Equal(t, RebuildStatusNotCommitted, report.Blocks[4].Status)
+}
@@ -7,8 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 
 package blockchain
 
+import "chainmaker.org/chainmaker/protocol/v2"
+
 // Start all the modules.
 func (bc *Blockchain) Start() error {
+	bc.modulesLock.Lock()
+	defer bc.modulesLock.Unlock()
+
 	// start all module
 
 	// start sequence：
@@ -169,3 +174,89 @@ func (bc *Blockchain) isModuleStartUp(moduleName string) bool {
 	_, res := bc.startModules[moduleName]
 	return res
 }
+
+// syncStateInheritor the sync service which continues with the peer scores and the quarantine of the one it
+// replaces
+type syncStateInheritor interface {
+	InheritState(previous protocol.SyncService)
+}
+
+// Resume create and start a new core engine and consensus module in place of the ones stopped by Pause, the
+// sync service is recreated too, as it verifies and commits blocks through the core engine, it continues with the
+// peer scores and the quarantine of the replaced one. If it fails, the modules it started are stopped again, so
+// the chain stays paused and Resume can be retried.
+func (bc *Blockchain) Resume() (err error) {
+	bc.modulesLock.Lock()
+	defer bc.modulesLock.Unlock()
+
+	if _, corePaused := bc.pausedModules[moduleNameCore]; !corePaused {
+		bc.pausedModules = nil
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			bc.rollbackResume()
+			return
+		}
+		bc.pausedModules = nil
+	}()
+
+	if err = bc.initCore(); err != nil {
+		return err
+	}
+	if bc.isModuleInit(moduleNameSync) {
+		if err = bc.replaceSyncService(); err != nil {
+			return err
+		}
+	}
+	if err = bc.initConsensus(); err != nil {
+		return err
+	}
+	if err = bc.startCoreEngine(); err != nil {
+		return err
+	}
+	if bc.isModuleInit(moduleNameConsensus) {
+		return bc.startConsensus()
+	}
+	return nil
+}
+
+// replaceSyncService replace the sync service by one on the current core engine
+func (bc *Blockchain) replaceSyncService() error {
+	previous := bc.syncServer
+	if bc.isModuleStartUp(moduleNameSync) {
+		if err := bc.stopSyncService(); err != nil {
+			return err
+		}
+	}
+	delete(bc.initModules, moduleNameSync)
+	if err := bc.initSync(); err != nil {
+		return err
+	}
+	if inheritor, ok := bc.syncServer.(syncStateInheritor); ok {
+		inheritor.InheritState(previous)
+	}
+	return bc.startSyncService()
+}
+
+// rollbackResume stop the core engine and the consensus module started by a failed Resume, the sync service keeps
+// running, on the new core engine if it is replaced
+func (bc *Blockchain) rollbackResume() {
+	if bc.isModuleStartUp(moduleNameConsensus) {
+		if err := bc.stopConsensus(); err != nil {
+			bc.log.Errorf("stop the consensus of a failed resume failed, %s", err)
+		}
+	}
+	delete(bc.initModules, moduleNameConsensus)
+	if bc.isModuleStartUp(moduleNameCore) {
+		if err := bc.stopCoreEngine(); err != nil {
+			bc.log.Errorf("stop the core engine of a failed resume failed, %s", err)
+		}
+	}
+	delete(bc.initModules, moduleNameCore)
+	if bc.isModuleInit(moduleNameSync) && !bc.isModuleStartUp(moduleNameSync) {
+		if err := bc.startSyncService(); err != nil {
+			bc.log.Errorf("start the sync service of a failed resume failed, %s", err)
+		}
+	}
+}
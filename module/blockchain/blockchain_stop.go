@@ -7,8 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 
 package blockchain
 
+import "fmt"
+
 // Stop all the modules.
 func (bc *Blockchain) Stop() {
+	bc.modulesLock.Lock()
+	defer bc.modulesLock.Unlock()
+
 	// stop all module
 
 	// stop sequence：
@@ -61,6 +66,9 @@ func (bc *Blockchain) Stop() {
 
 // StopOnRequirements close the module instance which is required to shut down when chain configuration updating.
 func (bc *Blockchain) StopOnRequirements() {
+	bc.modulesLock.Lock()
+	defer bc.modulesLock.Unlock()
+
 	stopMethodMap := map[string]func() error{
 		moduleNameNetService: bc.stopNetService,
 		moduleNameSync:       bc.stopSyncService,
@@ -180,3 +188,45 @@ func (bc *Blockchain) stopStore() error {
 	delete(bc.startModules, moduleNameStore)
 	return nil
 }
+
+// Pause stop the core engine and the consensus module, the chain neither proposes nor votes until Resume, it
+// keeps syncing blocks and serving queries. The stopped modules are dropped from initModules, so that Init does not
+// reuse them and Resume creates new ones. If it fails, the consensus module it stopped is started again, so the
+// chain keeps running.
+func (bc *Blockchain) Pause() error {
+	bc.modulesLock.Lock()
+	defer bc.modulesLock.Unlock()
+
+	pausedModules := make(map[string]struct{})
+	if bc.isModuleStartUp(moduleNameConsensus) {
+		if err := bc.stopConsensus(); err != nil {
+			return err
+		}
+		delete(bc.initModules, moduleNameConsensus)
+		pausedModules[moduleNameConsensus] = struct{}{}
+	}
+	if bc.isModuleStartUp(moduleNameCore) {
+		if err := bc.stopCoreEngine(); err != nil {
+			return bc.rollbackPause(pausedModules, err)
+		}
+		delete(bc.initModules, moduleNameCore)
+		pausedModules[moduleNameCore] = struct{}{}
+	}
+	bc.pausedModules = pausedModules
+	return nil
+}
+
+// rollbackPause start again the consensus module stopped by a Pause which failed with err
+func (bc *Blockchain) rollbackPause(pausedModules map[string]struct{}, err error) error {
+	if _, ok := pausedModules[moduleNameConsensus]; !ok {
+		return err
+	}
+	rollbackErr := bc.initConsensus()
+	if rollbackErr == nil {
+		rollbackErr = bc.startConsensus()
+	}
+	if rollbackErr != nil {
+		return fmt.Errorf("%s, and start the consensus again failed, %s", err, rollbackErr)
+	}
+	return err
+}
@@ -6,7 +6,13 @@
 
 package blockchain
 
-import "testing"
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
 
 func TestStopOnRequirements(t *testing.T) {
 	t.Log("TestStopOnRequirements")
@@ -107,3 +113,29 @@ func TestStopTxPool(t *testing.T) {
 		}
 	}
 }
+
+func TestPause(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	coreEngine := mock.NewMockCoreEngine(ctrl)
+	coreEngine.EXPECT().Stop().Times(1)
+	consensus := mock.NewMockConsensusEngine(ctrl)
+	consensus.EXPECT().Stop().Return(nil).Times(1)
+
+	chain := NewBlockchain("", "chain1", nil, nil)
+	chain.coreEngine, chain.consensus = coreEngine, consensus
+	for _, name := range []string{moduleNameCore, moduleNameConsensus} {
+		chain.initModules[name] = struct{}{}
+		chain.startModules[name] = struct{}{}
+	}
+
+	require.NoError(t, chain.Pause())
+	// the stopped modules are dropped, so that they are created again rather than restarted
+	for _, name := range []string{moduleNameCore, moduleNameConsensus} {
+		require.False(t, chain.isModuleInit(name))
+		require.False(t, chain.isModuleStartUp(name))
+	}
+	// an init on a chain config update does not create them while the chain is paused
+	require.NoError(t, chain.initCore())
+	require.False(t, chain.isModuleInit(moduleNameCore))
+	require.Len(t, chain.pausedModules, 2)
+}
@@ -0,0 +1,246 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	coreCommon "chainmaker.org/chainmaker-go/module/core/common"
+	"chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/pb-go/v2/common"
+	storePb "chainmaker.org/chainmaker/pb-go/v2/store"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"chainmaker.org/chainmaker/store/v2/conf"
+)
+
+const (
+	// LedgerCheckHeight the block is stored at the height it claims
+	LedgerCheckHeight = "height"
+	// LedgerCheckTxCount the tx count in header matches the txs of the block
+	LedgerCheckTxCount = "tx_count"
+	// LedgerCheckBlockHash the block hash matches the header
+	LedgerCheckBlockHash = "block_hash"
+	// LedgerCheckPreHash the pre block hash matches the hash of the parent block
+	LedgerCheckPreHash = "pre_hash"
+	// LedgerCheckRWSet the stored rw sets match the rw set hash of every tx
+	LedgerCheckRWSet = "rwset"
+	// LedgerCheckDigests the tx merkle root, dag hash and rw set root match the header
+	LedgerCheckDigests = "digests"
+)
+
+// VerifyLedgerOptions controls the range of blocks audited by VerifyLedger.
+type VerifyLedgerOptions struct {
+	// StartHeight the first height to audit
+	StartHeight uint64
+	// EndHeight the last height to audit, 0 means the last block of the ledger
+	EndHeight uint64
+	// StopC closed when the audit should stop after the block being audited
+	StopC <-chan struct{}
+}
+
+// LedgerBlockResult the outcome of auditing one block, Failed is empty when every check passed.
+type LedgerBlockResult struct {
+	Height    uint64            `json:"height"`
+	BlockHash string            `json:"block_hash,omitempty"`
+	TxCount   uint32            `json:"tx_count"`
+	Failed    map[string]string `json:"failed,omitempty"`
+}
+
+// VerifyLedgerReport the machine-readable result of verify-ledger, kept as audit evidence.
+type VerifyLedgerReport struct {
+	ChainId      string               `json:"chain_id"`
+	HashType     string               `json:"hash_type"`
+	StartHeight  uint64               `json:"start_height"`
+	EndHeight    uint64               `json:"end_height"`
+	LastHeight   uint64               `json:"last_verified_height"`
+	BlockCount   uint64               `json:"block_count"`
+	TxCount      uint64               `json:"tx_count"`
+	FailedCount  int                  `json:"failed_count"`
+	Success      bool                 `json:"success"`
+	Interrupted  bool                 `json:"interrupted"`
+	Error        string               `json:"error,omitempty"`
+	StartTime    string               `json:"start_time"`
+	EndTime      string               `json:"end_time"`
+	FailedBlocks []*LedgerBlockResult `json:"failed_blocks"`
+}
+
+// WriteFile write the report to file as json.
+func (r *VerifyLedgerReport) WriteFile(file string) error {
+	return writeJSONFile(file, r)
+}
+
+func (r *VerifyLedgerReport) finish() {
+	r.Success = r.Error == "" && r.FailedCount == 0 && !r.Interrupted
+	r.EndTime = time.Now().Format(time.RFC3339)
+}
+
+// InitForVerifyLedger init the modules needed to audit the ledger offline. Unlike Init, the genesis
+// block is never created and the store is opened read-only, see initReadOnlyStore.
+func (bc *Blockchain) InitForVerifyLedger() error {
+	baseModules := []map[string]func() error{
+		// init store module
+		{moduleNameStore: bc.initReadOnlyStore},
+		// init chain config , must latter than store module
+		{moduleNameChainConf: bc.initChainConf},
+	}
+	return bc.initBaseModules(baseModules)
+}
+
+// errReadOnlyStore returned by the writes to the store opened by verify-ledger
+var errReadOnlyStore = errors.New("store is opened read-only to verify the ledger")
+
+// readOnlyStore the store of the ledger being audited, it rejects the writes, so that neither the audit nor the
+// chain config it loads changes the ledger
+type readOnlyStore struct {
+	protocol.BlockchainStore
+}
+
+func (s *readOnlyStore) InitGenesis(*storePb.BlockWithRWSet) error { return errReadOnlyStore }
+
+func (s *readOnlyStore) PutBlock(*common.Block, []*common.TxRWSet) error { return errReadOnlyStore }
+
+func (s *readOnlyStore) ArchiveBlock(uint64) error { return errReadOnlyStore }
+
+func (s *readOnlyStore) RestoreBlocks([][]byte) error { return errReadOnlyStore }
+
+func (s *readOnlyStore) ExecDdlSql(string, string, string) error { return errReadOnlyStore }
+
+func (s *readOnlyStore) DropDatabase(string) error { return errReadOnlyStore }
+
+// initReadOnlyStore open the store of the local config for the audit. The history db and the contract event db,
+// which the audit does not read, are not opened, and the store is wrapped by readOnlyStore.
+func (bc *Blockchain) initReadOnlyStore() error {
+	if _, ok := bc.initModules[moduleNameStore]; ok {
+		bc.log.Infof("store module existed, ignore.")
+		return nil
+	}
+	storageConfig := make(map[string]interface{}, len(localconf.ChainMakerConfig.StorageConfig)+2)
+	for k, v := range localconf.ChainMakerConfig.StorageConfig {
+		storageConfig[k] = v
+	}
+	storageConfig["disable_historydb"] = true
+	storageConfig["disable_contract_eventdb"] = true
+	config, err := conf.NewStorageConfig(storageConfig)
+	if err != nil {
+		return err
+	}
+	if err = bc.openStore(config); err != nil {
+		return err
+	}
+	bc.store = &readOnlyStore{BlockchainStore: bc.store}
+	return nil
+}
+
+// VerifyLedger walk the blocks of the store and check the hash chain, the tx merkle root, the dag hash
+// and the rw set hash of every block. All blocks in range are audited, failures are collected in the report.
+func (bc *Blockchain) VerifyLedger(opts *VerifyLedgerOptions) *VerifyLedgerReport {
+	report := &VerifyLedgerReport{
+		ChainId:      bc.chainId,
+		HashType:     bc.chainConf.ChainConfig().Crypto.Hash,
+		StartHeight:  opts.StartHeight,
+		StartTime:    time.Now().Format(time.RFC3339),
+		FailedBlocks: make([]*LedgerBlockResult, 0),
+	}
+	defer report.finish()
+
+	lastBlock, err := bc.store.GetLastBlock()
+	if err != nil {
+		report.Error = fmt.Sprintf("get last block failed, %s", err)
+		return report
+	}
+	report.EndHeight = lastBlock.Header.BlockHeight
+	if opts.EndHeight > 0 && opts.EndHeight < report.EndHeight {
+		report.EndHeight = opts.EndHeight
+	}
+	if report.StartHeight > report.EndHeight {
+		report.Error = fmt.Sprintf("start height %d is beyond end height %d", report.StartHeight, report.EndHeight)
+		return report
+	}
+	bc.log.Infof("verify ledger from height %d to %d", report.StartHeight, report.EndHeight)
+
+	// the hash chain is checked from the parent of the first block
+	var preHash []byte
+	if report.StartHeight > 0 {
+		var parent *common.Block
+		parent, err = bc.store.GetBlock(report.StartHeight - 1)
+		if err != nil || parent == nil {
+			report.Error = fmt.Sprintf("get block[%d] failed, %v", report.StartHeight-1, err)
+			return report
+		}
+		preHash = parent.Header.BlockHash
+	}
+
+	for height := report.StartHeight; height <= report.EndHeight; height++ {
+		select {
+		case <-opts.StopC:
+			bc.log.Warnf("verify ledger interrupted before block[%d]", height)
+			report.Interrupted = true
+			return report
+		default:
+		}
+
+		result, block := bc.verifyLedgerBlock(height, preHash, report.HashType)
+		report.BlockCount++
+		report.TxCount += uint64(result.TxCount)
+		report.LastHeight = height
+		preHash = nil
+		if block != nil {
+			preHash = block.Header.BlockHash
+		}
+		if len(result.Failed) > 0 {
+			report.FailedCount++
+			report.FailedBlocks = append(report.FailedBlocks, result)
+			bc.log.Errorf("block[%d] verify ledger failed, %v", height, result.Failed)
+		}
+		if height%1000 == 0 {
+			bc.log.Infof("verify ledger progress %d/%d, failed blocks: %d", height, report.EndHeight,
+				report.FailedCount)
+		}
+	}
+	return report
+}
+
+// verifyLedgerBlock audit the block of the given height against its parent hash, the block is returned
+// so that the caller can follow the hash chain.
+func (bc *Blockchain) verifyLedgerBlock(height uint64, preHash []byte,
+	hashType string) (*LedgerBlockResult, *common.Block) {
+	result := &LedgerBlockResult{Height: height, Failed: make(map[string]string)}
+	blockWithRWSets, err := bc.store.GetBlockWithRWSets(height)
+	if err != nil || blockWithRWSets == nil || blockWithRWSets.Block == nil {
+		result.Failed[LedgerCheckHeight] = fmt.Sprintf("get block with rwsets failed, %v", err)
+		return result, nil
+	}
+	block := blockWithRWSets.Block
+	result.BlockHash = fmt.Sprintf("%x", block.Header.BlockHash)
+	result.TxCount = block.Header.TxCount
+
+	if block.Header.BlockHeight != height {
+		result.Failed[LedgerCheckHeight] = fmt.Sprintf("expect %d, got %d", height, block.Header.BlockHeight)
+	}
+	if err = coreCommon.IsTxCountValid(block); err != nil {
+		result.Failed[LedgerCheckTxCount] = err.Error()
+	}
+	if err = coreCommon.IsBlockHashValid(block, hashType); err != nil {
+		result.Failed[LedgerCheckBlockHash] = err.Error()
+	}
+	if height > 0 {
+		if err = coreCommon.IsPreHashValid(block, preHash); err != nil {
+			result.Failed[LedgerCheckPreHash] = err.Error()
+		}
+	}
+	txHashes, err := coreCommon.CalcTxHashesWithRWSets(block, blockWithRWSets.TxRWSets, hashType)
+	if err != nil {
+		result.Failed[LedgerCheckRWSet] = err.Error()
+		return result, block
+	}
+	if err = coreCommon.CheckBlockDigests(block, txHashes, hashType, bc.log); err != nil {
+		result.Failed[LedgerCheckDigests] = err.Error()
+	}
+	return result, block
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"fmt"
+	"testing"
+
+	"chainmaker.org/chainmaker/common/v2/crypto/hash"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	storePb "chainmaker.org/chainmaker/pb-go/v2/store"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"chainmaker.org/chainmaker/utils/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const ledgerHashType = "SHA256"
+
+// newLedgerBlock a committed block of one tx whose hashes and roots are all valid
+func newLedgerBlock(t *testing.T, height uint64, preHash []byte) *storePb.BlockWithRWSet {
+	txId := fmt.Sprintf("tx%d", height)
+	rwSet := &commonPb.TxRWSet{TxId: txId, TxWrites: []*commonPb.TxWrite{{Key: []byte("k"), Value: []byte(txId)}}}
+	rwSetHash, err := utils.CalcRWSetHash(ledgerHashType, rwSet)
+	require.NoError(t, err)
+	block := &commonPb.Block{
+		Header: &commonPb.BlockHeader{BlockHeight: height, PreBlockHash: preHash, TxCount: 1},
+		Dag:    &commonPb.DAG{Vertexes: []*commonPb.DAG_Neighbor{{}}},
+		Txs: []*commonPb.Transaction{{
+			Payload: &commonPb.Payload{ChainId: "chain1", TxId: txId},
+			Result:  &commonPb.Result{RwSetHash: rwSetHash},
+		}},
+	}
+	txHash, err := utils.CalcTxHashWithVersion(ledgerHashType, block.Txs[0], int(block.Header.BlockVersion))
+	require.NoError(t, err)
+	block.Header.TxRoot, err = hash.GetMerkleRoot(ledgerHashType, [][]byte{txHash})
+	require.NoError(t, err)
+	block.Header.DagHash, err = utils.CalcDagHash(ledgerHashType, block.Dag)
+	require.NoError(t, err)
+	block.Header.RwSetRoot, err = utils.CalcRWSetRoot(ledgerHashType, block.Txs)
+	require.NoError(t, err)
+	block.Header.BlockHash, err = utils.CalcBlockHash(ledgerHashType, block)
+	require.NoError(t, err)
+	return &storePb.BlockWithRWSet{Block: block, TxRWSets: []*commonPb.TxRWSet{rwSet}}
+}
+
+func newLedgerChain(t *testing.T, blocks []*storePb.BlockWithRWSet) *Blockchain {
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockBlockchainStore(ctrl)
+	store.EXPECT().GetLastBlock().Return(blocks[len(blocks)-1].Block, nil).AnyTimes()
+	store.EXPECT().GetBlock(gomock.Any()).DoAndReturn(func(height uint64) (*commonPb.Block, error) {
+		return blocks[height].Block, nil
+	}).AnyTimes()
+	store.EXPECT().GetBlockWithRWSets(gomock.Any()).DoAndReturn(
+		func(height uint64) (*storePb.BlockWithRWSet, error) {
+			return blocks[height], nil
+		}).AnyTimes()
+	chainConf := mock.NewMockChainConf(ctrl)
+	chainConf.EXPECT().ChainConfig().Return(
+		&configPb.ChainConfig{Crypto: &configPb.CryptoConfig{Hash: ledgerHashType}}).AnyTimes()
+
+	chain := NewBlockchain("", "chain1", nil, nil)
+	chain.store = &readOnlyStore{BlockchainStore: store}
+	chain.chainConf = chainConf
+	return chain
+}
+
+func TestVerifyLedger(t *testing.T) {
+	var blocks []*storePb.BlockWithRWSet
+	var preHash []byte
+	for height := uint64(0); height < 4; height++ {
+		block := newLedgerBlock(t, height, preHash)
+		blocks = append(blocks, block)
+		preHash = block.Block.Header.BlockHash
+	}
+
+	report := newLedgerChain(t, blocks).VerifyLedger(&VerifyLedgerOptions{})
+	require.True(t, report.Success, report.FailedBlocks)
+	require.EqualValues(t, 4, report.BlockCount)
+	require.EqualValues(t, 3, report.LastHeight)
+
+	// the stored rw set of block 2 is tampered with
+	blocks[2].TxRWSets[0].TxWrites[0].Value = []byte("tampered")
+	report = newLedgerChain(t, blocks).VerifyLedger(&VerifyLedgerOptions{StartHeight: 1})
+	require.False(t, report.Success)
+	require.EqualValues(t, 3, report.BlockCount)
+	require.Equal(t, 1, report.FailedCount)
+	require.EqualValues(t, 2, report.FailedBlocks[0].Height)
+	require.Contains(t, report.FailedBlocks[0].Failed, LedgerCheckRWSet)
+
+	// a tx of block 1 is tampered with and its block hash recalculated, the hash chain breaks at block 2
+	blocks[2] = newLedgerBlock(t, 2, blocks[1].Block.Header.BlockHash)
+	blocks[1].Block.Txs[0].Payload.TxId = "tampered"
+	blocks[1].Block.Header.BlockHash, _ = utils.CalcBlockHash(ledgerHashType, blocks[1].Block)
+	report = newLedgerChain(t, blocks).VerifyLedger(&VerifyLedgerOptions{})
+	require.False(t, report.Success)
+	require.Equal(t, 2, report.FailedCount)
+	require.EqualValues(t, 1, report.FailedBlocks[0].Height)
+	require.Contains(t, report.FailedBlocks[0].Failed, LedgerCheckRWSet)
+	require.EqualValues(t, 2, report.FailedBlocks[1].Height)
+	require.Contains(t, report.FailedBlocks[1].Failed, LedgerCheckPreHash)
+}
+
+func TestReadOnlyStore(t *testing.T) {
+	chain := newLedgerChain(t, []*storePb.BlockWithRWSet{newLedgerBlock(t, 0, nil)})
+	require.ErrorIs(t, chain.store.PutBlock(&commonPb.Block{}, nil), errReadOnlyStore)
+	require.ErrorIs(t, chain.store.InitGenesis(&storePb.BlockWithRWSet{}), errReadOnlyStore)
+	require.ErrorIs(t, chain.store.ArchiveBlock(1), errReadOnlyStore)
+	require.ErrorIs(t, chain.store.RestoreBlocks(nil), errReadOnlyStore)
+	_, err := chain.store.GetLastBlock()
+	require.NoError(t, err)
+}
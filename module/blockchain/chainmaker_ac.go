@@ -0,0 +1,66 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"fmt"
+
+	"chainmaker.org/chainmaker-go/module/accesscontrol"
+	"chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// ExplainAuthorization judge the endorsements by the endorsement policy of the resource on the chain as of its
+// current block version, and explain the verdict of each endorsement. Nothing is submitted, see
+// accesscontrol.AuthorizationExplainer for the message and the target organization.
+func (server *ChainMakerServer) ExplainAuthorization(chainId, resourceName string,
+	endorsements []*common.EndorsementEntry, message []byte, targetOrgId string) (
+	*accesscontrol.AuthorizationExplanation, error) {
+	bc, err := server.GetBlockchain(chainId)
+	if err != nil {
+		return nil, err
+	}
+	return bc.explainAuthorization(resourceName, endorsements, message, targetOrgId)
+}
+
+func (bc *Blockchain) explainAuthorization(resourceName string, endorsements []*common.EndorsementEntry,
+	message []byte, targetOrgId string) (*accesscontrol.AuthorizationExplanation, error) {
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+
+	if bc.ac == nil || bc.chainConf == nil {
+		return nil, fmt.Errorf("access control of chain[%s] is not initialized", bc.chainId)
+	}
+	explainer, ok := bc.ac.(accesscontrol.AuthorizationExplainer)
+	if !ok {
+		return nil, fmt.Errorf("access control of chain[%s] can not explain the authorization", bc.chainId)
+	}
+	blockVersion := bc.chainConf.ChainConfig().GetBlockVersion()
+	if blockVersion == 0 {
+		blockVersion = protocol.DefaultBlockVersion
+	}
+	return explainer.ExplainAuthorization(resourceName, endorsements, message, targetOrgId, blockVersion)
+}
+
+// GetRevocationLists get the status of the CRLs stored by the access control of the chain
+func (server *ChainMakerServer) GetRevocationLists(chainId string) ([]*accesscontrol.RevocationListStatus, error) {
+	bc, err := server.GetBlockchain(chainId)
+	if err != nil {
+		return nil, err
+	}
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+
+	if bc.ac == nil {
+		return nil, fmt.Errorf("access control of chain[%s] is not initialized", chainId)
+	}
+	provider, ok := bc.ac.(accesscontrol.RevocationListProvider)
+	if !ok {
+		return nil, fmt.Errorf("access control of chain[%s] has no certificate revocation list", chainId)
+	}
+	return provider.GetRevocationLists(), nil
+}
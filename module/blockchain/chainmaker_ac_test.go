@@ -0,0 +1,34 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainAuthorization(t *testing.T) {
+	server := NewChainMakerServer()
+	server.blockchains.Store("chain1", NewBlockchain("", "chain1", nil, nil))
+
+	// the access control of the chain is not initialized
+	_, err := server.ExplainAuthorization("chain1", "TEST_CONTRACT-TEST_METHOD", nil, nil, "")
+	require.Error(t, err)
+	_, err = server.ExplainAuthorization("chain2", "TEST_CONTRACT-TEST_METHOD", nil, nil, "")
+	require.Error(t, err)
+}
+
+func TestGetRevocationLists(t *testing.T) {
+	server := NewChainMakerServer()
+	server.blockchains.Store("chain1", NewBlockchain("", "chain1", nil, nil))
+
+	_, err := server.GetRevocationLists("chain1")
+	require.Error(t, err)
+	_, err = server.GetRevocationLists("chain2")
+	require.Error(t, err)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// the keys of the blockchain list in the config file of the node, see localconf.BlockchainConfig
+const (
+	chainListKey        = "blockchain"
+	chainListChainIdKey = "chainId"
+	chainListGenesisKey = "genesis"
+)
+
+// chainListLock serializes the changes of the blockchain list, in the running config and in the config file
+var chainListLock sync.Mutex
+
+// checkChainListWritable check that the blockchain list of the config file can be saved, the file is replaced
+// through a temp file beside it
+func checkChainListWritable(file string) error {
+	if _, err := os.Stat(file); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	_ = tmp.Close()
+	return os.Remove(tmp.Name())
+}
+
+// saveChainList replace the blockchain list of the config file by chains, so that the chains joined and left at
+// runtime are kept across restarts. The rest of the file is kept, comments included, though the blank lines, the
+// indents and the quotes are normalized. The file is replaced through a temp file, so it is never half written.
+func saveChainList(file string, chains []localconf.BlockchainConfig) error {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err = yaml.Unmarshal(bz, &doc); err != nil {
+		return fmt.Errorf("parse config file %s failed, %s", file, err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return fmt.Errorf("config file %s is not a yaml mapping", file)
+	}
+
+	root := doc.Content[0]
+	var list *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if strings.EqualFold(root.Content[i].Value, chainListKey) {
+			list = root.Content[i+1]
+			break
+		}
+	}
+	if list == nil {
+		list = &yaml.Node{}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: chainListKey}, list)
+	}
+	setChainListNode(list, chains)
+
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&doc); err != nil {
+		return err
+	}
+	if err = encoder.Close(); err != nil {
+		return err
+	}
+	return replaceFile(file, buf.Bytes())
+}
+
+// setChainListNode set the items of the yaml sequence to chains, the items of the chains which are kept are reused
+// with their comments
+func setChainListNode(list *yaml.Node, chains []localconf.BlockchainConfig) {
+	kept := make(map[localconf.BlockchainConfig]*yaml.Node)
+	if list.Kind == yaml.SequenceNode {
+		for _, item := range list.Content {
+			var chain localconf.BlockchainConfig
+			if item.Kind == yaml.MappingNode {
+				for i := 0; i+1 < len(item.Content); i += 2 {
+					switch strings.ToLower(item.Content[i].Value) {
+					case strings.ToLower(chainListChainIdKey):
+						chain.ChainId = item.Content[i+1].Value
+					case chainListGenesisKey:
+						chain.Genesis = item.Content[i+1].Value
+					}
+				}
+			}
+			kept[chain] = item
+		}
+	}
+
+	items := make([]*yaml.Node, 0, len(chains))
+	for _, chain := range chains {
+		item, ok := kept[chain]
+		if !ok {
+			item = &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: chainListChainIdKey},
+				{Kind: yaml.ScalarNode, Value: chain.ChainId},
+				{Kind: yaml.ScalarNode, Value: chainListGenesisKey},
+				{Kind: yaml.ScalarNode, Value: chain.Genesis},
+			}}
+		}
+		items = append(items, item)
+	}
+	list.Kind, list.Tag, list.Value, list.Content = yaml.SequenceNode, "", "", items
+}
+
+// replaceFile write the content to a temp file beside the file and rename it to the file, keeping its mode
+func replaceFile(file string, content []byte) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(content); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), file)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainListConfig = `# the log config
+log:
+  config_file: ../config/wx-org1/log.yml
+
+blockchain:
+  # chain id and its genesis block file path
+  - chainId: chain1
+    genesis: ../config/wx-org1/chainconfig/bc1.yml
+  - chainId: chain2
+    genesis: ../config/wx-org1/chainconfig/bc2.yml
+
+node:
+  org_id: wx-org1.chainmaker.org
+`
+
+func loadChainList(t *testing.T, file string) []localconf.BlockchainConfig {
+	v := viper.New()
+	v.SetConfigFile(file)
+	require.NoError(t, v.ReadInConfig())
+	var chains []localconf.BlockchainConfig
+	require.NoError(t, v.UnmarshalKey(chainListKey, &chains))
+	require.Equal(t, "wx-org1.chainmaker.org", v.GetString("node.org_id"))
+	return chains
+}
+
+func TestSaveChainList(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "chainmaker.yml")
+	require.NoError(t, os.WriteFile(file, []byte(testChainListConfig), 0640))
+	require.NoError(t, checkChainListWritable(file))
+
+	chains := []localconf.BlockchainConfig{
+		{ChainId: "chain1", Genesis: "../config/wx-org1/chainconfig/bc1.yml"},
+		{ChainId: "chain3", Genesis: "/data/config/joined/chain3/genesis.yml"},
+	}
+	require.NoError(t, saveChainList(file, chains))
+	require.Equal(t, chains, loadChainList(t, file))
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "# the log config")
+	require.Contains(t, string(bz), "# chain id and its genesis block file path")
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0640), info.Mode().Perm())
+
+	require.NoError(t, saveChainList(file, nil))
+	require.Empty(t, loadChainList(t, file))
+	require.NoError(t, saveChainList(file, chains[1:]))
+	require.Equal(t, chains[1:], loadChainList(t, file))
+
+	// no temp file is left
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	absent := filepath.Join(dir, "absent.yml")
+	require.Error(t, checkChainListWritable(absent))
+	require.Error(t, saveChainList(absent, chains))
+}
+
+func TestSaveChainList_NoList(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, os.WriteFile(file, []byte("node:\n  org_id: wx-org1.chainmaker.org\n"), 0600))
+
+	chains := []localconf.BlockchainConfig{{ChainId: "chain1", Genesis: "bc1.yml"}}
+	require.NoError(t, saveChainList(file, chains))
+	require.Equal(t, chains, loadChainList(t, file))
+}
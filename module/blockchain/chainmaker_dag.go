@@ -0,0 +1,91 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"fmt"
+
+	"chainmaker.org/chainmaker-go/module/core/common/scheduler"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// maxHotKeyBlocks the max number of the blocks whose keys are aggregated by GetHotKeys at a time
+const maxHotKeyBlocks = 1000
+
+// AnalyzeBlockDAG rebuild the conflicts between the txs of the block at the height from the stored read-write sets,
+// and analyze the DAG of the block
+func (server *ChainMakerServer) AnalyzeBlockDAG(chainId string, height uint64) (*scheduler.DAGAnalysis, error) {
+	bc, err := server.GetBlockchain(chainId)
+	if err != nil {
+		return nil, err
+	}
+	store, err := bc.getAnalysisStore()
+	if err != nil {
+		return nil, err
+	}
+	return analyzeStoredBlockDAG(store, height)
+}
+
+// GetHotKeys aggregate the accesses of the keys by the txs of the blocks from startHeight to endHeight, and returns
+// at most top keys with the most conflicts. The range is limited to maxHotKeyBlocks blocks, and ends at the last
+// block if endHeight is 0 or beyond it.
+func (server *ChainMakerServer) GetHotKeys(chainId string, startHeight, endHeight uint64, top int) (
+	*scheduler.HotKeyReport, error) {
+	bc, err := server.GetBlockchain(chainId)
+	if err != nil {
+		return nil, err
+	}
+	store, err := bc.getAnalysisStore()
+	if err != nil {
+		return nil, err
+	}
+	lastBlock, err := store.GetLastBlock()
+	if err != nil || lastBlock == nil {
+		return nil, fmt.Errorf("get last block failed, %v", err)
+	}
+	if endHeight == 0 || endHeight > lastBlock.Header.BlockHeight {
+		endHeight = lastBlock.Header.BlockHeight
+	}
+	if startHeight > endHeight {
+		return nil, fmt.Errorf("start height %d is beyond end height %d", startHeight, endHeight)
+	}
+	if endHeight-startHeight >= maxHotKeyBlocks {
+		return nil, fmt.Errorf("at most %d blocks are aggregated at a time", maxHotKeyBlocks)
+	}
+
+	aggregator := scheduler.NewHotKeyAggregator()
+	for height := startHeight; height <= endHeight; height++ {
+		var analysis *scheduler.DAGAnalysis
+		analysis, err = analyzeStoredBlockDAG(store, height)
+		if err != nil {
+			return nil, err
+		}
+		aggregator.Add(analysis)
+	}
+	return aggregator.Report(top), nil
+}
+
+// getAnalysisStore get the store of the chain whose blocks are analyzed
+func (bc *Blockchain) getAnalysisStore() (protocol.BlockchainStore, error) {
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+	if bc.store == nil {
+		return nil, fmt.Errorf("store of chain[%s] is not initialized", bc.chainId)
+	}
+	return bc.store, nil
+}
+
+func analyzeStoredBlockDAG(store protocol.BlockchainStore, height uint64) (*scheduler.DAGAnalysis, error) {
+	blockWithRWSets, err := store.GetBlockWithRWSets(height)
+	if err != nil {
+		return nil, fmt.Errorf("get block with rwsets of height %d failed, %s", height, err)
+	}
+	if blockWithRWSets == nil || blockWithRWSets.Block == nil {
+		return nil, fmt.Errorf("block of height %d is not found", height)
+	}
+	return scheduler.AnalyzeBlockDAG(blockWithRWSets.Block, blockWithRWSets.TxRWSets)
+}
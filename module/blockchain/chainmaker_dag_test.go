@@ -0,0 +1,63 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker/pb-go/v2/common"
+	storePb "chainmaker.org/chainmaker/pb-go/v2/store"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeBlockDAG(t *testing.T) {
+	server := NewChainMakerServer()
+	chain := NewBlockchain("", "chain1", nil, nil)
+	server.blockchains.Store("chain1", chain)
+
+	// the store of the chain is not initialized
+	_, err := server.AnalyzeBlockDAG("chain1", 1)
+	require.Error(t, err)
+	_, err = server.AnalyzeBlockDAG("chain2", 1)
+	require.Error(t, err)
+
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockBlockchainStore(ctrl)
+	chain.store = store
+	store.EXPECT().GetLastBlock().Return(&common.Block{Header: &common.BlockHeader{BlockHeight: 5}}, nil).AnyTimes()
+	store.EXPECT().GetBlockWithRWSets(gomock.Any()).DoAndReturn(func(height uint64) (*storePb.BlockWithRWSet, error) {
+		return &storePb.BlockWithRWSet{
+			Block: &common.Block{
+				Header: &common.BlockHeader{BlockHeight: height},
+				Txs: []*common.Transaction{
+					{Payload: &common.Payload{TxId: "tx1"}},
+					{Payload: &common.Payload{TxId: "tx2"}},
+				},
+			},
+			TxRWSets: []*common.TxRWSet{
+				{TxId: "tx1", TxWrites: []*common.TxWrite{{ContractName: "contract1", Key: []byte("k1")}}},
+				{TxId: "tx2", TxReads: []*common.TxRead{{ContractName: "contract1", Key: []byte("k1")}}},
+			},
+		}, nil
+	}).AnyTimes()
+
+	analysis, err := server.AnalyzeBlockDAG("chain1", 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, analysis.CriticalPathLength)
+
+	report, err := server.GetHotKeys("chain1", 2, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), report.EndHeight)
+	require.Equal(t, 4, report.BlockCount)
+	require.Len(t, report.Keys, 1)
+	require.Equal(t, 4, report.Keys[0].Conflicts)
+
+	_, err = server.GetHotKeys("chain1", 6, 0, 10)
+	require.Error(t, err)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"chainmaker.org/chainmaker/localconf/v2"
+	"github.com/spf13/viper"
+)
+
+const (
+	// GenesisBundleFile the chain config file in a genesis bundle
+	GenesisBundleFile = "genesis.yml"
+	// MaxGenesisBundleSize the max size of the files extracted from a genesis bundle
+	MaxGenesisBundleSize = 16 << 20
+	// genesisBundleDir the dir beside the config file of the node which the joined genesis bundles are extracted to
+	genesisBundleDir = "joined"
+)
+
+// ExtractGenesisBundle extract a genesis bundle into dir, and returns the chain config file in it. A genesis
+// bundle is a tar.gz of the chain config file, named GenesisBundleFile, and the files it refers to, such as
+// the certs of the trust roots. The relative paths in the chain config file are resolved against the bundle, the
+// file is rewritten with the absolute paths. dir is replaced.
+func ExtractGenesisBundle(bundle []byte, dir string) (string, error) {
+	if len(bundle) == 0 {
+		return "", errors.New("genesis bundle is empty")
+	}
+	tmpDir := dir + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return "", err
+	}
+	if err := extractTarGz(bundle, tmpDir); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return "", fmt.Errorf("extract genesis bundle failed, %s", err)
+	}
+	if err := resolveGenesisPaths(filepath.Join(tmpDir, GenesisBundleFile), dir); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return "", err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return "", err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		_ = os.RemoveAll(tmpDir)
+		return "", err
+	}
+	return filepath.Join(dir, GenesisBundleFile), nil
+}
+
+// genesisBundlePath the dir a genesis bundle of the chain is extracted to
+func genesisBundlePath(chainId string) (string, error) {
+	dir, err := filepath.Abs(filepath.Dir(localconf.ConfigFilepath))
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, genesisBundleDir, chainId), nil
+}
+
+// extractTarGz extract the regular files and the dirs of a tar.gz into dir, the entries out of dir are rejected
+func extractTarGz(bundle []byte, dir string) error {
+	gz, err := gzip.NewReader(bytes.NewReader(bundle))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	var size int64
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("entry %s is out of the bundle", header.Name)
+		}
+		path := filepath.Join(dir, name)
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			size += header.Size
+			if size > MaxGenesisBundleSize {
+				return fmt.Errorf("bundle is larger than %d bytes", MaxGenesisBundleSize)
+			}
+			if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				return err
+			}
+			if err = extractTarFile(reader, path, header.Size); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("entry %s is not a regular file or a dir", header.Name)
+		}
+	}
+}
+
+func extractTarFile(reader io.Reader, path string, size int64) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = io.CopyN(file, reader, size); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// resolveGenesisPaths rewrite the relative paths of the trust roots and the trust members in the chain config
+// file to the absolute paths under dir
+func resolveGenesisPaths(file, dir string) error {
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return fmt.Errorf("read %s of genesis bundle failed, %s", GenesisBundleFile, err)
+	}
+	resolve := func(path string) string {
+		// a cert may be given in pem rather than by its file
+		if path == "" || filepath.IsAbs(path) || strings.Contains(path, "-----BEGIN") {
+			return path
+		}
+		return filepath.Join(dir, filepath.FromSlash(path))
+	}
+
+	roots, _ := v.Get("trust_roots").([]interface{})
+	for _, root := range roots {
+		paths, _ := genesisItem(root, "root").([]interface{})
+		for i, path := range paths {
+			if s, ok := path.(string); ok {
+				paths[i] = resolve(s)
+			}
+		}
+	}
+	members, _ := v.Get("trust_members").([]interface{})
+	for i, member := range members {
+		if s, ok := genesisItem(member, "member_info").(string); ok {
+			members[i] = setGenesisItem(member, "member_info", resolve(s))
+		}
+	}
+	if roots != nil {
+		v.Set("trust_roots", roots)
+	}
+	if members != nil {
+		v.Set("trust_members", members)
+	}
+	if err := v.WriteConfigAs(file); err != nil {
+		return fmt.Errorf("write %s of genesis bundle failed, %s", GenesisBundleFile, err)
+	}
+	return nil
+}
+
+// genesisItem the value of the key of an item of a list in the chain config file, the yml decoder gives the maps
+// in the lists with interface keys
+func genesisItem(item interface{}, key string) interface{} {
+	switch m := item.(type) {
+	case map[interface{}]interface{}:
+		return m[key]
+	case map[string]interface{}:
+		return m[key]
+	}
+	return nil
+}
+
+func setGenesisItem(item interface{}, key string, value interface{}) interface{} {
+	switch m := item.(type) {
+	case map[interface{}]interface{}:
+		m[key] = value
+	case map[string]interface{}:
+		m[key] = value
+	}
+	return item
+}
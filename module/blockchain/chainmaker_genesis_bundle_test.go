@@ -0,0 +1,98 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	writer := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, writer.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)),
+			Typeflag: tar.TypeReg}))
+		_, err := writer.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestExtractGenesisBundle(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "chain1")
+	genesis := `chain_id: chain1
+trust_roots:
+  - org_id: org1
+    root:
+      - certs/org1/ca.crt
+      - /etc/org1/ca.crt
+trust_members:
+  - member_info: certs/member.crt
+    org_id: org1
+`
+	bundle := tarGz(t, map[string]string{
+		GenesisBundleFile:     genesis,
+		"certs/org1/ca.crt":   "ca",
+		"certs/member.crt":    "member",
+		"certs/unused/ca.crt": "unused",
+	})
+
+	file, err := ExtractGenesisBundle(bundle, dir)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, GenesisBundleFile), file)
+	content, err := os.ReadFile(filepath.Join(dir, "certs/org1/ca.crt"))
+	require.NoError(t, err)
+	require.Equal(t, "ca", string(content))
+
+	v := viper.New()
+	v.SetConfigFile(file)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, "chain1", v.GetString("chain_id"))
+	roots, _ := v.Get("trust_roots").([]interface{})
+	require.Len(t, roots, 1)
+	require.Equal(t, []interface{}{filepath.Join(dir, "certs/org1/ca.crt"), "/etc/org1/ca.crt"},
+		genesisItem(roots[0], "root"))
+	members, _ := v.Get("trust_members").([]interface{})
+	require.Len(t, members, 1)
+	require.Equal(t, filepath.Join(dir, "certs/member.crt"), genesisItem(members[0], "member_info"))
+
+	// extracting again replaces the dir
+	_, err = ExtractGenesisBundle(tarGz(t, map[string]string{GenesisBundleFile: "chain_id: chain1\n"}), dir)
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "certs"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestExtractGenesisBundle_Invalid(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "chain1")
+	_, err := ExtractGenesisBundle(nil, dir)
+	require.Error(t, err)
+	_, err = ExtractGenesisBundle([]byte("bundle"), dir)
+	require.Error(t, err)
+	// without the chain config file
+	_, err = ExtractGenesisBundle(tarGz(t, map[string]string{"ca.crt": "ca"}), dir)
+	require.Error(t, err)
+	// an entry out of the bundle
+	_, err = ExtractGenesisBundle(tarGz(t, map[string]string{GenesisBundleFile: "chain_id: chain1\n",
+		"../ca.crt": "ca"}), dir)
+	require.Error(t, err)
+	_, err = os.Stat(dir)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(dir + ".tmp")
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,160 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"fmt"
+	"time"
+)
+
+// healthModules the modules reported by the health of a chain, in start order
+var healthModules = []string{
+	moduleNameStore,
+	moduleNameNetService,
+	moduleNameVM,
+	moduleNameCore,
+	moduleNameConsensus,
+	moduleNameTxPool,
+	moduleNameSync,
+}
+
+// ModuleHealth whether a module of a chain is initialized and started, a module stopped by PauseChain is Paused.
+type ModuleHealth struct {
+	Name    string `json:"name"`
+	Init    bool   `json:"init"`
+	Started bool   `json:"started"`
+	Paused  bool   `json:"paused,omitempty"`
+}
+
+// ChainHealth the health of a chain. A value which could not be collected is left zero, and the reason is in
+// Errors.
+type ChainHealth struct {
+	ChainId string          `json:"chain_id"`
+	State   ChainState      `json:"state"`
+	Modules []*ModuleHealth `json:"modules"`
+	// Height the height of the last committed block
+	Height uint64 `json:"height"`
+	// PeerHeight the highest height known of the peers
+	PeerHeight uint64 `json:"peer_height"`
+	// SyncLag how many blocks the chain is behind the highest peer
+	SyncLag uint64 `json:"sync_lag"`
+	// SinceLastBlock seconds since the timestamp of the last committed block
+	SinceLastBlock  int64  `json:"since_last_block"`
+	ConsensusHeight uint64 `json:"consensus_height"`
+	// TxPoolTxs the common txs in the queue and pending of the tx pool, TxPoolSize its capacity
+	TxPoolTxs      uint64   `json:"tx_pool_txs"`
+	TxPoolSize     uint64   `json:"tx_pool_size"`
+	TxPoolFullness float64  `json:"tx_pool_fullness"`
+	Errors         []string `json:"errors,omitempty"`
+}
+
+// ModuleStarted returns whether the module is started, a module which is not reported is not started.
+func (h *ChainHealth) ModuleStarted(name string) bool {
+	for _, m := range h.Modules {
+		if m.Name == name {
+			return m.Started
+		}
+	}
+	return false
+}
+
+// GetChainHealth collect the health of a chain.
+func (server *ChainMakerServer) GetChainHealth(chainId string) (*ChainHealth, error) {
+	status, err := server.GetChainStatus(chainId)
+	if err != nil {
+		return nil, err
+	}
+	health := &ChainHealth{ChainId: chainId, State: status.State, Height: status.Height}
+	chain, err := server.GetBlockchain(chainId)
+	if err != nil {
+		// joining, left or failed
+		return health, nil
+	}
+
+	health.Modules = chain.moduleHealth()
+	for _, m := range health.Modules {
+		m.Paused = status.State == ChainStatePaused && !m.Started &&
+			(m.Name == moduleNameCore || m.Name == moduleNameConsensus)
+	}
+	chain.collectHealth(health)
+	if height, err1 := server.GetConsensusHeight(chainId); err1 == nil {
+		health.ConsensusHeight = height
+	} else if err1.Error() != notConsensusNode {
+		health.Errors = append(health.Errors, fmt.Sprintf("consensus height: %s", err1))
+	}
+	return health, nil
+}
+
+// GetAllChainHealth collect the health of all the chains of the node.
+func (server *ChainMakerServer) GetAllChainHealth() []*ChainHealth {
+	var healths []*ChainHealth
+	chainIds := make(map[string]struct{})
+	collect := func(key, _ interface{}) bool {
+		chainId, _ := key.(string)
+		if _, ok := chainIds[chainId]; ok {
+			return true
+		}
+		chainIds[chainId] = struct{}{}
+		if health, err := server.GetChainHealth(chainId); err == nil {
+			healths = append(healths, health)
+		}
+		return true
+	}
+	server.blockchains.Range(collect)
+	server.chainStatuses.Range(collect)
+	return healths
+}
+
+func (bc *Blockchain) moduleHealth() []*ModuleHealth {
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+
+	modules := make([]*ModuleHealth, 0, len(healthModules))
+	for _, name := range healthModules {
+		modules = append(modules, &ModuleHealth{
+			Name:    name,
+			Init:    bc.isModuleInit(name),
+			Started: bc.isModuleStartUp(name),
+		})
+	}
+	return modules
+}
+
+// collectHealth fill the height, sync lag, block time and tx pool of the health
+func (bc *Blockchain) collectHealth(health *ChainHealth) {
+	if bc.ledgerCache != nil {
+		if block := bc.ledgerCache.GetLastCommittedBlock(); block != nil && block.Header != nil {
+			health.Height = block.Header.BlockHeight
+			health.SinceLastBlock = time.Now().Unix() - block.Header.BlockTimestamp
+		}
+	}
+
+	if health.ModuleStarted(moduleNameSync) {
+		state, err := bc.syncServer.GetState(true)
+		if err != nil {
+			health.Errors = append(health.Errors, fmt.Sprintf("sync state: %s", err))
+		} else {
+			for _, node := range state.Others {
+				if node.Height > health.PeerHeight {
+					health.PeerHeight = node.Height
+				}
+			}
+			if health.PeerHeight > state.Height {
+				health.SyncLag = health.PeerHeight - state.Height
+			}
+		}
+	}
+
+	if health.ModuleStarted(moduleNameTxPool) {
+		poolStatus := bc.txPool.GetPoolStatus()
+		health.TxPoolTxs = uint64(poolStatus.CommonTxNumInQueue + poolStatus.CommonTxNumInPending)
+		health.TxPoolSize = uint64(poolStatus.CommonTxPoolSize)
+		if health.TxPoolSize > 0 {
+			health.TxPoolFullness = float64(health.TxPoolTxs) / float64(health.TxPoolSize)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/store/v2/conf"
+)
+
+// ChainState the state of a chain managed at runtime by the admin api.
+type ChainState string
+
+// the states of a chain, JOINING and LEAVING are transient, the operation runs in the background
+const (
+	ChainStateJoining ChainState = "JOINING"
+	ChainStateRunning ChainState = "RUNNING"
+	ChainStatePaused  ChainState = "PAUSED"
+	ChainStateLeaving ChainState = "LEAVING"
+	ChainStateLeft    ChainState = "LEFT"
+	ChainStateFailed  ChainState = "FAILED"
+)
+
+// ChainStatus the state of a chain and the result of the last lifecycle operation on it.
+type ChainStatus struct {
+	ChainId string     `json:"chain_id"`
+	State   ChainState `json:"state"`
+	Height  uint64     `json:"height"`
+	Message string     `json:"message,omitempty"`
+	// Archive the manifest file of the archived data of a left chain, for restore-dbs
+	Archive    string `json:"archive,omitempty"`
+	UpdateTime string `json:"update_time"`
+}
+
+// ChainLifecycleListener is called when a chain enters a new state. The listeners of LEAVING are called
+// before the chain is stopped, so that they can release what depends on the chain.
+type ChainLifecycleListener func(chainId string, state ChainState)
+
+// AddChainLifecycleListener register a listener of the lifecycle of the chains.
+func (server *ChainMakerServer) AddChainLifecycleListener(listener ChainLifecycleListener) {
+	server.lifecycleLock.Lock()
+	defer server.lifecycleLock.Unlock()
+	server.lifecycleListeners = append(server.lifecycleListeners, listener)
+}
+
+// GetChainStatus returns the state of a chain, a chain started with the node is RUNNING until an operation
+// on it.
+func (server *ChainMakerServer) GetChainStatus(chainId string) (*ChainStatus, error) {
+	server.lifecycleLock.Lock()
+	defer server.lifecycleLock.Unlock()
+	return server.getChainStatus(chainId)
+}
+
+func (server *ChainMakerServer) getChainStatus(chainId string) (*ChainStatus, error) {
+	status := &ChainStatus{ChainId: chainId}
+	if v, ok := server.chainStatuses.Load(chainId); ok {
+		*status = *v.(*ChainStatus)
+	} else if _, ok = server.blockchains.Load(chainId); ok {
+		status.State = ChainStateRunning
+	} else {
+		return nil, fmt.Errorf(chainIdNotFoundErrorTemplate, chainId)
+	}
+
+	if status.State == ChainStateRunning || status.State == ChainStatePaused {
+		if chain, err := server.GetBlockchain(chainId); err == nil && chain.store != nil {
+			if block, err1 := chain.store.GetLastBlock(); err1 == nil {
+				status.Height = block.Header.BlockHeight
+			}
+		}
+	}
+	return status, nil
+}
+
+// setChainStatus record the state of a chain and notify the listeners, must be called without lifecycleLock
+func (server *ChainMakerServer) setChainStatus(status *ChainStatus) {
+	status.UpdateTime = time.Now().Format(time.RFC3339)
+	server.lifecycleLock.Lock()
+	server.chainStatuses.Store(status.ChainId, status)
+	listeners := server.lifecycleListeners
+	server.lifecycleLock.Unlock()
+
+	log.Infof("chain[%s] is %s %s", status.ChainId, status.State, status.Message)
+	for _, listener := range listeners {
+		listener(status.ChainId, status.State)
+	}
+}
+
+// JoinChain init and start the chain of a genesis bundle at runtime, see ExtractGenesisBundle. The bundle is
+// extracted beside the config file of the node. It returns once the chain is JOINING, the chain is RUNNING, or
+// FAILED with the error, after it started.
+func (server *ChainMakerServer) JoinChain(chainId string, bundle []byte) (*ChainStatus, error) {
+	if chainId == "" || len(bundle) == 0 {
+		return nil, fmt.Errorf("chain id and genesis bundle are required")
+	}
+	dir, err := genesisBundlePath(chainId)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkChainListWritable(localconf.ConfigFilepath); err != nil {
+		return nil, fmt.Errorf("the chain list of the config file can not be saved, %s", err)
+	}
+
+	server.lifecycleLock.Lock()
+	if _, ok := server.blockchains.Load(chainId); ok {
+		server.lifecycleLock.Unlock()
+		return nil, fmt.Errorf("chain[%s] is already joined", chainId)
+	}
+	if status, err1 := server.getChainStatus(chainId); err1 == nil && status.State == ChainStateJoining {
+		server.lifecycleLock.Unlock()
+		return nil, fmt.Errorf("chain[%s] is %s", chainId, status.State)
+	}
+	status := &ChainStatus{ChainId: chainId, State: ChainStateJoining}
+	server.chainStatuses.Store(chainId, status)
+	server.lifecycleLock.Unlock()
+
+	genesis, err := ExtractGenesisBundle(bundle, dir)
+	if err != nil {
+		server.setChainStatus(&ChainStatus{ChainId: chainId, State: ChainStateFailed, Message: err.Error()})
+		return nil, fmt.Errorf("load genesis of chain[%s] failed, %s", chainId, err)
+	}
+	server.setChainStatus(status)
+	go server.joinChain(chainId, genesis)
+	return status, nil
+}
+
+func (server *ChainMakerServer) joinChain(chainId, genesis string) {
+	if err := server.initBlockchain(chainId, genesis); err != nil {
+		server.setChainStatus(&ChainStatus{ChainId: chainId, State: ChainStateFailed, Message: err.Error()})
+		return
+	}
+	chain, _ := server.GetBlockchain(chainId)
+	if err := chain.Start(); err != nil {
+		chain.Stop()
+		server.blockchains.Delete(chainId)
+		server.setChainStatus(&ChainStatus{ChainId: chainId, State: ChainStateFailed,
+			Message: fmt.Sprintf("start blockchain[%s] failed, %s", chainId, err)})
+		return
+	}
+	status := &ChainStatus{ChainId: chainId, State: ChainStateRunning}
+	if err := addChainConfig(chainId, genesis); err != nil {
+		status.Message = fmt.Sprintf("save the chain list of the config file failed, %s", err)
+	}
+	server.setChainStatus(status)
+}
+
+// PauseChain stop the consensus and the core engine of a chain, so that it neither proposes nor votes, the
+// chain keeps syncing blocks and serving queries.
+func (server *ChainMakerServer) PauseChain(chainId string) (*ChainStatus, error) {
+	return server.switchChain(chainId, ChainStateRunning, ChainStatePaused, (*Blockchain).Pause)
+}
+
+// ResumeChain start the consensus and the core engine of a paused chain.
+func (server *ChainMakerServer) ResumeChain(chainId string) (*ChainStatus, error) {
+	return server.switchChain(chainId, ChainStatePaused, ChainStateRunning, (*Blockchain).Resume)
+}
+
+func (server *ChainMakerServer) switchChain(chainId string, from, to ChainState,
+	fn func(*Blockchain) error) (*ChainStatus, error) {
+	server.lifecycleLock.Lock()
+	status, err := server.getChainStatus(chainId)
+	if err != nil {
+		server.lifecycleLock.Unlock()
+		return nil, err
+	}
+	if status.State != from {
+		server.lifecycleLock.Unlock()
+		return nil, fmt.Errorf("chain[%s] is %s, not %s", chainId, status.State, from)
+	}
+	chain, err := server.GetBlockchain(chainId)
+	if err != nil {
+		server.lifecycleLock.Unlock()
+		return nil, err
+	}
+	err = fn(chain)
+	server.lifecycleLock.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("chain[%s] switch to %s failed, %s", chainId, to, err)
+	}
+
+	status = &ChainStatus{ChainId: chainId, State: to, Height: status.Height}
+	server.setChainStatus(status)
+	return status, nil
+}
+
+// LeaveChain stop a chain and forget it at runtime. The listeners of LEAVING release the sync tx waiters and
+// the subscriptions of the chain first. If archive, the data of the chain is moved to a backup that
+// restore-dbs can bring back, otherwise it is left in place for a later join. It returns once the chain is
+// LEAVING.
+func (server *ChainMakerServer) LeaveChain(chainId string, archive bool) (*ChainStatus, error) {
+	server.lifecycleLock.Lock()
+	status, err := server.getChainStatus(chainId)
+	if err != nil {
+		server.lifecycleLock.Unlock()
+		return nil, err
+	}
+	if status.State != ChainStateRunning && status.State != ChainStatePaused {
+		server.lifecycleLock.Unlock()
+		return nil, fmt.Errorf("chain[%s] is %s", chainId, status.State)
+	}
+	if err = checkChainListWritable(localconf.ConfigFilepath); err != nil {
+		server.lifecycleLock.Unlock()
+		return nil, fmt.Errorf("the chain list of the config file can not be saved, %s", err)
+	}
+	if archive {
+		if err = validateArchiveConfig(); err != nil {
+			server.lifecycleLock.Unlock()
+			return nil, fmt.Errorf("chain[%s] can not be archived, %s", chainId, err)
+		}
+	}
+	chain, err := server.GetBlockchain(chainId)
+	if err != nil {
+		server.lifecycleLock.Unlock()
+		return nil, err
+	}
+	status = &ChainStatus{ChainId: chainId, State: ChainStateLeaving, Height: status.Height}
+	server.chainStatuses.Store(chainId, status)
+	server.lifecycleLock.Unlock()
+
+	server.setChainStatus(status)
+	go server.leaveChain(chain, status.Height, archive)
+	return status, nil
+}
+
+func (server *ChainMakerServer) leaveChain(chain *Blockchain, height uint64, archive bool) {
+	chainId := chain.chainId
+	chain.Stop()
+	server.blockchains.Delete(chainId)
+
+	status := &ChainStatus{ChainId: chainId, State: ChainStateLeft, Height: height}
+	if err := removeChainConfig(chainId); err != nil {
+		// the chain is left anyway, the node starts it again after a restart unless the file is fixed
+		status.Message = fmt.Sprintf("save the chain list of the config file failed, %s", err)
+	}
+	if archive {
+		config, err := conf.NewStorageConfig(localconf.ChainMakerConfig.StorageConfig)
+		if err != nil {
+			status.Message = strings.TrimPrefix(status.Message+"; archive failed, "+err.Error(), "; ")
+			server.setChainStatus(status)
+			return
+		}
+		backupId := fmt.Sprintf("leave-%d", time.Now().Unix())
+		if _, err = BackupDbs(chainId, backupId, config); err != nil {
+			status.Message = strings.TrimPrefix(status.Message+"; archive failed, "+err.Error(), "; ")
+			server.setChainStatus(status)
+			return
+		}
+		status.Archive = DbBackupManifestFile(config.StorePath, chainId, backupId)
+	}
+	server.setChainStatus(status)
+}
+
+// validateArchiveConfig check that the stores of the local config can be backed up by the archive
+func validateArchiveConfig() error {
+	config, err := conf.NewStorageConfig(localconf.ChainMakerConfig.StorageConfig)
+	if err != nil {
+		return err
+	}
+	return ValidateDbBackupConfig(config)
+}
+
+// addChainConfig add the chain to the blockchain list of the running config, so that the modules which list the
+// chains from it find the joined chain, and save the list to the config file, so that the node starts the chain
+// after a restart. The running config is replaced by a copy, as it is read without a lock.
+func addChainConfig(chainId, genesis string) error {
+	return updateChainList(func(chains []localconf.BlockchainConfig) []localconf.BlockchainConfig {
+		return append(chains, localconf.BlockchainConfig{ChainId: chainId, Genesis: genesis})
+	}, chainId)
+}
+
+// removeChainConfig remove the chain from the blockchain list of the running config and the config file
+func removeChainConfig(chainId string) error {
+	return updateChainList(func(chains []localconf.BlockchainConfig) []localconf.BlockchainConfig {
+		return chains
+	}, chainId)
+}
+
+// updateChainList drop the chain from the blockchain list of the running config, pass the rest to fn, publish the
+// list it returns and save it to the config file
+func updateChainList(fn func([]localconf.BlockchainConfig) []localconf.BlockchainConfig, chainId string) error {
+	chainListLock.Lock()
+	defer chainListLock.Unlock()
+
+	var chains []localconf.BlockchainConfig
+	confreload.Update(func(config *localconf.CMConfig) {
+		rest := make([]localconf.BlockchainConfig, 0, len(config.BlockChainConfig)+1)
+		for _, chain := range config.BlockChainConfig {
+			if chain.ChainId != chainId {
+				rest = append(rest, chain)
+			}
+		}
+		config.BlockChainConfig = fn(rest)
+		chains = config.BlockChainConfig
+	})
+	return saveChainList(localconf.ConfigFilepath, chains)
+}
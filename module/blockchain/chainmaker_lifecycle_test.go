@@ -0,0 +1,108 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainLifecycle(t *testing.T) {
+	oldFile := localconf.ConfigFilepath
+	defer func() {
+		localconf.ConfigFilepath = oldFile
+	}()
+	localconf.ConfigFilepath = filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, os.WriteFile(localconf.ConfigFilepath, []byte(testChainListConfig), 0600))
+
+	server := NewChainMakerServer()
+	server.blockchains.Store("chain1", NewBlockchain("", "chain1", nil, nil))
+
+	var (
+		mu     sync.Mutex
+		states []ChainState
+	)
+	server.AddChainLifecycleListener(func(chainId string, state ChainState) {
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, "chain1", chainId)
+		states = append(states, state)
+	})
+
+	_, err := server.GetChainStatus("chain2")
+	require.Error(t, err)
+	status, err := server.GetChainStatus("chain1")
+	require.NoError(t, err)
+	require.Equal(t, ChainStateRunning, status.State)
+
+	_, err = server.ResumeChain("chain1")
+	require.Error(t, err)
+	status, err = server.PauseChain("chain1")
+	require.NoError(t, err)
+	require.Equal(t, ChainStatePaused, status.State)
+	_, err = server.PauseChain("chain1")
+	require.Error(t, err)
+	status, err = server.ResumeChain("chain1")
+	require.NoError(t, err)
+	require.Equal(t, ChainStateRunning, status.State)
+
+	_, err = server.JoinChain("chain1", nil)
+	require.Error(t, err)
+	_, err = server.JoinChain("chain1", []byte("bundle"))
+	require.Error(t, err)
+
+	status, err = server.LeaveChain("chain1", false)
+	require.NoError(t, err)
+	require.Equal(t, ChainStateLeaving, status.State)
+	require.Eventually(t, func() bool {
+		status, err = server.GetChainStatus("chain1")
+		return err == nil && status.State == ChainStateLeft
+	}, time.Second, 10*time.Millisecond)
+	require.Empty(t, status.Message)
+	require.NotContains(t, loadChainList(t, localconf.ConfigFilepath),
+		localconf.BlockchainConfig{ChainId: "chain1", Genesis: "../config/wx-org1/chainconfig/bc1.yml"})
+	_, err = server.GetBlockchain("chain1")
+	require.Error(t, err)
+	_, err = server.LeaveChain("chain1", false)
+	require.Error(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []ChainState{ChainStatePaused, ChainStateRunning, ChainStateLeaving, ChainStateLeft}, states)
+}
+
+func TestGetChainHealth(t *testing.T) {
+	server := NewChainMakerServer()
+	chain := NewBlockchain("", "chain1", nil, nil)
+	chain.initModules[moduleNameStore] = struct{}{}
+	chain.startModules[moduleNameStore] = struct{}{}
+	server.blockchains.Store("chain1", chain)
+
+	health, err := server.GetChainHealth("chain1")
+	require.NoError(t, err)
+	require.Equal(t, ChainStateRunning, health.State)
+	require.Len(t, health.Modules, len(healthModules))
+	require.True(t, health.ModuleStarted(moduleNameStore))
+	require.False(t, health.ModuleStarted(moduleNameSync))
+	require.Empty(t, health.Errors)
+
+	_, err = server.PauseChain("chain1")
+	require.NoError(t, err)
+	health, err = server.GetChainHealth("chain1")
+	require.NoError(t, err)
+	require.Equal(t, ChainStatePaused, health.State)
+	require.Len(t, server.GetAllChainHealth(), 1)
+
+	_, err = server.GetChainHealth("chain2")
+	require.Error(t, err)
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	"chainmaker.org/chainmaker-go/module/txfilter"
+	"chainmaker.org/chainmaker-go/module/txfilter/filtercommon"
+	logger "chainmaker.org/chainmaker/logger/v2"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// the keys of the local config the chains apply at runtime, see confreload.Register. The other items of the sync
+// config, such as the block pool size and the preference nodes, need a restart.
+const (
+	reloadKeySyncPrefix = "sync."
+	reloadKeyTxFilter   = "tx_filter"
+)
+
+var chainReloadKeys = []string{
+	reloadKeySyncPrefix + "process_block_tick",
+	reloadKeySyncPrefix + "scheduler_tick",
+	reloadKeySyncPrefix + "node_status_tick",
+	reloadKeySyncPrefix + "liveness_tick",
+	reloadKeySyncPrefix + "data_detection_tick",
+	reloadKeySyncPrefix + "block_request_time",
+	reloadKeyTxFilter,
+}
+
+// syncTickersReloader the sync service whose tickers follow the reloads of the local config
+type syncTickersReloader interface {
+	ReloadTickers() error
+}
+
+// registerConfigReloader let the chains follow the reloads of the local config
+func (server *ChainMakerServer) registerConfigReloader() {
+	confreload.Register("blockchain", chainReloadKeys, server.reloadChainConfig)
+}
+
+// reloadChainConfig apply the reloaded keys to every chain, the sync tickers are reset and the tx filter is
+// rebuilt. The tx filter config is checked for every chain before any of them is rebuilt.
+func (server *ChainMakerServer) reloadChainConfig(keys []string) error {
+	syncChanged, txFilterChanged := false, false
+	for _, key := range keys {
+		if strings.HasPrefix(key, reloadKeySyncPrefix) {
+			syncChanged = true
+		} else {
+			txFilterChanged = true
+		}
+	}
+
+	var chains []*Blockchain
+	server.blockchains.Range(func(_, value interface{}) bool {
+		chains = append(chains, value.(*Blockchain))
+		return true
+	})
+
+	txFilterConfigs := make(map[string]*filtercommon.TxFilterConfig, len(chains))
+	if txFilterChanged {
+		for _, bc := range chains {
+			config, err := filtercommon.GetConf(bc.chainId)
+			if err != nil {
+				return fmt.Errorf("invalid tx filter config, %s", err)
+			}
+			txFilterConfigs[bc.chainId] = config
+		}
+	}
+
+	var errs []string
+	for _, bc := range chains {
+		if txFilterChanged {
+			if err := bc.reloadTxFilter(txFilterConfigs[bc.chainId]); err != nil {
+				errs = append(errs, fmt.Sprintf("reload tx filter of chain[%s] failed, %s", bc.chainId, err))
+			}
+		}
+		if syncChanged {
+			bc.reloadSyncTickers()
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// reloadTxFilter build a tx filter of the config and chase it up to the store height, then replace the one in use
+// once it caught up with the blocks committed while it was chased, the commits waiting meanwhile
+func (bc *Blockchain) reloadTxFilter(config *filtercommon.TxFilterConfig) error {
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+
+	filter, ok := bc.txFilter.(*txfilter.ReloadableTxFilter)
+	if !ok {
+		// not initialized yet, it is built from the reloaded config
+		return nil
+	}
+	log := logger.GetLoggerByChain(logger.MODULE_TXFILTER, bc.chainId)
+	newFilter, err := txfilter.Factory().NewTxFilter(config, log, bc.store)
+	if err != nil {
+		return err
+	}
+	// the map filter is not chased on start either
+	chase := func(protocol.TxFilter) error { return nil }
+	if config.Type != filtercommon.TxFilterTypeDefault && config.Type != filtercommon.TxFilterTypeMap {
+		chase = func(f protocol.TxFilter) error { return filtercommon.ChaseBlockHeight(bc.store, f, log) }
+	}
+	if err = chase(newFilter); err != nil {
+		newFilter.Close()
+		return err
+	}
+	replaced, err := filter.ReplaceAfter(newFilter, chase)
+	if err != nil {
+		newFilter.Close()
+		return err
+	}
+	replaced.Close()
+	log.Infof("tx filter reloaded, type: %d", config.Type)
+	return nil
+}
+
+// reloadSyncTickers reset the tickers of the sync service if it is started
+func (bc *Blockchain) reloadSyncTickers() {
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+
+	if !bc.isModuleStartUp(moduleNameSync) {
+		return
+	}
+	reloader, ok := bc.syncServer.(syncTickersReloader)
+	if !ok {
+		return
+	}
+	if err := reloader.ReloadTickers(); err != nil {
+		bc.log.Warnf("reload sync tickers failed, %s", err)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/txfilter"
+	"chainmaker.org/chainmaker-go/module/txfilter/filtercommon"
+	mapimpl "chainmaker.org/chainmaker-go/module/txfilter/map"
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadChainConfig(t *testing.T) {
+	server := NewChainMakerServer()
+	chain := NewBlockchain("", "chain1", nil, nil)
+	old := mapimpl.New()
+	filter := txfilter.NewReloadableTxFilter(old)
+	chain.txFilter = filter
+	server.blockchains.Store("chain1", chain)
+
+	txFilterType := localconf.ChainMakerConfig.TxFilter.Type
+	defer func() {
+		localconf.ChainMakerConfig.TxFilter.Type = txFilterType
+	}()
+	localconf.ChainMakerConfig.TxFilter.Type = int32(filtercommon.TxFilterTypeMap)
+
+	// the sync service is not started, its tickers are skipped
+	require.NoError(t, server.reloadChainConfig([]string{reloadKeySyncPrefix + "scheduler_tick"}))
+	require.Same(t, old, filter.Current())
+
+	require.NoError(t, server.reloadChainConfig([]string{reloadKeyTxFilter + ".type"}))
+	require.NotSame(t, old, filter.Current())
+	require.IsType(t, &mapimpl.TxFilter{}, filter.Current())
+
+	// the config is checked before any tx filter is rebuilt
+	localconf.ChainMakerConfig.TxFilter.Type = int32(filtercommon.TxFilterTypeBirdsNest)
+	current := filter.Current()
+	require.Error(t, server.reloadChainConfig([]string{reloadKeyTxFilter + ".type"}))
+	require.Same(t, current, filter.Current())
+}
@@ -22,6 +22,7 @@ import (
 
 	"chainmaker.org/chainmaker/vm-evm/v2/evm-go/math"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/net"
 	"chainmaker.org/chainmaker-go/module/subscriber"
 	"chainmaker.org/chainmaker/common/v2/crypto/asym"
@@ -51,6 +52,11 @@ type ChainMakerServer struct {
 	blockchains sync.Map // map[string]*Blockchain
 
 	readyC chan struct{}
+
+	// lifecycleLock serialize the lifecycle operations on the chains at runtime
+	lifecycleLock      sync.Mutex
+	chainStatuses      sync.Map // map[string]*ChainStatus
+	lifecycleListeners []ChainLifecycleListener
 }
 
 // NewChainMakerServer create a new ChainMakerServer instance.
@@ -71,6 +77,7 @@ func (server *ChainMakerServer) Init() error {
 	if err = server.initBlockchains(); err != nil {
 		return err
 	}
+	server.registerConfigReloader()
 	log.Info("init chain maker server success!")
 	return nil
 }
@@ -91,6 +98,42 @@ func (server *ChainMakerServer) InitForRebuildDbs(chainId string) error {
 	log.Info("init chain maker server success!")
 	return nil
 }
+
+// InitForVerifyLedger init ChainMakerServer for auditing the ledger of chainId offline.
+func (server *ChainMakerServer) InitForVerifyLedger(chainId string) error {
+	server.blockchains = sync.Map{}
+	for _, chain := range confreload.Config().GetBlockChains() {
+		if chain.ChainId != chainId {
+			continue
+		}
+		genesis, err := filepath.Abs(chain.Genesis)
+		if err != nil {
+			return err
+		}
+		blockchain := NewBlockchain(genesis, chainId, msgbus.NewMessageBus(), nil)
+		if err = blockchain.InitForVerifyLedger(); err != nil {
+			return fmt.Errorf("init blockchain[%s] failed, %s", chainId, err)
+		}
+		server.blockchains.Store(chainId, blockchain)
+		return nil
+	}
+	return fmt.Errorf(chainIdNotFoundErrorTemplate, chainId)
+}
+
+// VerifyLedger audit the ledger of chainId, the store is closed once the audit finished.
+func (server *ChainMakerServer) VerifyLedger(chainId string, opts *VerifyLedgerOptions) (*VerifyLedgerReport, error) {
+	blockchain, err := server.GetBlockchain(chainId)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := blockchain.store.Close(); closeErr != nil {
+			log.Errorf("close store of chain[%s] failed, %s", chainId, closeErr)
+		}
+	}()
+	return blockchain.VerifyLedger(opts), nil
+}
+
 func (server *ChainMakerServer) initNet() error {
 	var netType protocol.NetType
 	var err error
@@ -268,7 +311,7 @@ func initKMS() error {
 func (server *ChainMakerServer) initBlockchains() error {
 	server.blockchains = sync.Map{}
 	ok := false
-	for _, chain := range localconf.ChainMakerConfig.GetBlockChains() {
+	for _, chain := range confreload.Config().GetBlockChains() {
 		chainId := chain.ChainId
 		if err := server.initBlockchain(chainId, chain.Genesis); err != nil {
 			log.Error(err.Error())
@@ -287,7 +330,7 @@ func (server *ChainMakerServer) initBlockchains() error {
 func (server *ChainMakerServer) initBlockchainsForRebuildDbs(chainId string) error {
 	server.blockchains = sync.Map{}
 	ok := false
-	for _, chain := range localconf.ChainMakerConfig.GetBlockChains() {
+	for _, chain := range confreload.Config().GetBlockChains() {
 		if chainId == chain.ChainId {
 			if err := server.initBlockchainForRebuildDbs(chainId, chain.Genesis); err != nil {
 				return err
@@ -315,7 +358,7 @@ func (server *ChainMakerServer) newBlockchainTaskListener() {
 			continue
 		}
 		log.Infof("new block chain found(chain-id: %s), start to init new block chain.", newChainId)
-		for _, chain := range localconf.ChainMakerConfig.GetBlockChains() {
+		for _, chain := range confreload.Config().GetBlockChains() {
 			if chain.ChainId == newChainId {
 				if err := server.initBlockchain(newChainId, chain.Genesis); err != nil {
 					log.Error(err.Error())
@@ -387,13 +430,18 @@ func startBlockchain(chain *Blockchain) {
 	}
 	log.Infof("[Core] start blockchain[%s] success", chain.chainId)
 }
-func startBlockchainForRebuildDbs(chain *Blockchain, needVerify bool) {
+func startBlockchainForRebuildDbs(chain *Blockchain, opts *RebuildOptions,
+	reportC chan<- *RebuildReport) {
 	if err := chain.StartForRebuildDbs(); err != nil {
 		log.Errorf("[Core] start blockchain[%s] rebuild-dbs failed, %s", chain.chainId, err.Error())
-		os.Exit(-1)
+		report := &RebuildReport{ChainId: chain.chainId, NeedVerify: opts.NeedVerify}
+		report.fail(fmt.Errorf("start blockchain failed, %s", err))
+		report.finish()
+		reportC <- report
+		return
 	}
 	log.Infof("[Core] start blockchain[%s] rebuild-dbs success", chain.chainId)
-	chain.RebuildDbs(needVerify)
+	reportC <- chain.RebuildDbs(opts)
 }
 
 // Start ChainMakerServer.
@@ -419,24 +467,20 @@ func (server *ChainMakerServer) Start() error {
 	return nil
 }
 
-// Start ChainMakerServer for rebuild dbs.
-func (server *ChainMakerServer) StartForRebuildDbs(needVerify bool) error {
-	// 1) start Net
-	//if err := server.net.Start(); err != nil {
-	//	log.Errorf("[Net] start failed, %s", err.Error())
-	//	return err
-	//}
-	//log.Infof("[Net] start success!")
-	// 2) start blockchains
+// StartForRebuildDbs start ChainMakerServer for rebuild dbs, the report of the rebuild is sent to
+// the returned channel once the rebuild finished, failed or was stopped.
+func (server *ChainMakerServer) StartForRebuildDbs(opts *RebuildOptions) (<-chan *RebuildReport, error) {
+	reportC := make(chan *RebuildReport, 1)
+	// 1) start blockchains
 	server.blockchains.Range(func(_, value interface{}) bool {
 		chain, _ := value.(*Blockchain)
-		go startBlockchainForRebuildDbs(chain, needVerify)
+		go startBlockchainForRebuildDbs(chain, opts, reportC)
 		return true
 	})
 
-	// 3) ready
+	// 2) ready
 	close(server.readyC)
-	return nil
+	return reportC, nil
 }
 
 // Stop ChainMakerServer.
@@ -455,7 +499,10 @@ func (server *ChainMakerServer) Stop() {
 	wg.Wait()
 	log.Info("ChainMaker server is stopped!")
 
-	// stop net
+	// stop net, there is no net when rebuilding dbs
+	if server.net == nil {
+		return
+	}
 	if err := server.net.Stop(); err != nil {
 		log.Errorf("stop net failed, %s", err.Error())
 	}
@@ -471,6 +518,22 @@ func (server *ChainMakerServer) AddTx(chainId string, tx *common.Transaction, so
 	return fmt.Errorf(chainIdNotFoundErrorTemplate, chainId)
 }
 
+// AddTxs add the txs of a chain to its tx pool one by one, and returns the error of each of them. The tx pools
+// have no batch add, a batch saves the lookup of the chain only.
+func (server *ChainMakerServer) AddTxs(chainId string, txs []*common.Transaction,
+	source protocol.TxSource) ([]error, error) {
+	blockchain, ok := server.blockchains.Load(chainId)
+	if !ok {
+		return nil, fmt.Errorf(chainIdNotFoundErrorTemplate, chainId)
+	}
+	txPool := blockchain.(*Blockchain).txPool
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
+		errs[i] = txPool.AddTx(tx, source)
+	}
+	return errs, nil
+}
+
 // GetPoolStatus Returns the max size of config transaction pool and common transaction pool,
 // the num of config transaction in queue and pendingCache,
 // and the the num of common transaction in queue and pendingCache.
@@ -556,6 +619,15 @@ func (server *ChainMakerServer) GetStore(chainId string) (protocol.BlockchainSto
 	return nil, fmt.Errorf(chainIdNotFoundErrorTemplate, chainId)
 }
 
+// GetTxFilter get protocol.TxFilter of chain which id is the given.
+func (server *ChainMakerServer) GetTxFilter(chainId string) (protocol.TxFilter, error) {
+	if blockchain, ok := server.blockchains.Load(chainId); ok {
+		return blockchain.(*Blockchain).txFilter, nil
+	}
+
+	return nil, fmt.Errorf(chainIdNotFoundErrorTemplate, chainId)
+}
+
 // GetChainConf get protocol.ChainConf of chain which id is the given.
 func (server *ChainMakerServer) GetChainConf(chainId string) (protocol.ChainConf, error) {
 	if blockchain, ok := server.blockchains.Load(chainId); ok {
@@ -397,7 +397,7 @@ func TestChainMakerServer_StartForRebuildDbs(t *testing.T) {
 				net:    tt.fields.net,
 				readyC: tt.fields.readyC,
 			}
-			if err := server.StartForRebuildDbs(false); (err != nil) != tt.wantErr {
+			if _, err := server.StartForRebuildDbs(&RebuildOptions{}); (err != nil) != tt.wantErr {
 				t.Errorf("StartForRebuildDbs() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -0,0 +1,95 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"fmt"
+
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
+)
+
+// syncPeerSelector the sync service whose strategy to select the peer which the blocks are requested from can be
+// changed at runtime
+type syncPeerSelector interface {
+	SetPeerSelectStrategy(strategy string) error
+}
+
+// syncQuarantineProvider the sync service keeping the blocks failed to be verified
+type syncQuarantineProvider interface {
+	GetQuarantine() *blockSync.Quarantine
+}
+
+// syncPeerScoresProvider the sync service scoring the peers which the blocks are requested from
+type syncPeerScoresProvider interface {
+	GetPeerScores() *blockSync.PeerScores
+}
+
+// GetSyncQuarantine get the blocks failed to be verified by the sync service of the chain and the peers banned
+// for serving them
+func (server *ChainMakerServer) GetSyncQuarantine(chainId string) (*blockSync.Quarantine, error) {
+	syncService, err := server.GetSync(chainId)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := syncService.(syncQuarantineProvider)
+	if !ok {
+		return nil, fmt.Errorf("sync service of chain[%s] has no quarantine", chainId)
+	}
+	return provider.GetQuarantine(), nil
+}
+
+// GetSyncPeerScores get the peer select strategy in use by the sync service of the chain and the scores of the
+// peers
+func (server *ChainMakerServer) GetSyncPeerScores(chainId string) (*blockSync.PeerScores, error) {
+	syncService, err := server.GetSync(chainId)
+	if err != nil {
+		return nil, err
+	}
+	provider, ok := syncService.(syncPeerScoresProvider)
+	if !ok {
+		return nil, fmt.Errorf("sync service of chain[%s] has no peer scores", chainId)
+	}
+	return provider.GetPeerScores(), nil
+}
+
+// SetSyncPeerSelectStrategy change the peer select strategy of the sync service of the chain, or of all the chains
+// whose sync service is started if chainId is empty. It takes effect until the chain restarts.
+func (server *ChainMakerServer) SetSyncPeerSelectStrategy(chainId, strategy string) error {
+	if !blockSync.IsValidPeerSelectStrategy(strategy) {
+		return fmt.Errorf("unknown peer select strategy: %s", strategy)
+	}
+	if chainId != "" {
+		bc, err := server.GetBlockchain(chainId)
+		if err != nil {
+			return err
+		}
+		return bc.setSyncPeerSelectStrategy(strategy, true)
+	}
+
+	var err error
+	server.blockchains.Range(func(_, value interface{}) bool {
+		err = value.(*Blockchain).setSyncPeerSelectStrategy(strategy, false)
+		return err == nil
+	})
+	return err
+}
+
+// setSyncPeerSelectStrategy change the peer select strategy of the sync service, the chain whose sync service is
+// not started is skipped unless it is required
+func (bc *Blockchain) setSyncPeerSelectStrategy(strategy string, required bool) error {
+	bc.modulesLock.RLock()
+	defer bc.modulesLock.RUnlock()
+
+	selector, ok := bc.syncServer.(syncPeerSelector)
+	if !ok || !bc.isModuleStartUp(moduleNameSync) {
+		if required {
+			return fmt.Errorf("sync service of chain[%s] is not started", bc.chainId)
+		}
+		return nil
+	}
+	return selector.SetPeerSelectStrategy(strategy)
+}
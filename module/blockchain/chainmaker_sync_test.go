@@ -0,0 +1,56 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package blockchain
+
+import (
+	"testing"
+
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSyncPeerSelectStrategy(t *testing.T) {
+	server := NewChainMakerServer()
+	server.blockchains.Store("chain1", NewBlockchain("", "chain1", nil, nil))
+
+	require.Error(t, server.SetSyncPeerSelectStrategy("", "unknown"))
+	// the chain whose sync service is not started is skipped, unless it is specified
+	require.NoError(t, server.SetSyncPeerSelectStrategy("", blockSync.PeerSelectRandom))
+	require.Error(t, server.SetSyncPeerSelectStrategy("chain1", blockSync.PeerSelectRandom))
+	require.Error(t, server.SetSyncPeerSelectStrategy("chain2", blockSync.PeerSelectRandom))
+}
+
+func TestGetSyncQuarantine(t *testing.T) {
+	server := NewChainMakerServer()
+	chain := NewBlockchain("", "chain1", nil, nil)
+	server.blockchains.Store("chain1", chain)
+
+	_, err := server.GetSyncQuarantine("chain1")
+	require.Error(t, err)
+	_, err = server.GetSyncQuarantine("chain2")
+	require.Error(t, err)
+
+	chain.syncServer = blockSync.NewBlockChainSyncServer("chain1", nil, nil, nil, nil, nil, nil, nil)
+	quarantine, err := server.GetSyncQuarantine("chain1")
+	require.NoError(t, err)
+	require.NotNil(t, quarantine)
+}
+
+func TestGetSyncPeerScores(t *testing.T) {
+	server := NewChainMakerServer()
+	chain := NewBlockchain("", "chain1", nil, nil)
+	server.blockchains.Store("chain1", chain)
+
+	_, err := server.GetSyncPeerScores("chain1")
+	require.Error(t, err)
+
+	chain.syncServer = blockSync.NewBlockChainSyncServer("chain1", nil, nil, nil, nil, nil, nil, nil)
+	scores, err := server.GetSyncPeerScores("chain1")
+	require.NoError(t, err)
+	require.Equal(t, blockSync.PeerSelectLeastLoaded, scores.Strategy)
+	require.Empty(t, scores.Peers)
+}
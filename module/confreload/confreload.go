@@ -0,0 +1,351 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package confreload reloads the local config of the node without restarting it. A module declares the keys of
+// the local config it can apply at runtime by Register, Reload diffs the config file against the running config,
+// applies the changed keys which are declared, and reports the other changed keys as needing a restart. The keys
+// of the extension sections, which localconf.CMConfig does not declare, are diffed and applied the same way.
+//
+// The running config is published by Config. localconf.ChainMakerConfig keeps the config the node started with,
+// it is never written after the start, so the modules reading the keys which may change at runtime load Config.
+package confreload
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/logger/v2"
+	"github.com/spf13/viper"
+)
+
+var log = logger.GetLogger(logger.MODULE_BLOCKCHAIN)
+
+// ErrNeedRestart returned by an ApplyFunc which can not apply the changes in the current state of the module,
+// the changes are reported as needing a restart rather than failed
+var ErrNeedRestart = errors.New("need restart")
+
+// ApplyFunc apply the changed keys of the local config, whose new values are already published by Config, or by
+// UnmarshalExtension for the keys of the extension sections. The old values are restored if it returns an error.
+// The running config is never written in place, it is replaced by a copy with the new values, so the modules
+// reading it see either the old values or the new ones.
+type ApplyFunc func(keys []string) error
+
+type reloader struct {
+	module string
+	keys   []string
+	apply  ApplyFunc
+}
+
+// match returns whether the key is one of the keys of the reloader, or under one of them
+func (r *reloader) match(key string) bool {
+	for _, k := range r.keys {
+		if key == k || strings.HasPrefix(key, k+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Report the result of a reload, the keys are the paths of the yml keys joined by dots
+type Report struct {
+	Applied     []string `json:"applied,omitempty"`
+	NeedRestart []string `json:"need_restart,omitempty"`
+	// Failed the error of each key whose module failed to apply it, the key is left unchanged
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+// change a changed key, path is the indexes of the struct fields from the root of the config to the key. The
+// key of an extension section has no path, value is its loaded value, nil if it is removed from the file.
+type change struct {
+	key       string
+	path      []int
+	loaded    reflect.Value
+	extension bool
+	value     interface{}
+}
+
+var (
+	// reloadLock serializes the reloads, the updates and the registers
+	reloadLock sync.Mutex
+	reloaders  []*reloader
+	// running the published *localconf.CMConfig, unset until the first reload or update
+	running atomic.Value
+)
+
+// Config the running local config of the node, it is replaced as a whole by the reloads and the updates. It is
+// localconf.ChainMakerConfig until the first of them.
+func Config() *localconf.CMConfig {
+	if config, ok := running.Load().(*localconf.CMConfig); ok {
+		return config
+	}
+	return localconf.ChainMakerConfig
+}
+
+// Register declare the keys of the local config the module can apply at runtime. A key is the path of the yml
+// keys joined by dots, such as "rpc.ratelimit", and covers the keys under it. A key declared by more than one
+// module is applied by the first one. Registering a module again replaces its keys and apply func.
+func Register(module string, keys []string, apply ApplyFunc) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	r := &reloader{module: module, keys: keys, apply: apply}
+	for i, old := range reloaders {
+		if old.module == module {
+			reloaders[i] = r
+			return
+		}
+	}
+	reloaders = append(reloaders, r)
+}
+
+// Reload load the config file, and apply its changes to the running config. The changes of the keys which are
+// not declared by any module are not applied, they are reported as needing a restart.
+func Reload(file string) (*Report, error) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	loaded, err := LoadConfig(file)
+	if err != nil {
+		return nil, err
+	}
+	loadedExt, err := readExtension(file)
+	if err != nil {
+		return nil, err
+	}
+	runningExt, err := loadExtension(localconf.ConfigFilepath)
+	if err != nil {
+		return nil, err
+	}
+	report := reload(Config(), loaded, settingsOf(runningExt), loadedExt.AllSettings(),
+		func(config interface{}, ext map[string]interface{}) {
+			if c, ok := config.(*localconf.CMConfig); ok {
+				running.Store(c)
+			}
+			publishExtension(file, ext)
+		})
+	log.Infof("reload config file %s, applied: %v, need restart: %v, failed: %v", file,
+		report.Applied, report.NeedRestart, report.Failed)
+	return report, nil
+}
+
+// Update apply fn to a copy of the running config and publish the copy, serialized with the reloads, for the
+// changes the node makes at runtime such as the chains it joins. The copy is shallow, fn must replace the slices
+// and maps it changes rather than write them in place.
+func Update(fn func(config *localconf.CMConfig)) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	config := &localconf.CMConfig{}
+	if current := Config(); current != nil {
+		*config = *current
+	}
+	fn(config)
+	running.Store(config)
+}
+
+// LoadConfig load a local config file onto the running config, so that the values localconf sets on start for the
+// keys absent from the file are kept rather than reported as changed
+func LoadConfig(file string) (*localconf.CMConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("read config file %s failed, %s", file, err)
+	}
+	config := &localconf.CMConfig{}
+	if current := Config(); current != nil {
+		config, _ = unmarshalBase(reflect.ValueOf(current).Elem()).Addr().Interface().(*localconf.CMConfig)
+	}
+	if err := v.Unmarshal(config); err != nil {
+		return nil, fmt.Errorf("unmarshal config file %s failed, %s", file, err)
+	}
+	return config, nil
+}
+
+// unmarshalBase a copy of the running config for the file to be unmarshaled onto, the structs are copied so that
+// the running one is never written. The slices and maps are left empty, as mapstructure merges into them rather
+// than replacing them, which would hide the items removed from the file.
+func unmarshalBase(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if copied.Field(i).CanSet() {
+				copied.Field(i).Set(unmarshalBase(v.Field(i)))
+			}
+		}
+		return copied
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		copied := reflect.New(v.Type().Elem())
+		copied.Elem().Set(unmarshalBase(v.Elem()))
+		return copied
+	case reflect.Slice, reflect.Map, reflect.Interface:
+		return reflect.Zero(v.Type())
+	default:
+		return v
+	}
+}
+
+// reload apply the changes of the loaded config to the running one, both are pointers to the same struct type,
+// and the changes of the loaded extension sections to the running ones. The config and the extension sections
+// with the changes applied are copies of the running ones, they are passed to publish before the modules apply the
+// changes, and the previous ones are passed again if they fail.
+func reload(running, loaded interface{}, runningExt, loadedExt map[string]interface{},
+	publish func(config interface{}, ext map[string]interface{})) *Report {
+	report := &Report{}
+	changes := diff("", nil, reflect.ValueOf(running).Elem(), reflect.ValueOf(loaded).Elem(), nil)
+	changes = append(changes, diffExtension(reflect.TypeOf(running).Elem(), runningExt, loadedExt)...)
+	current := &published{config: reflect.ValueOf(running), ext: runningExt}
+	for _, r := range reloaders {
+		var matched, rest []*change
+		for _, c := range changes {
+			if r.match(c.key) {
+				matched = append(matched, c)
+			} else {
+				rest = append(rest, c)
+			}
+		}
+		changes = rest
+		if len(matched) > 0 {
+			current = apply(r, current, matched, report, publish)
+		}
+	}
+	for _, c := range changes {
+		report.NeedRestart = append(report.NeedRestart, c.key)
+	}
+	sort.Strings(report.Applied)
+	sort.Strings(report.NeedRestart)
+	return report
+}
+
+// published the config and the extension sections in use
+type published struct {
+	config reflect.Value
+	ext    map[string]interface{}
+}
+
+// apply publish a copy of the current config with the changes and let the module apply them, the current one is
+// published again if it fails. It returns the config in use afterwards.
+func apply(r *reloader, current *published, changes []*change, report *Report,
+	publish func(config interface{}, ext map[string]interface{})) *published {
+	next := &published{config: reflect.New(current.config.Type().Elem()), ext: current.ext}
+	next.config.Elem().Set(current.config.Elem())
+	keys := make([]string, 0, len(changes))
+	for _, c := range changes {
+		keys = append(keys, c.key)
+		if c.extension {
+			next.ext = setExtension(next.ext, strings.Split(c.key, "."), c.value)
+		} else {
+			setCopied(next.config.Elem(), c.path, c.loaded)
+		}
+	}
+	publish(next.config.Interface(), next.ext)
+
+	err := r.apply(keys)
+	if err == nil {
+		report.Applied = append(report.Applied, keys...)
+		return next
+	}
+	publish(current.config.Interface(), current.ext)
+	if errors.Is(err, ErrNeedRestart) {
+		report.NeedRestart = append(report.NeedRestart, keys...)
+		return current
+	}
+	log.Warnf("module %s failed to apply %v, %s", r.module, keys, err)
+	if report.Failed == nil {
+		report.Failed = make(map[string]string)
+	}
+	for _, key := range keys {
+		report.Failed[key] = err.Error()
+	}
+	return current
+}
+
+// setCopied set the field at the path of the struct, the structs pointed along the path are copied before they
+// are written, so the ones shared with the running config are left unchanged
+func setCopied(v reflect.Value, path []int, value reflect.Value) {
+	field := v.Field(path[0])
+	if len(path) == 1 {
+		field.Set(value)
+		return
+	}
+	if field.Kind() == reflect.Ptr {
+		copied := reflect.New(field.Type().Elem())
+		copied.Elem().Set(field.Elem())
+		field.Set(copied)
+		field = copied.Elem()
+	}
+	setCopied(field, path[1:], value)
+}
+
+// diff collect the leaf values which differ, walking the structs by their mapstructure keys
+func diff(key string, path []int, running, loaded reflect.Value, changes []*change) []*change {
+	switch {
+	case running.Kind() == reflect.Struct:
+		t := running.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, squash := fieldKey(field)
+			if field.PkgPath != "" || name == "-" {
+				continue
+			}
+			childKey := key
+			if !squash {
+				childKey = joinKey(key, name)
+			}
+			childPath := append(append([]int{}, path...), i)
+			changes = diff(childKey, childPath, running.Field(i), loaded.Field(i), changes)
+		}
+		return changes
+	case running.Kind() == reflect.Ptr && running.Type().Elem().Kind() == reflect.Struct &&
+		!running.IsNil() && !loaded.IsNil():
+		return diff(key, path, running.Elem(), loaded.Elem(), changes)
+	case equalValue(running, loaded):
+		return changes
+	default:
+		return append(changes, &change{key: key, path: path, loaded: loaded})
+	}
+}
+
+// equalValue empty slices and maps are equal whether they are nil or not, as yml does not tell them apart
+func equalValue(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Slice, reflect.Map:
+		if a.Len() == 0 && b.Len() == 0 {
+			return true
+		}
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+// fieldKey the mapstructure key of a struct field, and whether it is squashed into its parent
+func fieldKey(field reflect.StructField) (string, bool) {
+	tag := strings.Split(field.Tag.Get("mapstructure"), ",")
+	squash := false
+	for _, opt := range tag[1:] {
+		squash = squash || opt == "squash"
+	}
+	if tag[0] == "" {
+		return strings.ToLower(field.Name), squash
+	}
+	return tag[0], squash
+}
+
+func joinKey(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
@@ -0,0 +1,302 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package confreload
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"chainmaker.org/chainmaker/localconf/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type testRateLimit struct {
+	Enabled         bool `mapstructure:"enabled"`
+	TokenPerSecond  int  `mapstructure:"token_per_second"`
+	TokenBucketSize int  `mapstructure:"token_bucket_size"`
+}
+
+type testRpc struct {
+	Port      int            `mapstructure:"port"`
+	RateLimit *testRateLimit `mapstructure:"ratelimit"`
+	Addresses []string       `mapstructure:"addresses"`
+}
+
+type testMonitor struct {
+	Enabled bool
+}
+
+type testConfig struct {
+	Rpc     testRpc                `mapstructure:"rpc"`
+	Monitor testMonitor            `mapstructure:"monitor"`
+	Storage map[string]interface{} `mapstructure:"storage"`
+	ignored int
+}
+
+func newTestConfig() *testConfig {
+	return &testConfig{
+		Rpc: testRpc{
+			Port:      12301,
+			RateLimit: &testRateLimit{TokenPerSecond: 100},
+		},
+		Storage: map[string]interface{}{"store_path": "../data"},
+	}
+}
+
+func withReloaders(t *testing.T) {
+	old := reloaders
+	reloaders = nil
+	t.Cleanup(func() { reloaders = old })
+}
+
+func TestReload(t *testing.T) {
+	withReloaders(t)
+	var applied []string
+	Register("rpc", []string{"rpc.ratelimit", "rpc.addresses"}, func(keys []string) error {
+		applied = append(applied, keys...)
+		return nil
+	})
+	Register("monitor", []string{"monitor.enabled"}, func(keys []string) error {
+		return ErrNeedRestart
+	})
+
+	running, loaded := newTestConfig(), newTestConfig()
+	loaded.Rpc.Port = 12302
+	loaded.Rpc.RateLimit.Enabled = true
+	loaded.Rpc.RateLimit.TokenBucketSize = 10
+	loaded.Rpc.Addresses = []string{}
+	loaded.Monitor.Enabled = true
+	loaded.ignored = 1
+
+	published := running
+	report := reload(running, loaded, nil, nil, func(config interface{}, _ map[string]interface{}) {
+		published, _ = config.(*testConfig)
+	})
+	require.Equal(t, []string{"rpc.ratelimit.enabled", "rpc.ratelimit.token_bucket_size"}, report.Applied)
+	require.Equal(t, []string{"monitor.enabled", "rpc.port"}, report.NeedRestart)
+	require.Empty(t, report.Failed)
+	require.Equal(t, report.Applied, applied)
+
+	require.True(t, published.Rpc.RateLimit.Enabled)
+	require.Equal(t, 10, published.Rpc.RateLimit.TokenBucketSize)
+	require.Equal(t, 100, published.Rpc.RateLimit.TokenPerSecond)
+	require.Equal(t, 12301, published.Rpc.Port)
+	require.False(t, published.Monitor.Enabled)
+
+	// the running config is never written, the readers holding it see the old values
+	require.False(t, running.Rpc.RateLimit.Enabled)
+	require.Zero(t, running.Rpc.RateLimit.TokenBucketSize)
+}
+
+func TestReload_Failed(t *testing.T) {
+	withReloaders(t)
+	Register("rpc", []string{"rpc"}, func(keys []string) error {
+		return errors.New("invalid address")
+	})
+
+	running, loaded := newTestConfig(), newTestConfig()
+	loaded.Rpc.Addresses = []string{"10.1.0.0/16"}
+	loaded.Storage["store_path"] = "../data2"
+
+	var published []*testConfig
+	report := reload(running, loaded, nil, nil, func(config interface{}, _ map[string]interface{}) {
+		c, _ := config.(*testConfig)
+		published = append(published, c)
+	})
+	require.Empty(t, report.Applied)
+	require.Equal(t, []string{"storage"}, report.NeedRestart)
+	require.Equal(t, map[string]string{"rpc.addresses": "invalid address"}, report.Failed)
+	require.Len(t, published, 2)
+	require.Equal(t, []string{"10.1.0.0/16"}, published[0].Rpc.Addresses)
+	require.Same(t, running, published[1])
+	require.Empty(t, running.Rpc.Addresses)
+	require.Equal(t, "../data", running.Storage["store_path"])
+}
+
+func TestReload_Extension(t *testing.T) {
+	withReloaders(t)
+	var applied []string
+	Register("rpc", []string{"rpc.health"}, func(keys []string) error {
+		applied = append(applied, keys...)
+		return nil
+	})
+	Register("txfilter", []string{"tx_filter"}, func(keys []string) error {
+		return errors.New("invalid map window")
+	})
+
+	runningExt := map[string]interface{}{
+		"rpc":        map[string]interface{}{"port": 12301, "health": map[string]interface{}{"min_peers": 1}},
+		"subscriber": map[string]interface{}{"policy": "block"},
+		"tx_filter":  map[string]interface{}{"map_window": map[string]interface{}{"size": 10}},
+		"storage":    map[string]interface{}{"store_path": "../data"},
+	}
+	loadedExt := map[string]interface{}{
+		"rpc": map[string]interface{}{"port": 12302, "health": map[string]interface{}{"min_peers": 2,
+			"max_height_lag": 5}},
+		"subscriber": map[string]interface{}{"policy": "drop_oldest"},
+		"tx_filter":  map[string]interface{}{},
+		"storage":    map[string]interface{}{"store_path": "../data2"},
+	}
+
+	var published []map[string]interface{}
+	report := reload(newTestConfig(), newTestConfig(), runningExt, loadedExt,
+		func(_ interface{}, ext map[string]interface{}) { published = append(published, ext) })
+	// the keys declared by the config struct are left to diff, such as rpc.port and storage
+	require.Equal(t, []string{"rpc.health.max_height_lag", "rpc.health.min_peers"}, report.Applied)
+	require.Equal(t, report.Applied, applied)
+	require.Equal(t, []string{"subscriber.policy"}, report.NeedRestart)
+	require.Equal(t, map[string]string{"tx_filter.map_window.size": "invalid map window"}, report.Failed)
+
+	// the applied keys are published, the failed ones are published again with the running values
+	require.Len(t, published, 3)
+	last := published[2]
+	require.Equal(t, map[string]interface{}{"port": 12301, "health": map[string]interface{}{"min_peers": 2,
+		"max_height_lag": 5}}, last["rpc"])
+	require.Equal(t, runningExt["subscriber"], last["subscriber"])
+	require.Equal(t, runningExt["tx_filter"], last["tx_filter"])
+	require.Equal(t, map[string]interface{}{"map_window": map[string]interface{}{}}, published[1]["tx_filter"])
+	// the running settings are never written
+	require.Equal(t, map[string]interface{}{"min_peers": 1},
+		runningExt["rpc"].(map[string]interface{})["health"])
+}
+
+func TestReload_File(t *testing.T) {
+	withReloaders(t)
+	oldFile, oldConfig := localconf.ConfigFilepath, localconf.ChainMakerConfig
+	t.Cleanup(func() {
+		localconf.ConfigFilepath, localconf.ChainMakerConfig = oldFile, oldConfig
+		running = atomic.Value{}
+		resetExtension()
+	})
+	localconf.ChainMakerConfig = &localconf.CMConfig{}
+	localconf.ChainMakerConfig.MonitorConfig.Enabled = true
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, os.WriteFile(file, []byte("monitor:\n  enabled: true\nrpc:\n  health:\n    min_peers: 1\n"+
+		"subscriber:\n  policy: block\n"), 0600))
+	localconf.ConfigFilepath = file
+
+	health := &struct {
+		MinPeers int `mapstructure:"min_peers"`
+	}{}
+	require.NoError(t, UnmarshalExtension("rpc.health", health))
+	require.Equal(t, 1, health.MinPeers)
+
+	Register("rpc", []string{"rpc.health"}, func(keys []string) error {
+		return UnmarshalExtension("rpc.health", health)
+	})
+	require.NoError(t, os.WriteFile(file, []byte("monitor:\n  enabled: false\nrpc:\n  health:\n    min_peers: 3\n"+
+		"subscriber:\n  policy: drop_oldest\n"), 0600))
+	report, err := Reload(file)
+	require.NoError(t, err)
+	require.Equal(t, []string{"rpc.health.min_peers"}, report.Applied)
+	require.Equal(t, []string{"monitor.enabled", "subscriber.policy"}, report.NeedRestart)
+	require.Equal(t, 3, health.MinPeers)
+
+	// the changes needing a restart are not published, and the config the node started with is never written
+	subscriber := &struct {
+		Policy string `mapstructure:"policy"`
+	}{}
+	require.NoError(t, UnmarshalExtension("subscriber", subscriber))
+	require.Equal(t, "block", subscriber.Policy)
+	require.True(t, Config().MonitorConfig.Enabled)
+	require.True(t, localconf.ChainMakerConfig.MonitorConfig.Enabled)
+}
+
+func TestUnmarshalBase(t *testing.T) {
+	running := newTestConfig()
+	running.Rpc.Addresses = []string{"10.1.0.0/16"}
+	base, _ := unmarshalBase(reflect.ValueOf(running).Elem()).Addr().Interface().(*testConfig)
+
+	require.Equal(t, 12301, base.Rpc.Port)
+	require.Equal(t, 100, base.Rpc.RateLimit.TokenPerSecond)
+	require.NotSame(t, running.Rpc.RateLimit, base.Rpc.RateLimit)
+	require.Nil(t, base.Rpc.Addresses)
+	require.Nil(t, base.Storage)
+
+	base.Rpc.RateLimit.Enabled = true
+	require.False(t, running.Rpc.RateLimit.Enabled)
+}
+
+func TestRegister(t *testing.T) {
+	withReloaders(t)
+	Register("rpc", []string{"rpc.port"}, nil)
+	Register("rpc", []string{"rpc.ratelimit"}, nil)
+	require.Len(t, reloaders, 1)
+	require.True(t, reloaders[0].match("rpc.ratelimit.enabled"))
+	require.False(t, reloaders[0].match("rpc.port"))
+	require.False(t, reloaders[0].match("rpc.ratelimit_rules"))
+}
+
+func TestLoadConfig(t *testing.T) {
+	old := localconf.ChainMakerConfig
+	t.Cleanup(func() { localconf.ChainMakerConfig = old })
+	localconf.ChainMakerConfig = &localconf.CMConfig{}
+	localconf.ChainMakerConfig.SyncConfig.ProcessBlockTick = 50
+
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, os.WriteFile(file, []byte("rpc:\n  sync_tx_result_timeout: 30\nmonitor:\n  enabled: true\n"),
+		0600))
+	config, err := LoadConfig(file)
+	require.NoError(t, err)
+	require.True(t, config.MonitorConfig.Enabled)
+	// the keys absent from the file keep their running values
+	require.EqualValues(t, 50, config.SyncConfig.ProcessBlockTick)
+	require.False(t, localconf.ChainMakerConfig.MonitorConfig.Enabled)
+
+	_, err = LoadConfig(filepath.Join(t.TempDir(), "not-exists.yml"))
+	require.Error(t, err)
+}
+
+func TestUpdate(t *testing.T) {
+	old := localconf.ChainMakerConfig
+	t.Cleanup(func() {
+		localconf.ChainMakerConfig = old
+		running = atomic.Value{}
+	})
+	started := &localconf.CMConfig{}
+	started.MonitorConfig.Enabled = true
+	localconf.ChainMakerConfig = started
+	require.Same(t, started, Config())
+
+	Update(func(config *localconf.CMConfig) {
+		config.BlockChainConfig = append(config.BlockChainConfig, localconf.BlockchainConfig{ChainId: "chain1"})
+	})
+	require.Len(t, Config().BlockChainConfig, 1)
+	require.True(t, Config().MonitorConfig.Enabled)
+	// the running config is replaced rather than written
+	require.Empty(t, started.BlockChainConfig)
+	require.Same(t, started, localconf.ChainMakerConfig)
+}
+
+func TestConfig_Concurrent(t *testing.T) {
+	old := localconf.ChainMakerConfig
+	t.Cleanup(func() {
+		localconf.ChainMakerConfig = old
+		running = atomic.Value{}
+	})
+	localconf.ChainMakerConfig = &localconf.CMConfig{}
+
+	// the readers load the published config while it is replaced, go test -race checks they never race
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			Update(func(config *localconf.CMConfig) {
+				config.MonitorConfig.Enabled = !config.MonitorConfig.Enabled
+			})
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		_ = Config().MonitorConfig.Enabled
+	}
+	<-done
+	require.False(t, Config().MonitorConfig.Enabled)
+}
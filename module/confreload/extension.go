@@ -0,0 +1,205 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package confreload
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"chainmaker.org/chainmaker/localconf/v2"
+	"github.com/spf13/viper"
+)
+
+var (
+	// extensionLock guards the config file read for the extension sections
+	extensionLock sync.Mutex
+	extensionFile string
+	extension     *viper.Viper
+)
+
+// UnmarshalExtension unmarshal the section of the key in the config file of the node into out by its mapstructure
+// tags, for the settings which localconf.CMConfig does not declare. The key is the path of the yml keys joined by
+// dots, such as "sync.peer_select_strategy". The fields of out absent in the file are left unchanged, so out should
+// hold the defaults, and it is unchanged if the file does not exist.
+func UnmarshalExtension(key string, out interface{}) error {
+	v, err := loadExtension(localconf.ConfigFilepath)
+	if err != nil || v == nil || !v.IsSet(key) {
+		return err
+	}
+	if err = v.UnmarshalKey(key, out); err != nil {
+		return fmt.Errorf("unmarshal %s of config file %s failed, %s", key, localconf.ConfigFilepath, err)
+	}
+	return nil
+}
+
+// loadExtension read the config file once, nil if it does not exist
+func loadExtension(file string) (*viper.Viper, error) {
+	extensionLock.Lock()
+	defer extensionLock.Unlock()
+	if extension != nil && extensionFile == file {
+		return extension, nil
+	}
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		return nil, nil
+	}
+	v, err := readExtension(file)
+	if err != nil {
+		return nil, err
+	}
+	extension, extensionFile = v, file
+	return v, nil
+}
+
+func readExtension(file string) (*viper.Viper, error) {
+	v := viper.New()
+	v.SetConfigFile(file)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("read config file %s failed, %s", file, err)
+	}
+	return v, nil
+}
+
+// publishExtension replace the extension sections read by UnmarshalExtension. The published viper is never
+// written, as it is read without a lock, and viper writes the maps it merges, so they are copied first.
+func publishExtension(file string, settings map[string]interface{}) {
+	v := viper.New()
+	if err := v.MergeConfigMap(copySettings(settings)); err != nil {
+		log.Warnf("publish the extension sections of config file %s failed, %s", file, err)
+		return
+	}
+	extensionLock.Lock()
+	defer extensionLock.Unlock()
+	extension, extensionFile = v, file
+}
+
+// resetExtension read the config file again for the extension sections
+func resetExtension() {
+	extensionLock.Lock()
+	defer extensionLock.Unlock()
+	extension, extensionFile = nil, ""
+}
+
+func settingsOf(v *viper.Viper) map[string]interface{} {
+	if v == nil {
+		return nil
+	}
+	return v.AllSettings()
+}
+
+// diffExtension collect the leaf keys of the extension sections which differ, the keys declared by the config
+// struct t are diffed by diff rather than here
+func diffExtension(t reflect.Type, running, loaded map[string]interface{}) []*change {
+	declared := structKeys("", t, nil)
+	runningLeaves := leafSettings("", running, make(map[string]interface{}))
+	loadedLeaves := leafSettings("", loaded, make(map[string]interface{}))
+	keys := make([]string, 0, len(loadedLeaves))
+	for key := range loadedLeaves {
+		keys = append(keys, key)
+	}
+	for key := range runningLeaves {
+		if _, ok := loadedLeaves[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []*change
+	for _, key := range keys {
+		if isDeclaredKey(declared, key) {
+			continue
+		}
+		runningValue, runningOk := runningLeaves[key]
+		loadedValue, loadedOk := loadedLeaves[key]
+		if runningOk == loadedOk && reflect.DeepEqual(runningValue, loadedValue) {
+			continue
+		}
+		changes = append(changes, &change{key: key, extension: true, value: loadedValue})
+	}
+	return changes
+}
+
+// structKeys the leaf keys of the config struct by their mapstructure keys, in lower case like the keys of viper
+func structKeys(key string, t reflect.Type, keys []string) []string {
+	switch {
+	case t.Kind() == reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name, squash := fieldKey(field)
+			if field.PkgPath != "" || name == "-" {
+				continue
+			}
+			childKey := key
+			if !squash {
+				childKey = joinKey(key, strings.ToLower(name))
+			}
+			keys = structKeys(childKey, field.Type, keys)
+		}
+		return keys
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct:
+		return structKeys(key, t.Elem(), keys)
+	default:
+		return append(keys, key)
+	}
+}
+
+// isDeclaredKey returns whether the key is a declared key, under one, or above one
+func isDeclaredKey(declared []string, key string) bool {
+	for _, d := range declared {
+		if key == d || strings.HasPrefix(key, d+".") || strings.HasPrefix(d, key+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// leafSettings flatten the nested settings into their leaf keys joined by dots
+func leafSettings(key string, settings map[string]interface{}, leaves map[string]interface{}) map[string]interface{} {
+	for k, v := range settings {
+		childKey := joinKey(key, k)
+		if child, ok := v.(map[string]interface{}); ok {
+			leafSettings(childKey, child, leaves)
+		} else {
+			leaves[childKey] = v
+		}
+	}
+	return leaves
+}
+
+// setExtension returns a copy of the settings with the value at the path, removed if the value is nil. The maps
+// along the path are copied, so the ones shared with the running settings are left unchanged.
+func setExtension(settings map[string]interface{}, path []string, value interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(settings)+1)
+	for k, v := range settings {
+		copied[k] = v
+	}
+	if len(path) == 1 {
+		if value == nil {
+			delete(copied, path[0])
+		} else {
+			copied[path[0]] = value
+		}
+		return copied
+	}
+	child, _ := settings[path[0]].(map[string]interface{})
+	copied[path[0]] = setExtension(child, path[1:], value)
+	return copied
+}
+
+func copySettings(settings map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		if child, ok := v.(map[string]interface{}); ok {
+			v = copySettings(child)
+		}
+		copied[k] = v
+	}
+	return copied
+}
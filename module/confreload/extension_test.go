@@ -0,0 +1,57 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package confreload
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"chainmaker.org/chainmaker/localconf/v2"
+	"github.com/stretchr/testify/require"
+)
+
+type testExtension struct {
+	QueueSize int           `mapstructure:"queue_size"`
+	Policy    string        `mapstructure:"policy"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+}
+
+func TestUnmarshalExtension(t *testing.T) {
+	oldFile := localconf.ConfigFilepath
+	defer func() {
+		localconf.ConfigFilepath = oldFile
+		resetExtension()
+	}()
+
+	// the defaults are kept without the config file
+	localconf.ConfigFilepath = filepath.Join(t.TempDir(), "absent.yml")
+	ext := &testExtension{QueueSize: 1024, Policy: "block"}
+	require.NoError(t, UnmarshalExtension("subscriber", ext))
+	require.Equal(t, &testExtension{QueueSize: 1024, Policy: "block"}, ext)
+
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, os.WriteFile(file, []byte("subscriber:\n  policy: drop_oldest\n  timeout: 5s\n"+
+		"sync:\n  extra:\n    queue_size: 8\n"), 0600))
+	localconf.ConfigFilepath = file
+	require.NoError(t, UnmarshalExtension("subscriber", ext))
+	require.Equal(t, &testExtension{QueueSize: 1024, Policy: "drop_oldest", Timeout: 5 * time.Second}, ext)
+
+	nested := &testExtension{}
+	require.NoError(t, UnmarshalExtension("sync.extra", nested))
+	require.Equal(t, 8, nested.QueueSize)
+	absent := &testExtension{Policy: "block"}
+	require.NoError(t, UnmarshalExtension("sync.absent", absent))
+	require.Equal(t, "block", absent.Policy)
+
+	// the file is read again after a reload
+	require.NoError(t, os.WriteFile(file, []byte("subscriber:\n  policy: disconnect\n"), 0600))
+	resetExtension()
+	require.NoError(t, UnmarshalExtension("subscriber", ext))
+	require.Equal(t, "disconnect", ext.Policy)
+}
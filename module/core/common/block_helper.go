@@ -16,6 +16,7 @@ import (
 
 	"chainmaker.org/chainmaker-go/module/core/common/coinbasemgr"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/core/common/scheduler"
 	"chainmaker.org/chainmaker-go/module/core/provider/conf"
 	"chainmaker.org/chainmaker-go/module/subscriber"
@@ -24,7 +25,6 @@ import (
 	commonErrors "chainmaker.org/chainmaker/common/v2/errors"
 	"chainmaker.org/chainmaker/common/v2/monitor"
 	"chainmaker.org/chainmaker/common/v2/msgbus"
-	"chainmaker.org/chainmaker/localconf/v2"
 	"chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
 	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
 	"chainmaker.org/chainmaker/pb-go/v2/consensus"
@@ -538,6 +538,42 @@ func CheckBlockDigests(block *commonPb.Block, txHashes [][]byte, hashType string
 	return nil
 }
 
+// CalcTxHashesWithRWSets recalculate the tx hashes of a committed block from the rw sets kept in store,
+// it fails if the rw set hash recorded in a tx result does not match the stored rw set.
+func CalcTxHashesWithRWSets(block *commonPb.Block, txRWSets []*commonPb.TxRWSet, hashType string) (
+	[][]byte, error) {
+	rwSetMap := make(map[string]*commonPb.TxRWSet, len(txRWSets))
+	for _, rwSet := range txRWSets {
+		if rwSet != nil {
+			rwSetMap[rwSet.TxId] = rwSet
+		}
+	}
+	txHashes := make([][]byte, 0, len(block.Txs))
+	for _, tx := range block.Txs {
+		if tx.Payload == nil || tx.Result == nil {
+			return nil, fmt.Errorf("tx payload or result == nil (blockHeight: %d)", block.Header.BlockHeight)
+		}
+		rwSet, ok := rwSetMap[tx.Payload.TxId]
+		if !ok {
+			rwSet = &commonPb.TxRWSet{TxId: tx.Payload.TxId}
+		}
+		rwSetHash, err := utils.CalcRWSetHash(hashType, rwSet)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(rwSetHash, tx.Result.RwSetHash) {
+			return nil, fmt.Errorf("tx[%s] rwset hash expect %x, got %x",
+				tx.Payload.TxId, tx.Result.RwSetHash, rwSetHash)
+		}
+		txHash, err := utils.CalcTxHashWithVersion(hashType, tx, int(block.Header.BlockVersion))
+		if err != nil {
+			return nil, err
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	return txHashes, nil
+}
+
 func CheckVacuumBlock(block *commonPb.Block, consensusType consensus.ConsensusType) error {
 	if block.Header.TxCount == 0 {
 		if utils.CanProposeEmptyBlock(consensusType) {
@@ -954,7 +990,7 @@ func NewBlockCommitter(config BlockCommitterConfig, log protocol.Logger) (protoc
 		},
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		blockchain.initMetrics()
 	}
 
@@ -1094,7 +1130,7 @@ func (chain *BlockCommitterImpl) AddBlock(block *commonPb.Block) (err error) {
 			"time used(check:%d,db:%d,ss:%d,conf:%d,pool:%d,pubConEvent:%d,filter:%d,other:%d,total:%d,interval:%d)",
 		height, commitBlock.Header.TxCount, commitBlock.Header.BlockHash,
 		checkLasts, dbLasts, snapshotLasts, confLasts, poolLasts, pubEvent, filterLasts, otherLasts, elapsed, interval)
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		blockInfoTmp := *blockInfo
 		go chain.updateMetrics(&blockInfoTmp, elapsed, interval)
 	}
@@ -2238,3 +2238,37 @@ func TestBlockCommitterImpl_AddBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcTxHashesWithRWSets(t *testing.T) {
+	log := logger.GetLogger("core")
+	block := createBlock(1)
+	txRWSetMap := make(map[string]*commonpb.TxRWSet)
+	rwSets := make([]*commonpb.TxRWSet, 0)
+	for i := 0; i < 3; i++ {
+		txId := fmt.Sprintf("0x123456789%d", i)
+		block.Txs = append(block.Txs, createNewTestTx(txId))
+		rwSet := &commonpb.TxRWSet{
+			TxId:     txId,
+			TxWrites: []*commonpb.TxWrite{{Key: []byte(txId), Value: []byte("v"), ContractName: "c"}},
+		}
+		txRWSetMap[txId] = rwSet
+		rwSets = append(rwSets, rwSet)
+	}
+	if err := FinalizeBlock(block, txRWSetMap, nil, "SHA256", log); err != nil {
+		t.Fatal(err)
+	}
+
+	txHashes, err := CalcTxHashesWithRWSets(block, rwSets, "SHA256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = CheckBlockDigests(block, txHashes, "SHA256", log); err != nil {
+		t.Fatal(err)
+	}
+
+	// a tampered rw set is detected
+	rwSets[1].TxWrites[0].Value = []byte("tampered")
+	if _, err = CalcTxHashesWithRWSets(block, rwSets, "SHA256"); err == nil {
+		t.Fatal("expect rwset hash mismatch")
+	}
+}
@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker/common/v2/msgbus"
-	"chainmaker.org/chainmaker/localconf/v2"
 	commonpb "chainmaker.org/chainmaker/pb-go/v2/common"
 	"chainmaker.org/chainmaker/pb-go/v2/config"
 	"chainmaker.org/chainmaker/protocol/v2"
@@ -64,7 +64,7 @@ func (cb *CommitBlock) CommitBlock(
 	// TxFilter adds
 	filterLasts = utils.CurrentTimeMillisSeconds()
 	// The default filter type does not run AddsAndSetHeight
-	if localconf.ChainMakerConfig.TxFilter.Type != int32(config.TxFilterType_None) {
+	if confreload.Config().TxFilter.Type != int32(config.TxFilterType_None) {
 		err = cb.txFilter.AddsAndSetHeight(utils.GetTxIds(block.Txs), block.Header.GetBlockHeight())
 		if err != nil {
 			// if add filter error, then panic
@@ -107,6 +107,7 @@ func (cb *CommitBlock) CommitBlock(
 }
 
 // publishContractEvent publish contract event, return time used
+// the event is published synchronously, so that subscribers receive the events in order of block height
 func (cb *CommitBlock) publishContractEvent(block *commonpb.Block, events []*commonpb.ContractEvent) int64 {
 
 	var (
@@ -117,7 +118,7 @@ func (cb *CommitBlock) publishContractEvent(block *commonpb.Block, events []*com
 
 	if len(events) == 0 {
 		// 为避免由于没有event的情况下，导致contract event订阅落后很多区块，此处依然选择推送event事件给订阅模块
-		cb.msgBus.Publish(msgbus.ContractEventInfo, &commonpb.ContractEventMessageInfo{
+		cb.msgBus.PublishSafe(msgbus.ContractEventInfo, &commonpb.ContractEventMessageInfo{
 			BlockHeight:       height,
 			ChainId:           chainId,
 			ContractEventList: &commonpb.ContractEventInfoList{ContractEvents: eventsInfos},
@@ -141,7 +142,7 @@ func (cb *CommitBlock) publishContractEvent(block *commonpb.Block, events []*com
 		}
 		eventsInfos = append(eventsInfos, eventInfo)
 	}
-	cb.msgBus.Publish(msgbus.ContractEventInfo, &commonpb.ContractEventMessageInfo{
+	cb.msgBus.PublishSafe(msgbus.ContractEventInfo, &commonpb.ContractEventMessageInfo{
 		BlockHeight:       height,
 		ChainId:           chainId,
 		ContractEventList: &commonpb.ContractEventInfoList{ContractEvents: eventsInfos},
@@ -0,0 +1,419 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package scheduler
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+)
+
+// the types of the conflicts between two txs on a key, named by the access of the later tx after the earlier one
+const (
+	ConflictReadAfterWrite  = "read_after_write"
+	ConflictWriteAfterWrite = "write_after_write"
+	ConflictWriteAfterRead  = "write_after_read"
+)
+
+// DAGAnalysis the dependencies between the txs of a committed block and the conflicts causing them, rebuilt from
+// the read-write sets of the txs
+type DAGAnalysis struct {
+	BlockHeight uint64 `json:"block_height"`
+	TxCount     int    `json:"tx_count"`
+	// the txs in the order of the block, with the txs they depend on in the DAG of the block
+	Txs []*DAGTx `json:"txs"`
+	// true if the block has no DAG matching its txs, and the dependencies are rebuilt from the conflicts
+	DAGRebuilt bool          `json:"dag_rebuilt"`
+	Conflicts  []*TxConflict `json:"conflicts"`
+	// the number of the txs on the longest dependency chain, and the indexes of its txs from the first executed
+	CriticalPathLength int   `json:"critical_path_length"`
+	CriticalPath       []int `json:"critical_path"`
+	// the max number of the txs at the same depth of the DAG, which can be executed in parallel
+	ParallelismWidth int `json:"parallelism_width"`
+	// the txs depending on any other tx, and their ratio to all the txs
+	ConflictTxCount int     `json:"conflict_tx_count"`
+	ConflictRate    float64 `json:"conflict_rate"`
+	// the conflict rate of the last AdjustWindowSize txs, replayed in the order of the block by a
+	// ConflictsBitWindow as the scheduler tracks it
+	WindowConflictRate float64 `json:"window_conflict_rate"`
+
+	// the accesses of each key, aggregated by HotKeyAggregator
+	keys map[string]*HotKeyStat
+}
+
+// DAGTx a tx in the DAG of a block
+type DAGTx struct {
+	Index        int    `json:"index"`
+	TxId         string `json:"tx_id"`
+	ContractName string `json:"contract_name"`
+	Method       string `json:"method"`
+	// the depth of the tx in the DAG from 1, the txs at the same depth can be executed in parallel
+	Depth        int   `json:"depth"`
+	Dependencies []int `json:"dependencies,omitempty"`
+}
+
+// TxConflict the keys on which a tx conflicts with an earlier tx of the block
+type TxConflict struct {
+	From int            `json:"from"`
+	To   int            `json:"to"`
+	Keys []*ConflictKey `json:"keys"`
+}
+
+// ConflictKey a key on which two txs conflict, the key is in hex with the prefix 0x if it is not printable
+type ConflictKey struct {
+	ContractName string `json:"contract_name"`
+	Key          string `json:"key"`
+	Type         string `json:"type"`
+}
+
+// AnalyzeBlockDAG rebuild the conflicts between the txs of the block from their read-write sets, and analyze the
+// DAG of the block
+func AnalyzeBlockDAG(block *commonPb.Block, rwSets []*commonPb.TxRWSet) (*DAGAnalysis, error) {
+	if block == nil || block.Header == nil {
+		return nil, errors.New("block is empty")
+	}
+	analysis := &DAGAnalysis{
+		BlockHeight: block.Header.BlockHeight,
+		TxCount:     len(block.Txs),
+		Txs:         make([]*DAGTx, len(block.Txs)),
+		keys:        make(map[string]*HotKeyStat),
+	}
+	rwSetMap := make(map[string]*commonPb.TxRWSet, len(rwSets))
+	for _, rwSet := range rwSets {
+		if rwSet != nil {
+			rwSetMap[rwSet.TxId] = rwSet
+		}
+	}
+	for i, tx := range block.Txs {
+		dagTx := &DAGTx{Index: i}
+		if tx != nil && tx.Payload != nil {
+			dagTx.TxId, dagTx.ContractName, dagTx.Method = tx.Payload.TxId, tx.Payload.ContractName, tx.Payload.Method
+		}
+		analysis.Txs[i] = dagTx
+	}
+
+	analysis.buildConflicts(rwSetMap)
+	if block.Dag != nil && len(block.Dag.Vertexes) == len(block.Txs) {
+		for i, vertex := range block.Dag.Vertexes {
+			for _, n := range vertex.GetNeighbors() {
+				analysis.Txs[i].Dependencies = append(analysis.Txs[i].Dependencies, int(n))
+			}
+		}
+	} else {
+		analysis.DAGRebuilt = true
+		for _, conflict := range analysis.Conflicts {
+			to := analysis.Txs[conflict.To]
+			to.Dependencies = append(to.Dependencies, conflict.From)
+		}
+	}
+	if err := analysis.analyzeDepth(); err != nil {
+		return nil, err
+	}
+
+	window := NewConflictsBitWindow(len(block.Txs))
+	for _, tx := range analysis.Txs {
+		execType := NormalTx
+		if len(tx.Dependencies) > 0 {
+			execType = ConflictTx
+			analysis.ConflictTxCount++
+		}
+		window.Enqueue(execType, MinPoolCapacity)
+	}
+	if analysis.TxCount > 0 {
+		analysis.ConflictRate = float64(analysis.ConflictTxCount) / float64(analysis.TxCount)
+		analysis.WindowConflictRate = window.getConflictsRate()
+	}
+	return analysis, nil
+}
+
+// buildConflicts find the conflicts of each tx with the earlier ones by the keys they read and write
+func (a *DAGAnalysis) buildConflicts(rwSetMap map[string]*commonPb.TxRWSet) {
+	lastWriter := make(map[string]int)
+	readers := make(map[string][]int)
+	conflicts := make(map[[2]int]*TxConflict)
+	addConflict := func(from, to int, id, contractName string, key []byte, conflictType string) {
+		conflict, ok := conflicts[[2]int{from, to}]
+		if !ok {
+			conflict = &TxConflict{From: from, To: to}
+			conflicts[[2]int{from, to}] = conflict
+			a.Conflicts = append(a.Conflicts, conflict)
+		}
+		conflict.Keys = append(conflict.Keys, &ConflictKey{
+			ContractName: contractName,
+			Key:          formatKey(key),
+			Type:         conflictType,
+		})
+		a.keyStat(id, contractName, key).Conflicts++
+	}
+
+	for i, tx := range a.Txs {
+		rwSet := rwSetMap[tx.TxId]
+		if rwSet == nil {
+			continue
+		}
+		for _, read := range rwSet.TxReads {
+			id := keyId(read.ContractName, read.Key)
+			a.keyStat(id, read.ContractName, read.Key).Reads++
+			if w, ok := lastWriter[id]; ok && w != i {
+				addConflict(w, i, id, read.ContractName, read.Key, ConflictReadAfterWrite)
+			}
+		}
+		for _, write := range rwSet.TxWrites {
+			id := keyId(write.ContractName, write.Key)
+			a.keyStat(id, write.ContractName, write.Key).Writes++
+			if w, ok := lastWriter[id]; ok && w != i {
+				addConflict(w, i, id, write.ContractName, write.Key, ConflictWriteAfterWrite)
+			}
+			for _, r := range readers[id] {
+				if r != i {
+					addConflict(r, i, id, write.ContractName, write.Key, ConflictWriteAfterRead)
+				}
+			}
+		}
+
+		for _, read := range rwSet.TxReads {
+			id := keyId(read.ContractName, read.Key)
+			readers[id] = append(readers[id], i)
+		}
+		for _, write := range rwSet.TxWrites {
+			id := keyId(write.ContractName, write.Key)
+			lastWriter[id] = i
+			readers[id] = nil
+		}
+	}
+	sort.SliceStable(a.Conflicts, func(i, j int) bool {
+		if a.Conflicts[i].To != a.Conflicts[j].To {
+			return a.Conflicts[i].To < a.Conflicts[j].To
+		}
+		return a.Conflicts[i].From < a.Conflicts[j].From
+	})
+}
+
+// analyzeDepth compute the depth of each tx, the critical path and the parallelism width of the DAG
+func (a *DAGAnalysis) analyzeDepth() error {
+	n := len(a.Txs)
+	inDegree := make([]int, n)
+	dependents := make([][]int, n)
+	for i, tx := range a.Txs {
+		for _, dep := range tx.Dependencies {
+			if dep < 0 || dep >= n || dep == i {
+				return fmt.Errorf("invalid dependency %d of tx %d in the dag", dep, i)
+			}
+			inDegree[i]++
+			dependents[dep] = append(dependents[dep], i)
+		}
+	}
+
+	// the dependency of each tx on the longest chain ending with it
+	prev := make([]int, n)
+	queue := make([]int, 0, n)
+	for i := range a.Txs {
+		prev[i] = -1
+		if inDegree[i] == 0 {
+			a.Txs[i].Depth = 1
+			queue = append(queue, i)
+		}
+	}
+	last := -1
+	for visited := 0; visited < len(queue); visited++ {
+		i := queue[visited]
+		if last < 0 || a.Txs[i].Depth > a.Txs[last].Depth {
+			last = i
+		}
+		for _, j := range dependents[i] {
+			if a.Txs[i].Depth+1 > a.Txs[j].Depth {
+				a.Txs[j].Depth = a.Txs[i].Depth + 1
+				prev[j] = i
+			}
+			inDegree[j]--
+			if inDegree[j] == 0 {
+				queue = append(queue, j)
+			}
+		}
+	}
+	if len(queue) != n {
+		return errors.New("the dag has a cycle")
+	}
+
+	for i := last; i >= 0; i = prev[i] {
+		a.CriticalPath = append([]int{i}, a.CriticalPath...)
+	}
+	a.CriticalPathLength = len(a.CriticalPath)
+	width := make(map[int]int)
+	for _, tx := range a.Txs {
+		width[tx.Depth]++
+		if width[tx.Depth] > a.ParallelismWidth {
+			a.ParallelismWidth = width[tx.Depth]
+		}
+	}
+	return nil
+}
+
+func (a *DAGAnalysis) keyStat(id, contractName string, key []byte) *HotKeyStat {
+	stat, ok := a.keys[id]
+	if !ok {
+		stat = &HotKeyStat{ContractName: contractName, Key: formatKey(key)}
+		a.keys[id] = stat
+	}
+	return stat
+}
+
+// DOT the DAG in the graphviz dot language, each tx points to the txs it depends on, labeled with the conflict
+// keys, and the txs depending on nothing point to begin
+func (a *DAGAnalysis) DOT() string {
+	conflictKeys := make(map[[2]int][]string, len(a.Conflicts))
+	for _, conflict := range a.Conflicts {
+		keys := make([]string, 0, len(conflict.Keys))
+		for _, key := range conflict.Keys {
+			keys = append(keys, key.ContractName+"/"+key.Key)
+		}
+		conflictKeys[[2]int{conflict.From, conflict.To}] = keys
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph DAG_%d {\n", a.BlockHeight)
+	for _, tx := range a.Txs {
+		fmt.Fprintf(&sb, "  tx_%d [label=%q];\n", tx.Index,
+			fmt.Sprintf("%d %s\n%s.%s", tx.Index, shortTxId(tx.TxId), tx.ContractName, tx.Method))
+	}
+	for _, tx := range a.Txs {
+		if len(tx.Dependencies) == 0 {
+			fmt.Fprintf(&sb, "  tx_%d -> begin;\n", tx.Index)
+			continue
+		}
+		for _, dep := range tx.Dependencies {
+			if keys, ok := conflictKeys[[2]int{dep, tx.Index}]; ok {
+				fmt.Fprintf(&sb, "  tx_%d -> tx_%d [label=%q];\n", tx.Index, dep, strings.Join(keys, "\n"))
+			} else {
+				fmt.Fprintf(&sb, "  tx_%d -> tx_%d;\n", tx.Index, dep)
+			}
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// HotKeyStat the accesses of a key by the txs of the blocks, the reads and the writes are counted by the txs
+type HotKeyStat struct {
+	ContractName string `json:"contract_name"`
+	Key          string `json:"key"`
+	Reads        int    `json:"reads"`
+	Writes       int    `json:"writes"`
+	// the conflicts between the txs of the same block on the key
+	Conflicts int `json:"conflicts"`
+	// the blocks whose txs access the key
+	Blocks int `json:"blocks"`
+}
+
+// HotKeyReport the keys accessed most by the txs of the blocks of a height range
+type HotKeyReport struct {
+	StartHeight     uint64        `json:"start_height"`
+	EndHeight       uint64        `json:"end_height"`
+	BlockCount      int           `json:"block_count"`
+	TxCount         int           `json:"tx_count"`
+	ConflictTxCount int           `json:"conflict_tx_count"`
+	ConflictRate    float64       `json:"conflict_rate"`
+	Keys            []*HotKeyStat `json:"keys"`
+}
+
+// HotKeyAggregator aggregate the accesses of the keys by the txs of the analyzed blocks
+type HotKeyAggregator struct {
+	report *HotKeyReport
+	keys   map[string]*HotKeyStat
+}
+
+// NewHotKeyAggregator create an aggregator without any block
+func NewHotKeyAggregator() *HotKeyAggregator {
+	return &HotKeyAggregator{
+		report: &HotKeyReport{},
+		keys:   make(map[string]*HotKeyStat),
+	}
+}
+
+// Add aggregate the accesses of the keys by the txs of the analyzed block
+func (h *HotKeyAggregator) Add(analysis *DAGAnalysis) {
+	if h.report.BlockCount == 0 || analysis.BlockHeight < h.report.StartHeight {
+		h.report.StartHeight = analysis.BlockHeight
+	}
+	if analysis.BlockHeight > h.report.EndHeight {
+		h.report.EndHeight = analysis.BlockHeight
+	}
+	h.report.BlockCount++
+	h.report.TxCount += analysis.TxCount
+	h.report.ConflictTxCount += analysis.ConflictTxCount
+
+	for id, stat := range analysis.keys {
+		total, ok := h.keys[id]
+		if !ok {
+			total = &HotKeyStat{ContractName: stat.ContractName, Key: stat.Key}
+			h.keys[id] = total
+		}
+		total.Reads += stat.Reads
+		total.Writes += stat.Writes
+		total.Conflicts += stat.Conflicts
+		total.Blocks++
+	}
+}
+
+// Report the keys with the most conflicts, then the most writes and reads, at most top keys if it is positive
+func (h *HotKeyAggregator) Report(top int) *HotKeyReport {
+	report := *h.report
+	if report.TxCount > 0 {
+		report.ConflictRate = float64(report.ConflictTxCount) / float64(report.TxCount)
+	}
+	report.Keys = make([]*HotKeyStat, 0, len(h.keys))
+	for _, stat := range h.keys {
+		report.Keys = append(report.Keys, stat)
+	}
+	sort.Slice(report.Keys, func(i, j int) bool {
+		a, b := report.Keys[i], report.Keys[j]
+		if a.Conflicts != b.Conflicts {
+			return a.Conflicts > b.Conflicts
+		}
+		if a.Writes != b.Writes {
+			return a.Writes > b.Writes
+		}
+		if a.Reads != b.Reads {
+			return a.Reads > b.Reads
+		}
+		if a.ContractName != b.ContractName {
+			return a.ContractName < b.ContractName
+		}
+		return a.Key < b.Key
+	})
+	if top > 0 && len(report.Keys) > top {
+		report.Keys = report.Keys[:top]
+	}
+	return &report
+}
+
+func keyId(contractName string, key []byte) string {
+	return contractName + "#" + string(key)
+}
+
+// formatKey the key as it is if it is printable, otherwise in hex with the prefix 0x
+func formatKey(key []byte) string {
+	if utf8.Valid(key) && strings.IndexFunc(string(key), func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) < 0 {
+		return string(key)
+	}
+	return "0x" + hex.EncodeToString(key)
+}
+
+func shortTxId(txId string) string {
+	if len(txId) > 8 {
+		return txId[:8]
+	}
+	return txId
+}
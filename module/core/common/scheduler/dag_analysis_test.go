@@ -0,0 +1,120 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package scheduler
+
+import (
+	"strconv"
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func newAnalysisBlock(height uint64, txNum int) (*commonPb.Block, []*commonPb.TxRWSet) {
+	block := &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: height}}
+	rwSets := make([]*commonPb.TxRWSet, 0, txNum)
+	for i := 0; i < txNum; i++ {
+		txId := "tx" + strconv.Itoa(i) + "0000000000"
+		block.Txs = append(block.Txs, &commonPb.Transaction{
+			Payload: &commonPb.Payload{TxId: txId, ContractName: "contract1", Method: "transfer"},
+		})
+		rwSets = append(rwSets, &commonPb.TxRWSet{TxId: txId})
+	}
+	return block, rwSets
+}
+
+func addRead(rwSet *commonPb.TxRWSet, key string) {
+	rwSet.TxReads = append(rwSet.TxReads, &commonPb.TxRead{ContractName: "contract1", Key: []byte(key)})
+}
+
+func addWrite(rwSet *commonPb.TxRWSet, key string) {
+	rwSet.TxWrites = append(rwSet.TxWrites, &commonPb.TxWrite{ContractName: "contract1", Key: []byte(key)})
+}
+
+// tx1 reads what tx0 writes, tx3 writes what tx0 writes and tx1 reads, and reads what tx1 writes
+func newConflictBlock(height uint64) (*commonPb.Block, []*commonPb.TxRWSet) {
+	block, rwSets := newAnalysisBlock(height, 4)
+	addWrite(rwSets[0], "k1")
+	addRead(rwSets[1], "k1")
+	addWrite(rwSets[1], "k2")
+	addWrite(rwSets[2], "k3")
+	addRead(rwSets[3], "k2")
+	addWrite(rwSets[3], "k1")
+	return block, rwSets
+}
+
+func TestAnalyzeBlockDAG(t *testing.T) {
+	block, rwSets := newConflictBlock(10)
+	analysis, err := AnalyzeBlockDAG(block, rwSets)
+	require.NoError(t, err)
+	require.True(t, analysis.DAGRebuilt)
+	require.Equal(t, 4, analysis.TxCount)
+
+	require.Len(t, analysis.Conflicts, 3)
+	require.Equal(t, &TxConflict{From: 0, To: 1, Keys: []*ConflictKey{
+		{ContractName: "contract1", Key: "k1", Type: ConflictReadAfterWrite}}}, analysis.Conflicts[0])
+	require.Equal(t, &TxConflict{From: 0, To: 3, Keys: []*ConflictKey{
+		{ContractName: "contract1", Key: "k1", Type: ConflictWriteAfterWrite}}}, analysis.Conflicts[1])
+	require.Equal(t, &TxConflict{From: 1, To: 3, Keys: []*ConflictKey{
+		{ContractName: "contract1", Key: "k2", Type: ConflictReadAfterWrite},
+		{ContractName: "contract1", Key: "k1", Type: ConflictWriteAfterRead}}}, analysis.Conflicts[2])
+
+	require.Equal(t, []int{0, 1}, analysis.Txs[3].Dependencies)
+	require.Equal(t, 3, analysis.Txs[3].Depth)
+	require.Equal(t, 3, analysis.CriticalPathLength)
+	require.Equal(t, []int{0, 1, 3}, analysis.CriticalPath)
+	require.Equal(t, 2, analysis.ParallelismWidth)
+	require.Equal(t, 2, analysis.ConflictTxCount)
+	require.Equal(t, 0.5, analysis.ConflictRate)
+	require.Equal(t, 2.0/AdjustWindowSize, analysis.WindowConflictRate)
+
+	dot := analysis.DOT()
+	require.Contains(t, dot, "digraph DAG_10 {")
+	require.Contains(t, dot, "tx_0 -> begin;")
+	require.Contains(t, dot, "tx_3 -> tx_1 [label=\"contract1/k2\\ncontract1/k1\"];")
+
+	// the dependencies are taken from the dag of the block
+	block.Dag = &commonPb.DAG{Vertexes: []*commonPb.DAG_Neighbor{
+		{}, {Neighbors: []uint32{0}}, {Neighbors: []uint32{1}}, {Neighbors: []uint32{0, 1}},
+	}}
+	analysis, err = AnalyzeBlockDAG(block, rwSets)
+	require.NoError(t, err)
+	require.False(t, analysis.DAGRebuilt)
+	require.Equal(t, 3, analysis.CriticalPathLength)
+	require.Equal(t, 2, analysis.ParallelismWidth)
+	require.Equal(t, 3, analysis.ConflictTxCount)
+
+	block.Dag.Vertexes[0].Neighbors = []uint32{3}
+	_, err = AnalyzeBlockDAG(block, rwSets)
+	require.Error(t, err)
+	_, err = AnalyzeBlockDAG(&commonPb.Block{}, nil)
+	require.Error(t, err)
+}
+
+func TestHotKeyAggregator(t *testing.T) {
+	aggregator := NewHotKeyAggregator()
+	for height := uint64(5); height <= 6; height++ {
+		block, rwSets := newConflictBlock(height)
+		addWrite(rwSets[2], string([]byte{0, 1}))
+		analysis, err := AnalyzeBlockDAG(block, rwSets)
+		require.NoError(t, err)
+		aggregator.Add(analysis)
+	}
+
+	report := aggregator.Report(3)
+	require.Equal(t, uint64(5), report.StartHeight)
+	require.Equal(t, uint64(6), report.EndHeight)
+	require.Equal(t, 2, report.BlockCount)
+	require.Equal(t, 8, report.TxCount)
+	require.Equal(t, 0.5, report.ConflictRate)
+	require.Len(t, report.Keys, 3)
+	require.Equal(t, &HotKeyStat{ContractName: "contract1", Key: "k1", Reads: 2, Writes: 4, Conflicts: 6, Blocks: 2},
+		report.Keys[0])
+	require.Equal(t, "k2", report.Keys[1].Key)
+	require.Equal(t, "0x0001", report.Keys[2].Key)
+	require.Len(t, aggregator.Report(0).Keys, 4)
+}
@@ -856,18 +856,12 @@ func (ts *TxScheduler) Halt() {
 
 // nolint: unused
 func (ts *TxScheduler) dumpDAG(dag *commonPb.DAG, txs []*commonPb.Transaction) {
-	dagString := "digraph DAG {\n"
-	for i, ns := range dag.Vertexes {
-		if len(ns.Neighbors) == 0 {
-			dagString += fmt.Sprintf("id_%s -> begin;\n", txs[i].Payload.TxId[:8])
-			continue
-		}
-		for _, n := range ns.Neighbors {
-			dagString += fmt.Sprintf("id_%s -> id_%s;\n", txs[i].Payload.TxId[:8], txs[n].Payload.TxId[:8])
-		}
+	analysis, err := AnalyzeBlockDAG(&commonPb.Block{Header: &commonPb.BlockHeader{}, Dag: dag, Txs: txs}, nil)
+	if err != nil {
+		ts.log.Warnf("dump dag failed, %s", err)
+		return
 	}
-	dagString += "}"
-	ts.log.Infof("Dump Dag: %s", dagString)
+	ts.log.Infof("Dump Dag: %s", analysis.DOT())
 }
 
 func (ts *TxScheduler) chargeGasLimit(accountMangerContract *commonPb.Contract, tx *commonPb.Transaction,
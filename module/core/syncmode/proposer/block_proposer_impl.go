@@ -23,6 +23,7 @@ import (
 
 	"chainmaker.org/chainmaker-go/module/txfilter/filtercommon"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/core/common"
 	"chainmaker.org/chainmaker-go/module/core/provider/conf"
 	"chainmaker.org/chainmaker/common/v2/monitor"
@@ -133,7 +134,7 @@ func NewBlockProposer(config BlockProposerConfig, log protocol.Logger) (protocol
 		blockProposerImpl.proposeTimer.Stop()
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		blockProposerImpl.metricBlockPackageTime = monitor.NewHistogramVec(
 			monitor.SUBSYSTEM_CORE_PROPOSER,
 			"metric_block_package_time",
@@ -159,7 +160,7 @@ func NewBlockProposer(config BlockProposerConfig, log protocol.Logger) (protocol
 
 	blockProposerImpl.blockBuilder = common.NewBlockBuilder(bbConf)
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		//chainId,height,txInfo,timeStamp
 		blockProposerImpl.metricRandomAttackTime = monitor.NewCounterVec(monitor.SUBSYSTEM_CORE_PROPOSER,
 			"metric_random_tx_attack",
@@ -393,7 +394,7 @@ func (bp *BlockProposerImpl) proposing(height uint64, preHash []byte) *commonpb.
 		block.Header.BlockHeight, block.Header.TxCount,
 		totalTimes, fetchLasts, filterValidateLasts, fetchTotalLasts,
 		timeLasts[0], timeLasts[1], timeLasts[2], timeLasts[3], elapsed)
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		bp.metricBlockPackageTime.WithLabelValues(bp.chainId).Observe(float64(elapsed) / 1000)
 	}
 	return block
@@ -470,7 +471,7 @@ func (bp *BlockProposerImpl) OnReceiveRwSetVerifyFailTxs(rwSetVerifyFailTxs *con
 				bp.chainId, rwSetVerifyFailTxs.BlockHeight,
 				tx.Payload.TxId, tx.Payload.ContractName, tx.Payload.Method, utils.CurrentTimeMillisSeconds())
 
-			if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+			if confreload.Config().MonitorConfig.Enabled {
 				bp.metricRandomAttackTime.WithLabelValues(bp.chainId, tx.Payload.ContractName,
 					tx.Payload.Method, getCurrentTimeHour()).Inc()
 			}
@@ -507,7 +508,7 @@ func (bp *BlockProposerImpl) OnReceiveRwSetVerifyFailTxs(rwSetVerifyFailTxs *con
 			bp.chainId, rwSetVerifyFailTxs.BlockHeight,
 			tx.Payload.TxId, tx.Payload.ContractName, tx.Payload.Method, utils.CurrentTimeMillisSeconds())
 
-		if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+		if confreload.Config().MonitorConfig.Enabled {
 			bp.metricRandomAttackTime.WithLabelValues(bp.chainId, tx.Payload.ContractName,
 				tx.Payload.Method, getCurrentTimeHour()).Inc()
 		}
@@ -8,6 +8,7 @@ SPDX-License-Identifier: Apache-2.0
 package monitor
 
 import (
+	"chainmaker.org/chainmaker-go/module/confreload"
 	commonMonitor "chainmaker.org/chainmaker/common/v2/monitor"
 	"chainmaker.org/chainmaker/localconf/v2"
 	"chainmaker.org/chainmaker/logger/v2"
@@ -22,10 +23,22 @@ type MonitorServer struct {
 	log        *logger.CMLogger
 }
 
+// reloadKeyEnabled the monitor toggle, it can be turned off at runtime, see confreload. Turning it on needs a
+// restart, as the metrics are only created by the modules started with the monitor on.
+const reloadKeyEnabled = "monitor.enabled"
+
 func NewMonitorServer() *MonitorServer {
 	var log = logger.GetLogger(logger.MODULE_MONITOR)
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	confreload.Register("monitor", []string{reloadKeyEnabled}, func(_ []string) error {
+		if confreload.Config().MonitorConfig.Enabled {
+			return confreload.ErrNeedRestart
+		}
+		log.Infof("monitor is turned off, the metrics are no longer updated")
+		return nil
+	})
+
+	if confreload.Config().MonitorConfig.Enabled {
 		mux := http.NewServeMux()
 		mux.Handle("/metrics", Handler())
 		return &MonitorServer{
@@ -21,7 +21,9 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/snapshot"
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
 	commonErr "chainmaker.org/chainmaker/common/v2/errors"
 	"chainmaker.org/chainmaker/common/v2/monitor"
 	"chainmaker.org/chainmaker/localconf/v2"
@@ -37,6 +39,7 @@ import (
 	native "chainmaker.org/chainmaker/vm-native/v2"
 	"chainmaker.org/chainmaker/vm/v2"
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
 )
 
 const (
@@ -76,7 +79,7 @@ func NewApiService(ctx context.Context, chainMakerServer *blockchain.ChainMakerS
 		ctx:                   ctx,
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		apiService.metricQueryCounter = monitor.NewCounterVec(monitor.SUBSYSTEM_RPCSERVER, "metric_query_request_counter",
 			"query request counts metric", "chainId", "state")
 		apiService.metricQueryContractCounter = monitor.NewCounterVec(monitor.SUBSYSTEM_RPCSERVER,
@@ -125,13 +128,7 @@ func (s *ApiService) SendRequest(ctx context.Context, req *commonPb.TxRequest) (
 		Result:    nil,
 		Payer:     req.Payer,
 	}, protocol.RPC, false)
-	elapsed := time.Since(startTime)
-
-	// audit log format: ip:port|orgId|chainId|TxType|TxId|Timestamp|ContractName|Method|retCode|retCodeMsg|retMsg
-	// |invokeElapsed
-	s.logBrief.Infof("|%s|%s|%s|%s|%s|%d|%s|%s|%d|%s|%s|%d", GetClientAddr(ctx), req.Sender.Signer.OrgId,
-		req.Payload.ChainId, req.Payload.TxType, req.Payload.TxId, req.Payload.Timestamp, req.Payload.ContractName,
-		req.Payload.Method, resp.Code, resp.Code, resp.Message, elapsed.Milliseconds())
+	s.logTxBrief(ctx, req, resp, time.Since(startTime))
 
 	return resp, nil
 }
@@ -151,19 +148,31 @@ func (s *ApiService) SendRequestSync(ctx context.Context, req *commonPb.TxReques
 		Result:    nil,
 		Payer:     req.Payer,
 	}, protocol.RPC, true)
-	elapsed := time.Since(startTime)
+	s.logTxBrief(ctx, req, resp, time.Since(startTime))
+
+	return resp, nil
+}
 
+// logTxBrief write the audit log of a tx request
+func (s *ApiService) logTxBrief(ctx context.Context, req *commonPb.TxRequest, resp *commonPb.TxResponse,
+	elapsed time.Duration) {
 	// audit log format: ip:port|orgId|chainId|TxType|TxId|Timestamp|ContractName|Method|retCode|retCodeMsg|retMsg
 	// |invokeElapsed
 	s.logBrief.Infof("|%s|%s|%s|%s|%s|%d|%s|%s|%d|%s|%s|%d", GetClientAddr(ctx), req.Sender.Signer.OrgId,
 		req.Payload.ChainId, req.Payload.TxType, req.Payload.TxId, req.Payload.Timestamp, req.Payload.ContractName,
 		req.Payload.Method, resp.Code, resp.Code, resp.Message, elapsed.Milliseconds())
-
-	return resp, nil
 }
 
 // validate tx
 func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCode, errMsg string) {
+	errCode, errMsg, _ = s.validateTx(tx)
+	return
+}
+
+// validateTx validate the tx like validate, the code of a failure tells whether it fails on the node, Internal, on
+// the tx itself, InvalidArgument, or on the access control of the chain, PermissionDenied
+func (s *ApiService) validateTx(tx *commonPb.Transaction) (errCode commonErr.ErrCode, errMsg string,
+	code codes.Code) {
 	var (
 		err error
 		bc  *blockchain.Blockchain
@@ -171,6 +180,7 @@ func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCo
 
 	chainConfig, err := s.chainMakerServer.GetChainConf(tx.Payload.ChainId)
 	if err != nil {
+		code = codes.Internal
 		errCode = commonErr.ERR_CODE_GET_CHAIN_CONF
 		errMsg = s.getErrMsg(errCode, err)
 		s.log.Error(errMsg)
@@ -180,6 +190,7 @@ func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCo
 	if localconf.ChainMakerConfig.NodeConfig.CertKeyUsageCheck {
 		err = checkTxSignCert(tx)
 		if err != nil {
+			code = codes.InvalidArgument
 			errCode = commonErr.ERR_CODE_TX_VERIFY_FAILED
 			errMsg = s.getErrMsg(errCode, err)
 			s.log.Error(errMsg)
@@ -189,6 +200,7 @@ func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCo
 
 	bc, err = s.chainMakerServer.GetBlockchain(tx.Payload.ChainId)
 	if err != nil {
+		code = codes.Internal
 		errCode = commonErr.ERR_CODE_GET_BLOCKCHAIN
 		errMsg = s.getErrMsg(errCode, err)
 		s.log.Error(errMsg)
@@ -197,6 +209,12 @@ func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCo
 
 	blockVersion := chainConfig.ChainConfig().GetBlockVersion()
 	if err = utils.VerifyTxWithoutPayload(tx, tx.Payload.ChainId, bc.GetAccessControl(), blockVersion); err != nil {
+		// the failures of the signatures and the endorsement policy, the other checks are on the fields of the tx
+		authFailed := strings.Contains(err.Error(), "verify tx authentation failed")
+		code = codes.InvalidArgument
+		if authFailed {
+			code = codes.PermissionDenied
+		}
 		errCode = commonErr.ERR_CODE_TX_VERIFY_FAILED
 		errMsg = fmt.Sprintf("%s, %s, txId:%s, sender:%s, endorsers-len:%d,\nendorsers:\n",
 			errCode.String(), err.Error(), tx.Payload.TxId,
@@ -205,8 +223,8 @@ func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCo
 			errMsg += fmt.Sprintf("%s ", endorser.Signer.MemberInfo)
 		}
 		s.log.Error(errMsg)
-		if localconf.ChainMakerConfig.MonitorConfig.Enabled {
-			if strings.Contains(err.Error(), "verify tx authentation failed") {
+		if confreload.Config().MonitorConfig.Enabled {
+			if authFailed {
 				sender := hex.EncodeToString(tx.Sender.Signer.MemberInfo)
 				//交易发起者身份不合法 chainId,timeStamp,txId,signerMemberInfo
 				s.log.Warnf("<METRIC> verify tx authentation failed, chainId:%s, date:%s, signerMemberInfo:%s",
@@ -219,8 +237,15 @@ func (s *ApiService) validate(tx *commonPb.Transaction) (errCode commonErr.ErrCo
 		}
 		return
 	}
+	if err = checkCRLExpiry(bc.GetAccessControl(), tx); err != nil {
+		code = codes.PermissionDenied
+		errCode = commonErr.ERR_CODE_TX_VERIFY_FAILED
+		errMsg = s.getErrMsg(errCode, err)
+		s.log.Warn(errMsg)
+		return
+	}
 
-	return commonErr.ERR_CODE_OK, ""
+	return commonErr.ERR_CODE_OK, "", codes.OK
 }
 
 func (s *ApiService) getErrMsg(errCode commonErr.ErrCode, err error) string {
@@ -307,11 +332,26 @@ func (s *ApiService) dealQuery(tx *commonPb.Transaction, source protocol.TxSourc
 
 	var log = logger.GetLoggerByChain(logger.MODULE_SNAPSHOT, chainId)
 
+	queryHeight, isHistory, params, err := getQueryBlockHeight(tx.Payload.Parameters)
+	if err != nil {
+		s.log.Warn(err)
+		resp.Code = commonPb.TxStatusCode_INVALID_PARAMETER
+		resp.Message = err.Error()
+		return resp
+	}
+
 	var snap protocol.Snapshot
-	snap, err = snapshot.NewQuerySnapshot(store, log)
+	if isHistory {
+		snap, err = snapshot.NewHistoryQuerySnapshot(store, queryHeight, log)
+	} else {
+		snap, err = snapshot.NewQuerySnapshot(store, log)
+	}
 	if err != nil {
 		s.log.Error(err)
 		resp.Code = commonPb.TxStatusCode_INTERNAL_ERROR
+		if err == tbf.ErrArchivedBlock {
+			resp.Code = commonPb.TxStatusCode_ARCHIVED_BLOCK
+		}
 		resp.Message = err.Error()
 		resp.TxId = tx.Payload.TxId
 		return resp
@@ -341,7 +381,12 @@ func (s *ApiService) dealQuery(tx *commonPb.Transaction, source protocol.TxSourc
 	if contract.RuntimeType != commonPb.RuntimeType_NATIVE &&
 		contract.RuntimeType != commonPb.RuntimeType_GO &&
 		contract.RuntimeType != commonPb.RuntimeType_DOCKER_GO {
-		bytecode, err = store.GetContractBytecode(contract.Name)
+		if isHistory {
+			// the bytecode of the contract version at the height
+			bytecode, err = ctx.GetContractBytecode(contract.Name)
+		} else {
+			bytecode, err = store.GetContractBytecode(contract.Name)
+		}
 		if err != nil {
 			s.log.Error(err)
 			resp.Code = commonPb.TxStatusCode_INTERNAL_ERROR
@@ -368,7 +413,7 @@ func (s *ApiService) dealQuery(tx *commonPb.Transaction, source protocol.TxSourc
 		}
 	}
 	txResult, _, txStatusCode := vmMgr.RunContract(contract, tx.Payload.Method,
-		bytecode, s.kvPair2Map(tx.Payload.Parameters), ctx, gasUsed, tx.Payload.TxType)
+		bytecode, s.kvPair2Map(params), ctx, gasUsed, tx.Payload.TxType)
 	s.log.DebugDynamic(func() string {
 		contractJson, _ := json.Marshal(contract)
 		return fmt.Sprintf("vmMgr.RunContract: txStatusCode:%d, resultCode:%d, contractName[%s](%s), "+
@@ -405,7 +450,7 @@ func (s *ApiService) dealQuery(tx *commonPb.Transaction, source protocol.TxSourc
 		txResult.GasUsed += gasEvents
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 
 		if txStatusCode == commonPb.TxStatusCode_SUCCESS && txResult.Code != 1 {
 			s.metricQueryCounter.WithLabelValues(chainId, "true").Inc()
@@ -479,11 +524,26 @@ func (s *ApiService) dealSystemChainQuery(tx *commonPb.Transaction, vmMgr protoc
 
 	var log = logger.GetLoggerByChain(logger.MODULE_SNAPSHOT, chainId)
 
+	queryHeight, isHistory, params, err := getQueryBlockHeight(tx.Payload.Parameters)
+	if err != nil {
+		s.log.Warn(err)
+		resp.Code = commonPb.TxStatusCode_INVALID_PARAMETER
+		resp.Message = err.Error()
+		return resp
+	}
+
 	var snap protocol.Snapshot
-	snap, err = snapshot.NewQuerySnapshot(store, log)
+	if isHistory {
+		snap, err = snapshot.NewHistoryQuerySnapshot(store, queryHeight, log)
+	} else {
+		snap, err = snapshot.NewQuerySnapshot(store, log)
+	}
 	if err != nil {
 		s.log.Error(err)
 		resp.Code = commonPb.TxStatusCode_INTERNAL_ERROR
+		if err == tbf.ErrArchivedBlock {
+			resp.Code = commonPb.TxStatusCode_ARCHIVED_BLOCK
+		}
 		resp.Message = err.Error()
 		resp.TxId = tx.Payload.TxId
 		return resp
@@ -515,7 +575,7 @@ func (s *ApiService) dealSystemChainQuery(tx *commonPb.Transaction, vmMgr protoc
 		ctx,
 	)
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		if txResult.Code != 1 {
 			s.metricQueryCounter.WithLabelValues(chainId, "true").Inc()
 		} else {
@@ -555,7 +615,7 @@ func (s *ApiService) dealTransact(ctx context.Context, tx *commonPb.Transaction,
 	var (
 		err       error
 		resp      = &commonPb.TxResponse{TxId: tx.Payload.TxId}
-		txResultC <-chan *TxResultExt
+		txResultC chan *TxResultExt
 	)
 
 	// if sync result, register tx first
@@ -568,7 +628,13 @@ func (s *ApiService) dealTransact(ctx context.Context, tx *commonPb.Transaction,
 			resp.Message = err.Error()
 			return resp
 		}
-		defer dispatcher.Unregister(tx.Payload.ChainId, tx.Payload.TxId)
+		defer dispatcher.Unregister(tx.Payload.ChainId, tx.Payload.TxId, txResultC)
+
+		// a retry of a tx which is already on chain gets its result at once
+		if r := s.getCommittedTxResult(tx.Payload.ChainId, tx.Payload.TxId); r != nil {
+			setTxResult(resp, r)
+			return resp
+		}
 	}
 
 	err = s.chainMakerServer.AddTx(tx.Payload.ChainId, tx, source)
@@ -576,6 +642,16 @@ func (s *ApiService) dealTransact(ctx context.Context, tx *commonPb.Transaction,
 	s.incInvokeCounter(tx.Payload.ChainId, err)
 	s.updateTxSizeHistogram(tx, err)
 
+	if err != nil && syncResult {
+		committed, pending := s.resolveDuplicatedTx(tx.Payload.ChainId, tx.Payload.TxId, resp)
+		if committed {
+			return resp
+		}
+		if pending {
+			err = nil
+		}
+	}
+
 	if err != nil {
 		errMsg := fmt.Sprintf("Add tx failed, %s, chainId:%s, txId:%s",
 			err.Error(), tx.Payload.ChainId, tx.Payload.TxId)
@@ -590,16 +666,12 @@ func (s *ApiService) dealTransact(ctx context.Context, tx *commonPb.Transaction,
 
 	// if sync result, wait tx result
 	if syncResult {
-		timeout := time.Duration(localconf.ChainMakerConfig.RpcConfig.SyncTxResultTimeout) * time.Second
+		timeout := time.Duration(confreload.Config().RpcConfig.SyncTxResultTimeout) * time.Second
 		ticker := time.NewTicker(timeout)
 		defer ticker.Stop()
 		select {
 		case r := <-txResultC:
-			resp.Code = r.Result.Code
-			resp.Message = r.Result.Message
-			resp.ContractResult = r.Result.ContractResult
-			resp.TxTimestamp = r.TxTimestamp
-			resp.TxBlockHeight = r.TxBlockHeight
+			setTxResult(resp, r)
 		case <-ctx.Done():
 			resp.Code = commonPb.TxStatusCode_TIMEOUT
 			resp.Message = ctx.Err().Error()
@@ -615,8 +687,68 @@ func (s *ApiService) dealTransact(ctx context.Context, tx *commonPb.Transaction,
 	return resp
 }
 
+// getCommittedTxResult returns the result of the tx if it is on chain, or nil. The tx filter is checked first, so
+// that the store is rarely read for a new tx.
+func (s *ApiService) getCommittedTxResult(chainId, txId string) *TxResultExt {
+	txFilter, err := s.chainMakerServer.GetTxFilter(chainId)
+	if err == nil && txFilter != nil {
+		var exists bool
+		if exists, _, err = txFilter.IsExists(txId); err == nil && !exists {
+			return nil
+		}
+	}
+
+	store, err := s.chainMakerServer.GetStore(chainId)
+	if err != nil {
+		return nil
+	}
+	committedTx, err := store.GetTx(txId)
+	if err != nil || committedTx == nil || committedTx.Result == nil {
+		return nil
+	}
+	height, err := store.GetTxHeight(txId)
+	if err != nil {
+		s.log.Warnf("get height of committed tx failed, %s, chainId:%s, txId:%s", err, chainId, txId)
+		return nil
+	}
+	return &TxResultExt{
+		Result:        committedTx.Result,
+		TxTimestamp:   committedTx.Payload.Timestamp,
+		TxBlockHeight: height,
+	}
+}
+
+// resolveDuplicatedTx check whether a tx which failed to be added is a retry of a tx which was committed or added
+// to the pool meanwhile. The result of a committed tx is set to resp, pending is true if the tx is in the pool.
+func (s *ApiService) resolveDuplicatedTx(chainId, txId string, resp *commonPb.TxResponse) (committed,
+	pending bool) {
+	if r := s.getCommittedTxResult(chainId, txId); r != nil {
+		setTxResult(resp, r)
+		return true, false
+	}
+	if s.isTxInPool(chainId, txId) {
+		s.log.Debugf("tx is already in pool, wait for its result, chainId:%s, txId:%s", chainId, txId)
+		return false, true
+	}
+	return false, false
+}
+
+// isTxInPool returns whether the tx is waiting in the tx pool
+func (s *ApiService) isTxInPool(chainId, txId string) bool {
+	txs, _, err := s.chainMakerServer.GetTxsInPoolByTxIds(chainId, []string{txId})
+	return err == nil && len(txs) > 0
+}
+
+func setTxResult(resp *commonPb.TxResponse, r *TxResultExt) {
+	resp.Code = r.Result.Code
+	resp.Message = r.Result.Message
+	resp.ContractResult = r.Result.ContractResult
+	resp.TxTimestamp = r.TxTimestamp
+	resp.TxBlockHeight = r.TxBlockHeight
+}
+
 func (s *ApiService) incInvokeCounter(chainId string, err error) {
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		if err == nil {
 			s.metricInvokeCounter.WithLabelValues(chainId, "true").Inc()
 		} else {
@@ -626,7 +758,7 @@ func (s *ApiService) incInvokeCounter(chainId string, err error) {
 }
 
 func (s *ApiService) updateTxSizeHistogram(tx *commonPb.Transaction, err error) {
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		if err == nil {
 			s.metricInvokeTxSizeHistogram.WithLabelValues(tx.Payload.ChainId, "true").Observe(float64(tx.Size()))
 		} else {
@@ -650,11 +782,33 @@ func (s *ApiService) RefreshLogLevelsConfig(ctx context.Context, req *configPb.L
 	}, nil
 }
 
-// UpdateDebugConfig - update debug config for test
+// UpdateDebugConfig - update debug config for test, the rpc black list, rate limit rules, health thresholds, sync
+// peer select strategy and CRL expiry policy, see BlackListAddressesKey, RateLimitRulesKey, HealthThresholdsKey,
+// SyncPeerSelectStrategyKey and CRLExpiryPolicyKey. Only the node admins can change these, see checkChainAdmin.
 func (s *ApiService) UpdateDebugConfig(ctx context.Context, req *configPb.DebugConfigRequest) (
 	*configPb.DebugConfigResponse, error) {
 
-	if err := localconf.UpdateDebugConfig(req.Pairs); err != nil {
+	for _, pair := range req.Pairs {
+		if _, sensitive := chainAdminDebugConfigKeys[pair.Key]; !sensitive {
+			continue
+		}
+		if err := s.checkChainAdmin(ctx, ""); err != nil {
+			s.log.Warnf("[%s] update debug config %s denied, %s", GetClientAddr(ctx), pair.Key, err)
+			return nil, err
+		}
+		break
+	}
+
+	// every pair is validated before any of them is applied, the pairs of none of the updaters go to localconf
+	pairs, apply, err := prepareDebugConfig(req.Pairs, prepareBlackList, prepareRateLimitRules,
+		prepareHealthThresholds, s.prepareSyncPeerSelectStrategy, s.prepareCRLExpiryPolicy)
+	if err == nil && (len(pairs) > 0 || len(req.Pairs) == 0) {
+		err = localconf.UpdateDebugConfig(pairs)
+	}
+	if err == nil {
+		err = apply()
+	}
+	if err != nil {
 		return &configPb.DebugConfigResponse{
 			Code:    int32(1),
 			Message: err.Error(),
@@ -665,6 +819,114 @@ func (s *ApiService) UpdateDebugConfig(ctx context.Context, req *configPb.DebugC
 	}, nil
 }
 
+// debugConfigUpdater parse and validate the pairs of its keys of an UpdateDebugConfig request without changing
+// anything, and returns the other pairs and the function applying the parsed ones, nil if none of the pairs is its
+type debugConfigUpdater func(pairs []*configPb.ConfigKeyValue) ([]*configPb.ConfigKeyValue, func() error, error)
+
+// prepareDebugConfig validate the pairs by the updaters in turn, and returns the pairs of none of them and the
+// function applying the pairs of all of them
+func prepareDebugConfig(pairs []*configPb.ConfigKeyValue, updaters ...debugConfigUpdater) (
+	[]*configPb.ConfigKeyValue, func() error, error) {
+	var applies []func() error
+	for _, updater := range updaters {
+		rest, apply, err := updater(pairs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if apply != nil {
+			applies = append(applies, apply)
+		}
+		pairs = rest
+	}
+	return pairs, func() error {
+		for _, apply := range applies {
+			if err := apply(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+// updateDebugConfig validate and apply the pairs by the updaters, nothing is applied if any pair is invalid
+func updateDebugConfig(pairs []*configPb.ConfigKeyValue, updaters ...debugConfigUpdater) (
+	[]*configPb.ConfigKeyValue, error) {
+	rest, apply, err := prepareDebugConfig(pairs, updaters...)
+	if err != nil {
+		return nil, err
+	}
+	return rest, apply()
+}
+
+// chainAdminDebugConfigKeys the keys of UpdateDebugConfig which change the security of the node
+var chainAdminDebugConfigKeys = map[string]struct{}{
+	BlackListAddressesKey:      {},
+	BlackListAllowAddressesKey: {},
+	BlackListCertSubjectsKey:   {},
+	BlackListOrgIdsKey:         {},
+	RateLimitRulesKey:          {},
+	HealthThresholdsKey:        {},
+	SyncPeerSelectStrategyKey:  {},
+	CRLExpiryPolicyKey:         {},
+}
+
+// SyncPeerSelectStrategyKey the key of UpdateDebugConfig which changes the strategy of the sync service to select
+// the peer which the blocks are requested from, until the chain restarts. The value is a json object, an empty
+// chain_id changes all the chains, for example:
+//
+//	{"chain_id": "chain1", "strategy": "latency_weighted"}
+//
+// the strategies are least_loaded, latency_weighted, random and preference_first.
+const SyncPeerSelectStrategyKey = "SyncPeerSelectStrategy"
+
+// syncPeerSelectStrategy the value of SyncPeerSelectStrategyKey
+type syncPeerSelectStrategy struct {
+	ChainId  string `json:"chain_id"`
+	Strategy string `json:"strategy"`
+}
+
+// prepareSyncPeerSelectStrategy parse the SyncPeerSelectStrategyKey pairs of an UpdateDebugConfig request, and
+// returns the other pairs and the function changing the strategies
+func (s *ApiService) prepareSyncPeerSelectStrategy(pairs []*configPb.ConfigKeyValue) (
+	[]*configPb.ConfigKeyValue, func() error, error) {
+	var (
+		rest   []*configPb.ConfigKeyValue
+		values []*syncPeerSelectStrategy
+	)
+	for _, pair := range pairs {
+		if pair.Key != SyncPeerSelectStrategyKey {
+			rest = append(rest, pair)
+			continue
+		}
+		value := &syncPeerSelectStrategy{}
+		if err := json.Unmarshal([]byte(pair.Value), value); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s, %s", SyncPeerSelectStrategyKey, err)
+		}
+		if !blockSync.IsValidPeerSelectStrategy(value.Strategy) {
+			return nil, nil, fmt.Errorf("invalid %s, unknown peer select strategy: %s", SyncPeerSelectStrategyKey,
+				value.Strategy)
+		}
+		if value.ChainId != "" {
+			if _, err := s.chainMakerServer.GetBlockchain(value.ChainId); err != nil {
+				return nil, nil, fmt.Errorf("invalid %s, %s", SyncPeerSelectStrategyKey, err)
+			}
+		}
+		values = append(values, value)
+	}
+	if len(values) == 0 {
+		return rest, nil, nil
+	}
+	return rest, func() error {
+		for _, value := range values {
+			if err := s.chainMakerServer.SetSyncPeerSelectStrategy(value.ChainId, value.Strategy); err != nil {
+				return fmt.Errorf("invalid %s, %s", SyncPeerSelectStrategyKey, err)
+			}
+			s.log.Infof("update sync peer select strategy of chain [%s] to %s", value.ChainId, value.Strategy)
+		}
+		return nil
+	}, nil
+}
+
 // CheckNewBlockChainConfig check new block chain config.
 func (s *ApiService) CheckNewBlockChainConfig(context.Context, *configPb.CheckNewBlockChainConfigRequest) (
 	*configPb.CheckNewBlockChainConfigResponse, error) {
@@ -0,0 +1,333 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+//go:generate protoc -I=$CHAINMAKER_PB_PATH -I=. --gogofaster_out=plugins=grpc,paths=source_relative:. rpc_batch.proto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	commonErr "chainmaker.org/chainmaker/common/v2/errors"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the full gRPC methods of the batch submission of txs, served next to the RpcNode service. The client streams
+// common.TxRequest messages and closes its side when done, the server streams one common.TxResponse per tx in the
+// order of the requests, a chunk at a time as the requests arrive. The sync one waits for the results of the txs
+// like SendRequestSync.
+const (
+	SendRequestBatchMethod     = "/api.RpcBatch/SendRequestBatch"
+	SendRequestBatchSyncMethod = "/api.RpcBatch/SendRequestBatchSync"
+)
+
+// maxBatchTxCount the most txs of one batch
+const maxBatchTxCount = 10000
+
+// batchChunkTxCount the most txs of a batch processed at once, the responses of a chunk are sent before the next
+// chunk is received, so that the requests are not buffered until the client closes its side
+const batchChunkTxCount = 500
+
+var _ RpcBatchServer = (*ApiService)(nil)
+
+// txBatchStream the server stream of both methods of the RpcBatch service
+type txBatchStream interface {
+	Send(*commonPb.TxResponse) error
+	grpc.ServerStream
+}
+
+// SendRequestBatch - deal a batch of received TxRequests of INVOKE_CONTRACT
+func (s *ApiService) SendRequestBatch(stream RpcBatch_SendRequestBatchServer) error {
+	return s.sendRequestBatch(stream, false)
+}
+
+// SendRequestBatchSync - deal a batch of received TxRequests of INVOKE_CONTRACT, sync tx results and send responses
+func (s *ApiService) SendRequestBatchSync(stream RpcBatch_SendRequestBatchSyncServer) error {
+	return s.sendRequestBatch(stream, true)
+}
+
+// sendRequestBatch deal the requests of a batch chunk by chunk as they arrive, the txs of the whole batch share
+// one sync_tx_result_timeout if syncResult
+func (s *ApiService) sendRequestBatch(stream txBatchStream, syncResult bool) error {
+	timeout := time.Duration(confreload.Config().RpcConfig.SyncTxResultTimeout) * time.Second
+	deadline := time.Now().Add(timeout)
+	var received int
+	for {
+		reqs, rejected, err := recvTxRequests(stream, received, batchChunkTxCount)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(reqs) > 0 {
+			s.log.Debugf("SendRequestBatch received %d txs from tx %d, sync result: %v", len(reqs), received,
+				syncResult)
+			received += len(reqs)
+			if sendErr := s.sendBatchChunk(stream, reqs, rejected, syncResult, deadline, timeout); sendErr != nil {
+				return sendErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+// sendBatchChunk invoke the txs of a chunk and send their responses in the order of the requests
+func (s *ApiService) sendBatchChunk(stream txBatchStream, reqs []*commonPb.TxRequest, rejected []error,
+	syncResult bool, deadline time.Time, timeout time.Duration) error {
+	startTime := time.Now()
+	resps := s.invokeBatch(stream.Context(), reqs, rejected, syncResult, deadline, timeout)
+	elapsed := time.Since(startTime)
+
+	for i, resp := range resps {
+		s.logTxBrief(stream.Context(), reqs[i], resp, elapsed)
+	}
+	for _, resp := range resps {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recvTxRequests receive at most n tx requests of a batch following the received ones, it returns io.EOF with
+// the last requests once the client closes its side of the stream. A request rejected on receipt, such as by the
+// rate limit rules, is kept with its error in rejected, so that it gets its own response rather than failing the
+// batch. The requests are received by RecvMsg rather than Recv, which drops the decoded request of a rejected one.
+func recvTxRequests(stream grpc.ServerStream, received, n int) (reqs []*commonPb.TxRequest, rejected []error,
+	err error) {
+	for len(reqs) < n {
+		req := new(commonPb.TxRequest)
+		err = stream.RecvMsg(req)
+		if err == io.EOF {
+			return reqs, rejected, io.EOF
+		}
+		var rejectedErr *recvRejectedError
+		if err != nil && !errors.As(err, &rejectedErr) {
+			return nil, nil, err
+		}
+		if req.Payload == nil || req.Sender == nil || req.Sender.Signer == nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "tx %d of the batch has no payload or sender",
+				received+len(reqs))
+		}
+		if received+len(reqs) == maxBatchTxCount {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "a batch holds at most %d txs", maxBatchTxCount)
+		}
+		reqs = append(reqs, req)
+		rejected = append(rejected, err)
+	}
+	return reqs, rejected, nil
+}
+
+// invokeBatch verify the txs in parallel, add the valid ones to the tx pools of their chains, and wait for their
+// results until the deadline if syncResult. It returns the response of each tx, a rejected one gets the message
+// of its error.
+func (s *ApiService) invokeBatch(ctx context.Context, reqs []*commonPb.TxRequest, rejected []error,
+	syncResult bool, deadline time.Time, timeout time.Duration) []*commonPb.TxResponse {
+	txs := make([]*commonPb.Transaction, len(reqs))
+	resps := make([]*commonPb.TxResponse, len(reqs))
+	for i, req := range reqs {
+		txs[i] = &commonPb.Transaction{
+			Payload:   req.Payload,
+			Sender:    req.Sender,
+			Endorsers: req.Endorsers,
+			Payer:     req.Payer,
+		}
+		resps[i] = &commonPb.TxResponse{TxId: req.Payload.TxId}
+		if i < len(rejected) && rejected[i] != nil {
+			resps[i].Code = commonPb.TxStatusCode_INTERNAL_ERROR
+			resps[i].Message = status.Convert(rejected[i]).Message()
+		}
+	}
+	valid := s.validateBatch(txs, resps, rejected)
+
+	if !syncResult {
+		s.addBatch(txs, resps, valid, false)
+		return resps
+	}
+
+	registered := s.registerBatch(txs, resps, valid)
+	defer func() {
+		for i, txResultC := range registered {
+			if txResultC != nil {
+				dispatcher.Unregister(txs[i].Payload.ChainId, txs[i].Payload.TxId, txResultC)
+			}
+		}
+	}()
+
+	pending := s.addBatch(txs, resps, valid, true)
+	txResultCs := make([]chan *TxResultExt, len(txs))
+	for i := range txResultCs {
+		if pending[i] {
+			txResultCs[i] = registered[i]
+		}
+	}
+	waitTxResults(ctx, resps, txResultCs, deadline, timeout)
+	return resps
+}
+
+// registerBatch register the valid txs for their results, a tx which is already on chain gets its result at once
+// and is no longer valid to be added
+func (s *ApiService) registerBatch(txs []*commonPb.Transaction, resps []*commonPb.TxResponse,
+	valid []bool) []chan *TxResultExt {
+	registered := make([]chan *TxResultExt, len(txs))
+	for i, tx := range txs {
+		if !valid[i] {
+			continue
+		}
+		txResultC, err := dispatcher.Register(tx.Payload.ChainId, tx.Payload.TxId)
+		if err != nil {
+			resps[i].Code = commonPb.TxStatusCode_INTERNAL_ERROR
+			resps[i].Message = err.Error()
+			valid[i] = false
+			continue
+		}
+		registered[i] = txResultC
+
+		if r := s.getCommittedTxResult(tx.Payload.ChainId, tx.Payload.TxId); r != nil {
+			setTxResult(resps[i], r)
+			valid[i] = false
+		}
+	}
+	return registered
+}
+
+// validateBatch validate the txs in parallel, the response of an invalid one gets its error, the rejected ones
+// are not valid
+func (s *ApiService) validateBatch(txs []*commonPb.Transaction, resps []*commonPb.TxResponse,
+	rejected []error) []bool {
+	valid := make([]bool, len(txs))
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, runtime.NumCPU())
+	for i, tx := range txs {
+		if i < len(rejected) && rejected[i] != nil {
+			continue
+		}
+		if tx.Payload.TxType != commonPb.TxType_INVOKE_CONTRACT || tx.Payload.ChainId == SYSTEM_CHAIN {
+			resps[i].Code = commonPb.TxStatusCode_INTERNAL_ERROR
+			resps[i].Message = commonErr.ERR_CODE_TXTYPE.String()
+			continue
+		}
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, tx *commonPb.Transaction) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			if errCode, errMsg := s.validate(tx); errCode != commonErr.ERR_CODE_OK {
+				resps[i].Code = commonPb.TxStatusCode_INTERNAL_ERROR
+				resps[i].Message = errMsg
+				return
+			}
+			valid[i] = true
+		}(i, tx)
+	}
+	wg.Wait()
+	return valid
+}
+
+// addBatch add the valid txs to the tx pools of their chains tx by tx, the pools have no batch add. It returns
+// which txs are waiting for their results, a tx which fails is resolved like dealTransact if syncResult.
+func (s *ApiService) addBatch(txs []*commonPb.Transaction, resps []*commonPb.TxResponse, valid []bool,
+	syncResult bool) []bool {
+	chainTxs := make(map[string][]int)
+	for i, tx := range txs {
+		if valid[i] {
+			chainTxs[tx.Payload.ChainId] = append(chainTxs[tx.Payload.ChainId], i)
+		}
+	}
+
+	pending := make([]bool, len(txs))
+	for chainId, indexes := range chainTxs {
+		batch := make([]*commonPb.Transaction, 0, len(indexes))
+		for _, i := range indexes {
+			batch = append(batch, txs[i])
+		}
+		errs, err := s.chainMakerServer.AddTxs(chainId, batch, protocol.RPC)
+		for j, i := range indexes {
+			addErr := err
+			if addErr == nil {
+				addErr = errs[j]
+			}
+			s.incInvokeCounter(chainId, addErr)
+			s.updateTxSizeHistogram(txs[i], addErr)
+			pending[i] = s.addBatchResult(txs[i], resps[i], addErr, syncResult)
+		}
+	}
+	return pending
+}
+
+// addBatchResult set the response of a tx after adding it, returns whether to wait for its result
+func (s *ApiService) addBatchResult(tx *commonPb.Transaction, resp *commonPb.TxResponse, err error,
+	syncResult bool) bool {
+	if err != nil && syncResult {
+		committed, pending := s.resolveDuplicatedTx(tx.Payload.ChainId, tx.Payload.TxId, resp)
+		if committed {
+			return false
+		}
+		if pending {
+			err = nil
+		}
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("Add tx failed, %s, chainId:%s, txId:%s",
+			err.Error(), tx.Payload.ChainId, tx.Payload.TxId)
+		s.log.Warn(errMsg)
+		resp.Code = commonPb.TxStatusCode_INTERNAL_ERROR
+		resp.Message = errMsg
+		return false
+	}
+	if !syncResult {
+		resp.Code = commonPb.TxStatusCode_SUCCESS
+		resp.Message = commonErr.ERR_CODE_OK.String()
+	}
+	return syncResult
+}
+
+// waitTxResults wait for the result of each tx with a channel until the deadline, which is the sync_tx_result_timeout
+// shared by the whole batch
+func waitTxResults(ctx context.Context, resps []*commonPb.TxResponse, txResultCs []chan *TxResultExt,
+	deadline time.Time, timeout time.Duration) {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	var timeoutMsg string
+	for i, txResultC := range txResultCs {
+		if txResultC == nil {
+			continue
+		}
+		if timeoutMsg == "" {
+			select {
+			case r := <-txResultC:
+				setTxResult(resps[i], r)
+				continue
+			case <-ctx.Done():
+				timeoutMsg = ctx.Err().Error()
+			case <-timer.C:
+				timeoutMsg = fmt.Sprintf("request reached sync_tx_result_timeout, timeout=%s", timeout)
+			}
+		}
+		// timed out, only take the results which have already arrived
+		select {
+		case r := <-txResultC:
+			setTxResult(resps[i], r)
+		default:
+			resps[i].Code = commonPb.TxStatusCode_TIMEOUT
+			resps[i].Message = timeoutMsg
+		}
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testBatchStream struct {
+	grpc.ServerStream
+	reqs []*commonPb.TxRequest
+	// errs the error returned with each request
+	errs []error
+}
+
+func (s *testBatchStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+	req := m.(*commonPb.TxRequest)
+	req.Payload, req.Sender = s.reqs[0].Payload, s.reqs[0].Sender
+	s.reqs = s.reqs[1:]
+	if len(s.errs) == 0 {
+		return nil
+	}
+	err := s.errs[0]
+	s.errs = s.errs[1:]
+	return err
+}
+
+func TestRecvTxRequests(t *testing.T) {
+	stream := &testBatchStream{reqs: []*commonPb.TxRequest{
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org1"),
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract2", "org1"),
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract3", "org1"),
+	}}
+	// the requests are received chunk by chunk, the last chunk comes with io.EOF
+	reqs, rejected, err := recvTxRequests(stream, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	require.Equal(t, []error{nil, nil}, rejected)
+	require.Equal(t, "contract2", reqs[1].Payload.ContractName)
+	reqs, _, err = recvTxRequests(stream, 2, 2)
+	require.Equal(t, io.EOF, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, "contract3", reqs[0].Payload.ContractName)
+
+	_, _, err = recvTxRequests(&testBatchStream{reqs: []*commonPb.TxRequest{{}}}, 0, batchChunkTxCount)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the batch is limited across the chunks
+	_, _, err = recvTxRequests(&testBatchStream{reqs: []*commonPb.TxRequest{
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org1"),
+	}}, maxBatchTxCount, batchChunkTxCount)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a request rejected by the rate limit rules gets its own result, the batch goes on
+	limited := &recvRejectedError{err: rateLimitError(SendRequestBatchMethod, time.Second)}
+	reqs, rejected, err = recvTxRequests(&testBatchStream{reqs: []*commonPb.TxRequest{
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org1"),
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract2", "org1"),
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract3", "org1"),
+	}, errs: []error{nil, limited, nil}}, 0, batchChunkTxCount)
+	require.Equal(t, io.EOF, err)
+	require.Len(t, reqs, 3)
+	require.NoError(t, rejected[0])
+	require.Equal(t, codes.ResourceExhausted, status.Code(rejected[1]))
+	require.NoError(t, rejected[2])
+
+	// any other error fails the batch
+	_, _, err = recvTxRequests(&testBatchStream{reqs: []*commonPb.TxRequest{
+		newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org1"),
+	}, errs: []error{status.Error(codes.Canceled, "canceled")}}, 0, batchChunkTxCount)
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestInvokeBatch_Rejected(t *testing.T) {
+	s := &ApiService{}
+	reqs := []*commonPb.TxRequest{newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org1")}
+	rejected := []error{&recvRejectedError{err: rateLimitError(SendRequestBatchMethod, -1)}}
+	resps := s.invokeBatch(context.Background(), reqs, rejected, false, time.Now(), 0)
+	require.Len(t, resps, 1)
+	require.Equal(t, commonPb.TxStatusCode_INTERNAL_ERROR, resps[0].Code)
+	require.Contains(t, resps[0].Message, "rejected by ratelimit")
+}
+
+func TestValidateBatch_TxType(t *testing.T) {
+	s := &ApiService{}
+	txs := []*commonPb.Transaction{
+		{Payload: &commonPb.Payload{ChainId: "chain1", TxType: commonPb.TxType_QUERY_CONTRACT}},
+		{Payload: &commonPb.Payload{ChainId: SYSTEM_CHAIN, TxType: commonPb.TxType_INVOKE_CONTRACT}},
+	}
+	resps := []*commonPb.TxResponse{{}, {}}
+	require.Equal(t, []bool{false, false}, s.validateBatch(txs, resps, nil))
+	for _, resp := range resps {
+		require.Equal(t, commonPb.TxStatusCode_INTERNAL_ERROR, resp.Code)
+	}
+}
+
+func TestWaitTxResults(t *testing.T) {
+	resps := []*commonPb.TxResponse{{TxId: "tx0"}, {TxId: "tx1"}, {TxId: "tx2"}, {TxId: "tx3"}}
+	txResultCs := []chan *TxResultExt{make(chan *TxResultExt, 1), nil, make(chan *TxResultExt, 1),
+		make(chan *TxResultExt, 1)}
+	resps[1].Code = commonPb.TxStatusCode_CONTRACT_FAIL
+	result := &TxResultExt{Result: &commonPb.Result{Code: commonPb.TxStatusCode_SUCCESS}, TxBlockHeight: 5}
+	txResultCs[0] <- result
+	txResultCs[3] <- result
+
+	waitTxResults(context.Background(), resps, txResultCs, time.Now().Add(10*time.Millisecond), 10*time.Millisecond)
+	require.Equal(t, uint64(5), resps[0].TxBlockHeight)
+	require.Equal(t, commonPb.TxStatusCode_CONTRACT_FAIL, resps[1].Code)
+	require.Equal(t, commonPb.TxStatusCode_TIMEOUT, resps[2].Code)
+	// results which arrived before the timeout are kept
+	require.Equal(t, commonPb.TxStatusCode_SUCCESS, resps[3].Code)
+	require.Equal(t, uint64(5), resps[3].TxBlockHeight)
+}
@@ -0,0 +1,322 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	cmcred "chainmaker.org/chainmaker/common/v2/crypto/tls/credentials"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// keys of UpdateDebugConfig which replace a part of the rpc black list at runtime, until the node restarts or its
+// section of chainmaker.yml is reloaded, each value is a json array of strings, an empty array clears that part,
+// for example:
+//
+//	BlackListAddresses:      ["10.1.0.0/16", "192.168.1.7", "2001:db8::/32"]
+//	BlackListAllowAddresses: ["10.1.2.3"]
+//	BlackListCertSubjects:   ["client1.sign.wx-org1.chainmaker.org", "CN=admin1,O=wx-org2.chainmaker.org"]
+//	BlackListOrgIds:         ["wx-org3.chainmaker.org"]
+const (
+	// BlackListAddressesKey ips and cidr ranges which are rejected
+	BlackListAddressesKey = "BlackListAddresses"
+	// BlackListAllowAddressesKey ips and cidr ranges which are never rejected, even if blocked otherwise
+	BlackListAllowAddressesKey = "BlackListAllowAddresses"
+	// BlackListCertSubjectsKey tls client cert subjects, the common name or the whole distinguished name
+	BlackListCertSubjectsKey = "BlackListCertSubjects"
+	// BlackListOrgIdsKey org ids, the organization of the tls client cert subject
+	BlackListOrgIdsKey = "BlackListOrgIds"
+)
+
+// the sections of chainmaker.yml holding the rpc black list, loaded on first use and reloaded with the config
+// file, each is a list of strings like the value of the key of UpdateDebugConfig of the same part, for example:
+//
+//	rpc:
+//	  blacklist:
+//	    addresses: ["10.1.0.0/16"]
+//	    allow_addresses: ["10.1.2.3"]
+//	    cert_subjects: ["client1.sign.wx-org1.chainmaker.org"]
+//	    org_ids: ["wx-org3.chainmaker.org"]
+const (
+	BlackListAddressesConfigKey      = "rpc.blacklist.addresses"
+	BlackListAllowAddressesConfigKey = "rpc.blacklist.allow_addresses"
+	BlackListCertSubjectsConfigKey   = "rpc.blacklist.cert_subjects"
+	BlackListOrgIdsConfigKey         = "rpc.blacklist.org_ids"
+)
+
+// blackListConfigKeys the keys of UpdateDebugConfig of the black list sections of chainmaker.yml
+var blackListConfigKeys = map[string]string{
+	BlackListAddressesConfigKey:      BlackListAddressesKey,
+	BlackListAllowAddressesConfigKey: BlackListAllowAddressesKey,
+	BlackListCertSubjectsConfigKey:   BlackListCertSubjectsKey,
+	BlackListOrgIdsConfigKey:         BlackListOrgIdsKey,
+}
+
+// blackListRules an immutable rpc black list, updates build a new one and swap it in
+type blackListRules struct {
+	addresses      []string
+	allowAddresses []string
+	certSubjects   []string
+	orgIds         []string
+
+	blockedNets []*net.IPNet
+	allowedNets []*net.IPNet
+	subjects    map[string]struct{}
+	orgs        map[string]struct{}
+}
+
+var (
+	blackList         atomic.Value // *blackListRules
+	blackListInitOnce sync.Once
+	// blackListUpdateLock serializes the updates, so that none of them is lost
+	blackListUpdateLock sync.Mutex
+)
+
+// currentBlackList returns the black list in effect, it is loaded from the local config on first use and then
+// only changed by replaceBlackList, so it survives RPCServer.Restart. The invalid addresses of the local config are
+// ignored.
+func currentBlackList() *blackListRules {
+	blackListInitOnce.Do(func() {
+		parts, err := loadBlackListConfig([]string{BlackListAddressesConfigKey, BlackListAllowAddressesConfigKey,
+			BlackListCertSubjectsConfigKey, BlackListOrgIdsConfigKey})
+		if err != nil {
+			log.Warnf("load rpc black list failed, %s", err)
+		}
+		blockedNets, invalid := parseIPNets(parts[BlackListAddressesKey])
+		for _, addr := range invalid {
+			log.Warnf("ignore invalid rpc black list address [%s]", addr)
+		}
+		allowedNets, invalid := parseIPNets(parts[BlackListAllowAddressesKey])
+		for _, addr := range invalid {
+			log.Warnf("ignore invalid rpc black list allow address [%s]", addr)
+		}
+		blackList.Store(&blackListRules{
+			addresses:      parts[BlackListAddressesKey],
+			allowAddresses: parts[BlackListAllowAddressesKey],
+			certSubjects:   parts[BlackListCertSubjectsKey],
+			orgIds:         parts[BlackListOrgIdsKey],
+			blockedNets:    blockedNets,
+			allowedNets:    allowedNets,
+			subjects:       stringSet(parts[BlackListCertSubjectsKey]),
+			orgs:           stringSet(parts[BlackListOrgIdsKey]),
+		})
+	})
+	return blackList.Load().(*blackListRules)
+}
+
+// loadBlackListConfig read the black list sections of chainmaker.yml among the keys, by the keys of UpdateDebugConfig
+// of their parts, the other keys are skipped
+func loadBlackListConfig(configKeys []string) (map[string][]string, error) {
+	parts := make(map[string][]string)
+	for _, configKey := range configKeys {
+		key, ok := blackListConfigKeys[configKey]
+		if !ok {
+			continue
+		}
+		var values []string
+		if configKey == BlackListAddressesConfigKey {
+			values = confreload.Config().RpcConfig.BlackList.Addresses
+		} else if err := confreload.UnmarshalExtension(configKey, &values); err != nil {
+			return parts, err
+		}
+		parts[key] = values
+	}
+	return parts, nil
+}
+
+// prepareBlackList parse the black list pairs of an UpdateDebugConfig request, and returns the other pairs and the
+// function replacing the parts of the black list they carry
+func prepareBlackList(pairs []*configPb.ConfigKeyValue) ([]*configPb.ConfigKeyValue, func() error, error) {
+	var rest []*configPb.ConfigKeyValue
+	parts := make(map[string][]string)
+	for _, pair := range pairs {
+		switch pair.Key {
+		case BlackListAddressesKey, BlackListAllowAddressesKey, BlackListCertSubjectsKey, BlackListOrgIdsKey:
+		default:
+			rest = append(rest, pair)
+			continue
+		}
+		var values []string
+		if err := json.Unmarshal([]byte(pair.Value), &values); err != nil {
+			return nil, nil, fmt.Errorf("%s should be a json array of strings, %s", pair.Key, err)
+		}
+		if pair.Key == BlackListAddressesKey || pair.Key == BlackListAllowAddressesKey {
+			if _, invalid := parseIPNets(values); len(invalid) > 0 {
+				return nil, nil, fmt.Errorf("invalid %s %v", pair.Key, invalid)
+			}
+		}
+		parts[pair.Key] = values
+	}
+	if len(parts) == 0 {
+		return rest, nil, nil
+	}
+	return rest, func() error { return replaceBlackList(parts) }, nil
+}
+
+// replaceBlackList replace the parts of the black list by the keys of UpdateDebugConfig, the other parts are kept.
+// The black list is left unchanged if any of the parts is invalid.
+func replaceBlackList(parts map[string][]string) error {
+	blackListUpdateLock.Lock()
+	defer blackListUpdateLock.Unlock()
+
+	current := currentBlackList()
+	addresses, allowAddresses := current.addresses, current.allowAddresses
+	certSubjects, orgIds := current.certSubjects, current.orgIds
+	for key, values := range parts {
+		switch key {
+		case BlackListAddressesKey:
+			addresses = values
+		case BlackListAllowAddressesKey:
+			allowAddresses = values
+		case BlackListCertSubjectsKey:
+			certSubjects = values
+		case BlackListOrgIdsKey:
+			orgIds = values
+		}
+	}
+
+	rules, err := newBlackListRules(addresses, allowAddresses, certSubjects, orgIds)
+	if err != nil {
+		return err
+	}
+	blackList.Store(rules)
+	log.Infof("rpc black list updated, addresses: %v, allow addresses: %v, cert subjects: %v, org ids: %v",
+		addresses, allowAddresses, certSubjects, orgIds)
+	return nil
+}
+
+func newBlackListRules(addresses, allowAddresses, certSubjects, orgIds []string) (*blackListRules, error) {
+	blockedNets, invalid := parseIPNets(addresses)
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid black list addresses %v", invalid)
+	}
+	allowedNets, invalid := parseIPNets(allowAddresses)
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid black list allow addresses %v", invalid)
+	}
+	return &blackListRules{
+		addresses:      addresses,
+		allowAddresses: allowAddresses,
+		certSubjects:   certSubjects,
+		orgIds:         orgIds,
+		blockedNets:    blockedNets,
+		allowedNets:    allowedNets,
+		subjects:       stringSet(certSubjects),
+		orgs:           stringSet(orgIds),
+	}, nil
+}
+
+// check returns the reason why the client of the context is rejected, empty if it is not
+func (r *blackListRules) check(ctx context.Context) string {
+	ip := net.ParseIP(getClientIp(ctx))
+	if ip != nil && containsIP(r.allowedNets, ip) {
+		return ""
+	}
+	if ip != nil && containsIP(r.blockedNets, ip) {
+		return "address " + ip.String()
+	}
+
+	if len(r.subjects) == 0 && len(r.orgs) == 0 {
+		return ""
+	}
+	for _, subject := range getClientCertSubjects(ctx) {
+		if _, ok := r.subjects[subject.CommonName]; ok {
+			return "cert subject " + subject.CommonName
+		}
+		if _, ok := r.subjects[subject.String()]; ok {
+			return "cert subject " + subject.String()
+		}
+		for _, org := range subject.Organization {
+			if _, ok := r.orgs[org]; ok {
+				return "org id " + org
+			}
+		}
+	}
+	return ""
+}
+
+// parseIPNets parse ips and cidr ranges, a single ip is a range of its own, and returns the invalid ones
+func parseIPNets(addresses []string) (nets []*net.IPNet, invalid []string) {
+	for _, addr := range addresses {
+		addr = strings.TrimSpace(addr)
+		if strings.Contains(addr, "/") {
+			_, ipNet, err := net.ParseCIDR(addr)
+			if err != nil {
+				invalid = append(invalid, addr)
+				continue
+			}
+			nets = append(nets, ipNet)
+			continue
+		}
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			invalid = append(invalid, addr)
+			continue
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return nets, invalid
+}
+
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientCert a tls client cert, raw is its der
+type clientCert struct {
+	subject pkix.Name
+	raw     []byte
+}
+
+// getClientCerts returns the tls client certs, the leaf one first, none if the client did not present any
+func getClientCerts(ctx context.Context) []*clientCert {
+	pr, ok := peer.FromContext(ctx)
+	if !ok || pr.AuthInfo == nil {
+		return nil
+	}
+
+	var certs []*clientCert
+	switch info := pr.AuthInfo.(type) {
+	case cmcred.TLSInfo:
+		for _, cert := range info.State.PeerCertificates {
+			certs = append(certs, &clientCert{subject: cert.Subject, raw: cert.Raw})
+		}
+	case credentials.TLSInfo:
+		for _, cert := range info.State.PeerCertificates {
+			certs = append(certs, &clientCert{subject: cert.Subject, raw: cert.Raw})
+		}
+	}
+	return certs
+}
+
+// getClientCertSubjects returns the subjects of the tls client certs, none if the client did not present any
+func getClientCertSubjects(ctx context.Context) []pkix.Name {
+	var subjects []pkix.Name
+	for _, cert := range getClientCerts(ctx) {
+		subjects = append(subjects, cert.subject)
+	}
+	return subjects
+}
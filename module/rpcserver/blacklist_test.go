@@ -0,0 +1,136 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/logger/v2"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func peerContext(addr string, subject *pkix.Name) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	pr := &peer.Peer{Addr: tcpAddr}
+	if subject != nil {
+		pr.AuthInfo = credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{{Subject: *subject}},
+		}}
+	}
+	return peer.NewContext(context.Background(), pr)
+}
+
+func TestBlackListRules_Addresses(t *testing.T) {
+	rules, err := newBlackListRules([]string{"10.1.0.0/16", "192.168.1.7", "2001:db8::/32"},
+		[]string{"10.1.2.3"}, nil, nil)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, rules.check(peerContext("10.1.200.1:1234", nil)))
+	require.NotEmpty(t, rules.check(peerContext("192.168.1.7:1234", nil)))
+	require.NotEmpty(t, rules.check(peerContext("[2001:db8::1]:1234", nil)))
+	require.Empty(t, rules.check(peerContext("10.1.2.3:1234", nil)))
+	require.Empty(t, rules.check(peerContext("192.168.1.8:1234", nil)))
+	require.Empty(t, rules.check(peerContext("[2001:db9::1]:1234", nil)))
+	require.Empty(t, rules.check(context.Background()))
+
+	_, err = newBlackListRules([]string{"10.1.0.0/33"}, nil, nil, nil)
+	require.Error(t, err)
+	_, err = newBlackListRules(nil, []string{"not an ip"}, nil, nil)
+	require.Error(t, err)
+}
+
+func TestBlackListRules_CertSubjects(t *testing.T) {
+	rules, err := newBlackListRules(nil, []string{"127.0.0.1"},
+		[]string{"client1.sign.wx-org1.chainmaker.org"}, []string{"wx-org2.chainmaker.org"})
+	require.NoError(t, err)
+
+	blocked := &pkix.Name{CommonName: "client1.sign.wx-org1.chainmaker.org", Organization: []string{"wx-org1"}}
+	require.NotEmpty(t, rules.check(peerContext("10.0.0.1:1234", blocked)))
+	// allowed addresses override every other rule
+	require.Empty(t, rules.check(peerContext("127.0.0.1:1234", blocked)))
+
+	org := &pkix.Name{CommonName: "client2", Organization: []string{"wx-org2.chainmaker.org"}}
+	require.NotEmpty(t, rules.check(peerContext("10.0.0.1:1234", org)))
+
+	other := &pkix.Name{CommonName: "client2", Organization: []string{"wx-org1.chainmaker.org"}}
+	require.Empty(t, rules.check(peerContext("10.0.0.1:1234", other)))
+
+	rules, err = newBlackListRules(nil, nil, []string{other.String()}, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, rules.check(peerContext("10.0.0.1:1234", other)))
+}
+
+func TestReloadBlackListConfig(t *testing.T) {
+	oldFile := localconf.ConfigFilepath
+	rules := currentBlackList()
+	defer func() {
+		localconf.ConfigFilepath = oldFile
+		blackList.Store(rules)
+	}()
+
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`rpc:
+  blacklist:
+    allow_addresses: ["10.1.2.3"]
+    cert_subjects: ["client1.sign.wx-org1.chainmaker.org"]
+    org_ids: ["wx-org3.chainmaker.org"]
+`), 0600))
+	localconf.ConfigFilepath = file
+	require.NoError(t, reloadRPCConfig([]string{BlackListAllowAddressesConfigKey, BlackListCertSubjectsConfigKey,
+		BlackListOrgIdsConfigKey}))
+	require.Equal(t, []string{"10.1.2.3"}, currentBlackList().allowAddresses)
+	require.NotEmpty(t, currentBlackList().check(peerContext("10.0.0.1:1234",
+		&pkix.Name{CommonName: "client2", Organization: []string{"wx-org3.chainmaker.org"}})))
+
+	// an invalid part keeps the black list in effect
+	file = filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("rpc:\n  blacklist:\n    allow_addresses: [\"10.1.0.0/66\"]\n"),
+		0600))
+	localconf.ConfigFilepath = file
+	require.Error(t, reloadRPCConfig([]string{BlackListAllowAddressesConfigKey}))
+	require.Equal(t, []string{"10.1.2.3"}, currentBlackList().allowAddresses)
+}
+
+func TestUpdateDebugConfig_ValidateAll(t *testing.T) {
+	allowLoopbackAdmins(t)
+	rules := currentBlackList()
+	defer blackList.Store(rules)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	// the valid black list pair is not applied, as a later pair is invalid
+	resp, err := s.UpdateDebugConfig(peerContext("127.0.0.1:12301", nil), &configPb.DebugConfigRequest{
+		Pairs: []*configPb.ConfigKeyValue{
+			{Key: BlackListOrgIdsKey, Value: `["wx-org3.chainmaker.org"]`},
+			{Key: CRLExpiryPolicyKey, Value: "unknown"},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Code)
+	require.Same(t, rules, currentBlackList())
+
+	resp, err = s.UpdateDebugConfig(peerContext("127.0.0.1:12301", nil), &configPb.DebugConfigRequest{
+		Pairs: []*configPb.ConfigKeyValue{
+			{Key: BlackListOrgIdsKey, Value: `["wx-org3.chainmaker.org"]`},
+			{Key: SyncPeerSelectStrategyKey, Value: `{"chain_id": "chain1", "strategy": "random"}`},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Code)
+	require.Same(t, rules, currentBlackList())
+}
@@ -0,0 +1,256 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+//go:generate protoc -I=$CHAINMAKER_PB_PATH -I=. --gogofaster_out=plugins=grpc,paths=source_relative:. rpc_chain_admin.proto
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"sync/atomic"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
+	"chainmaker.org/chainmaker/localconf/v2"
+	acPb "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// chainLeftMsg the message of the result sent to the sync tx waiters of a left chain
+const chainLeftMsg = "chain is left by the node"
+
+var _ RpcChainAdminServer = (*ApiService)(nil)
+
+// JoinChain - join the chain of a genesis bundle, see blockchain.ExtractGenesisBundle
+func (s *ApiService) JoinChain(ctx context.Context, req *JoinChainRequest) (*ChainStatus, error) {
+	return s.dealChainAdmin(ctx, "JoinChain", req.ChainId, func() (*blockchain.ChainStatus, error) {
+		return s.chainMakerServer.JoinChain(req.ChainId, req.Genesis)
+	})
+}
+
+// PauseChain - stop the consensus of a chain, it keeps serving queries
+func (s *ApiService) PauseChain(ctx context.Context, req *ChainAdminRequest) (*ChainStatus, error) {
+	return s.dealChainAdmin(ctx, "PauseChain", req.ChainId, func() (*blockchain.ChainStatus, error) {
+		return s.chainMakerServer.PauseChain(req.ChainId)
+	})
+}
+
+// ResumeChain - start the consensus of a paused chain
+func (s *ApiService) ResumeChain(ctx context.Context, req *ChainAdminRequest) (*ChainStatus, error) {
+	return s.dealChainAdmin(ctx, "ResumeChain", req.ChainId, func() (*blockchain.ChainStatus, error) {
+		return s.chainMakerServer.ResumeChain(req.ChainId)
+	})
+}
+
+// LeaveChain - stop and forget a chain, archive its data if asked
+func (s *ApiService) LeaveChain(ctx context.Context, req *LeaveChainRequest) (*ChainStatus, error) {
+	return s.dealChainAdmin(ctx, "LeaveChain", req.ChainId, func() (*blockchain.ChainStatus, error) {
+		return s.chainMakerServer.LeaveChain(req.ChainId, req.Archive)
+	})
+}
+
+// GetChainStatus - get the state of a chain and the result of the last operation on it
+func (s *ApiService) GetChainStatus(ctx context.Context, req *ChainAdminRequest) (*ChainStatus, error) {
+	return s.dealChainAdmin(ctx, "GetChainStatus", req.ChainId, func() (*blockchain.ChainStatus, error) {
+		return s.chainMakerServer.GetChainStatus(req.ChainId)
+	})
+}
+
+func (s *ApiService) dealChainAdmin(ctx context.Context, method, chainId string,
+	fn func() (*blockchain.ChainStatus, error)) (*ChainStatus, error) {
+	var resp *ChainStatus
+	err := s.dealChainAdminResult(ctx, method, chainId, func() error {
+		chainStatus, err := fn()
+		if err != nil {
+			return err
+		}
+		s.log.Infof("[%s] call %s of chain[%s], chain is %s", GetClientAddr(ctx), method, chainId,
+			chainStatus.State)
+		resp = &ChainStatus{
+			ChainId:    chainStatus.ChainId,
+			State:      string(chainStatus.State),
+			Height:     chainStatus.Height,
+			Message:    chainStatus.Message,
+			Archive:    chainStatus.Archive,
+			UpdateTime: chainStatus.UpdateTime,
+		}
+		return nil
+	})
+	return resp, err
+}
+
+// dealChainAdminResult check the caller is an admin of the chain, then call fn, which sets the response. An error
+// of fn which is not a grpc status, see invalidChainAdminArg, is returned as FailedPrecondition.
+func (s *ApiService) dealChainAdminResult(ctx context.Context, method, chainId string, fn func() error) error {
+	if err := s.checkChainAdmin(ctx, chainId); err != nil {
+		s.log.Warnf("[%s] call %s denied, %s", GetClientAddr(ctx), method, err)
+		return err
+	}
+	if err := fn(); err != nil {
+		s.log.Warnf("[%s] call %s of chain[%s] failed, %s", GetClientAddr(ctx), method, chainId, err)
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+// invalidChainAdminArg the error of an invalid argument of a chain admin rpc
+func invalidChainAdminArg(format string, args ...interface{}) error {
+	return status.Errorf(codes.InvalidArgument, format, args...)
+}
+
+// gatewayForwardedKey the metadata the http gateway adds to the requests it forwards, see runtime.AnnotateContext
+const gatewayForwardedKey = "x-forwarded-for"
+
+// ChainAdminConfigKey the section of chainmaker.yml holding the node admins, who may call every method of the
+// RpcChainAdmin service and set the keys of chainAdminDebugConfigKeys, loaded when the rpc server is created:
+//
+//	rpc:
+//	  chain_admin:
+//	    # the tls client certs of the node admins
+//	    node_admin_certs: [../config/wx-org1/certs/user/admin1/admin1.tls.crt]
+//	    # trust the clients on the loopback interface without a tls cert as node admins
+//	    allow_loopback: false
+const ChainAdminConfigKey = "rpc.chain_admin"
+
+// chainAdminConfig the section of ChainAdminConfigKey
+type chainAdminConfig struct {
+	NodeAdminCerts []string `mapstructure:"node_admin_certs"`
+	AllowLoopback  bool     `mapstructure:"allow_loopback"`
+}
+
+// nodeAdmins the node admins in effect, certs are the der of their tls client certs
+type nodeAdmins struct {
+	certs         [][]byte
+	allowLoopback bool
+}
+
+var currentNodeAdmins atomic.Value // *nodeAdmins
+
+// loadNodeAdmins returns the node admins in effect, none until initNodeAdmins
+func loadNodeAdmins() *nodeAdmins {
+	if admins, ok := currentNodeAdmins.Load().(*nodeAdmins); ok {
+		return admins
+	}
+	return &nodeAdmins{}
+}
+
+// initNodeAdmins load the node admins of ChainAdminConfigKey
+func initNodeAdmins() error {
+	config := &chainAdminConfig{}
+	if err := confreload.UnmarshalExtension(ChainAdminConfigKey, config); err != nil {
+		return err
+	}
+	admins := &nodeAdmins{allowLoopback: config.AllowLoopback}
+	for _, certFile := range config.NodeAdminCerts {
+		bz, err := os.ReadFile(certFile)
+		if err != nil {
+			return fmt.Errorf("read node admin cert failed, %s", err)
+		}
+		block, _ := pem.Decode(bz)
+		if block == nil {
+			return fmt.Errorf("node admin cert %s is not pem encoded", certFile)
+		}
+		admins.certs = append(admins.certs, block.Bytes)
+	}
+	currentNodeAdmins.Store(admins)
+	return nil
+}
+
+// checkChainAdmin the client must be a node admin, see ChainAdminConfigKey, or, when chainId is not empty, an admin
+// of the chain in the org of the node, whose tls client cert is a valid member of the admin role under the access
+// control of the chain. The requests forwarded by the http gateway are never trusted as loopback clients.
+func (s *ApiService) checkChainAdmin(ctx context.Context, chainId string) error {
+	admins := loadNodeAdmins()
+	certs := getClientCerts(ctx)
+	if len(certs) == 0 {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get(gatewayForwardedKey)) > 0 {
+			return status.Error(codes.PermissionDenied, "chain admin rpcs are not allowed through the http gateway")
+		}
+		if ip := net.ParseIP(getClientIp(ctx)); admins.allowLoopback && ip != nil && ip.IsLoopback() {
+			return nil
+		}
+		return status.Error(codes.PermissionDenied, "chain admin rpcs need the tls cert of an admin")
+	}
+	// the first one is the leaf cert of the client
+	leaf := certs[0]
+	for _, cert := range admins.certs {
+		if bytes.Equal(cert, leaf.raw) {
+			return nil
+		}
+	}
+	if chainId == "" {
+		return status.Errorf(codes.PermissionDenied, "tls cert %s is not a node admin", leaf.subject.CommonName)
+	}
+	if err := s.checkChainAdminMember(chainId, leaf); err != nil {
+		return status.Errorf(codes.PermissionDenied, "tls cert %s is not an admin of chain %s, %s",
+			leaf.subject.CommonName, chainId, err)
+	}
+	return nil
+}
+
+// checkChainAdminMember verify the cert is a member of the admin role in the org of the node through the access
+// control of the chain, which checks it against the trust roots, the revocation lists and the frozen certs
+func (s *ApiService) checkChainAdminMember(chainId string, cert *clientCert) error {
+	bc, err := s.chainMakerServer.GetBlockchain(chainId)
+	if err != nil {
+		return err
+	}
+	orgId := localconf.ChainMakerConfig.NodeConfig.OrgId
+	if len(cert.subject.Organization) == 0 || cert.subject.Organization[0] != orgId {
+		return fmt.Errorf("not in the org %s of the node", orgId)
+	}
+	pbMember := &acPb.Member{
+		OrgId:      orgId,
+		MemberType: acPb.MemberType_CERT,
+		MemberInfo: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.raw}),
+	}
+	ac := bc.GetAccessControl()
+	memberStatus, err := ac.GetMemberStatus(pbMember)
+	if err != nil {
+		return err
+	}
+	if memberStatus != acPb.MemberStatus_NORMAL {
+		return fmt.Errorf("member is %s", memberStatus)
+	}
+	member, err := ac.NewMember(pbMember)
+	if err != nil {
+		return err
+	}
+	if member.GetRole() != protocol.RoleAdmin {
+		return fmt.Errorf("role is %s", member.GetRole())
+	}
+	return nil
+}
+
+// onChainLifecycle release what depends on a chain before it is left, and keep the tx result dispatchers
+// in step with the chains of the node
+func onChainLifecycle(chainId string, state blockchain.ChainState) {
+	switch state {
+	case blockchain.ChainStateLeaving:
+		dispatcher.Drain(chainId, &commonPb.Result{
+			Code:    commonPb.TxStatusCode_INTERNAL_ERROR,
+			Message: chainLeftMsg,
+		})
+		chainSubscriptions.cancelChain(chainId)
+	case blockchain.ChainStateRunning, blockchain.ChainStateLeft:
+		if err := dispatcher.CheckAndUpdate(); err != nil {
+			log.Errorf("dispatcher.CheckAndUpdate failed: %s", err.Error())
+		}
+	}
+}
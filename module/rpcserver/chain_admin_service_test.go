@@ -0,0 +1,166 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker/logger/v2"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// allowLoopbackAdmins trust the loopback clients as node admins until the test ends
+func allowLoopbackAdmins(t *testing.T) {
+	currentNodeAdmins.Store(&nodeAdmins{allowLoopback: true})
+	t.Cleanup(func() { currentNodeAdmins.Store(&nodeAdmins{}) })
+}
+
+// certContext the context of a client presenting the tls cert
+func certContext(addr string, cert *x509.Certificate) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     tcpAddr,
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}},
+	})
+}
+
+func TestCheckChainAdmin(t *testing.T) {
+	defer currentNodeAdmins.Store(&nodeAdmins{})
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	// the loopback clients are trusted only if it is allowed
+	currentNodeAdmins.Store(&nodeAdmins{})
+	require.Equal(t, codes.PermissionDenied, status.Code(s.checkChainAdmin(peerContext("127.0.0.1:12301", nil), "")))
+	currentNodeAdmins.Store(&nodeAdmins{allowLoopback: true})
+	require.NoError(t, s.checkChainAdmin(peerContext("127.0.0.1:12301", nil), ""))
+	require.NoError(t, s.checkChainAdmin(peerContext("[::1]:12301", nil), "chain1"))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.checkChainAdmin(peerContext("10.1.2.3:12301", nil), "")))
+
+	// the http gateway forwards the requests from the loopback interface
+	forwarded := metadata.NewIncomingContext(peerContext("127.0.0.1:12301", nil),
+		metadata.Pairs(gatewayForwardedKey, "10.1.2.3"))
+	require.Equal(t, codes.PermissionDenied, status.Code(s.checkChainAdmin(forwarded, "")))
+
+	// a node admin is matched by its cert, the admin organizational unit alone is not enough
+	currentNodeAdmins.Store(&nodeAdmins{certs: [][]byte{[]byte("admin1 cert")}})
+	admin := &x509.Certificate{Raw: []byte("admin1 cert"), Subject: pkix.Name{CommonName: "admin1"}}
+	require.NoError(t, s.checkChainAdmin(certContext("10.1.2.3:12301", admin), ""))
+	require.NoError(t, s.checkChainAdmin(certContext("10.1.2.3:12301", admin), "chain1"))
+	other := &x509.Certificate{Raw: []byte("admin2 cert"),
+		Subject: pkix.Name{CommonName: "admin2", OrganizationalUnit: []string{"admin"}}}
+	require.Equal(t, codes.PermissionDenied, status.Code(s.checkChainAdmin(certContext("10.1.2.3:12301", other), "")))
+	// the admins of a chain the node does not run are unknown
+	require.Equal(t, codes.PermissionDenied,
+		status.Code(s.checkChainAdmin(certContext("10.1.2.3:12301", other), "chain1")))
+}
+
+func TestInitNodeAdmins(t *testing.T) {
+	oldFile := localconf.ConfigFilepath
+	defer func() {
+		localconf.ConfigFilepath = oldFile
+		currentNodeAdmins.Store(&nodeAdmins{})
+	}()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "admin1.tls.crt")
+	require.NoError(t, ioutil.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("admin1 cert")}), 0600))
+	file := filepath.Join(dir, "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("rpc:\n  chain_admin:\n    node_admin_certs: ["+certFile+
+		"]\n    allow_loopback: true\n"), 0600))
+	localconf.ConfigFilepath = file
+	require.NoError(t, initNodeAdmins())
+	require.Equal(t, &nodeAdmins{certs: [][]byte{[]byte("admin1 cert")}, allowLoopback: true}, loadNodeAdmins())
+
+	// the config file is not a pem cert
+	file = filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("rpc:\n  chain_admin:\n    node_admin_certs: ["+file+"]\n"),
+		0600))
+	localconf.ConfigFilepath = file
+	require.Error(t, initNodeAdmins())
+	require.True(t, loadNodeAdmins().allowLoopback)
+}
+
+func TestUpdateDebugConfigChainAdmin(t *testing.T) {
+	defer currentNodeAdmins.Store(&nodeAdmins{})
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+	for key := range chainAdminDebugConfigKeys {
+		_, err := s.UpdateDebugConfig(peerContext("127.0.0.1:12301", nil), &configPb.DebugConfigRequest{
+			Pairs: []*configPb.ConfigKeyValue{{Key: key, Value: "[]"}},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), key)
+	}
+
+	currentNodeAdmins.Store(&nodeAdmins{allowLoopback: true})
+	resp, err := s.UpdateDebugConfig(peerContext("127.0.0.1:12301", nil), &configPb.DebugConfigRequest{
+		Pairs: []*configPb.ConfigKeyValue{{Key: BlackListOrgIdsKey, Value: "[]"}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+}
+
+func TestChainLifecycleRPCs(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+	local := peerContext("127.0.0.1:12301", nil)
+
+	_, err := s.PauseChain(peerContext("10.1.2.3:12301", nil), &ChainAdminRequest{ChainId: "chain1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.JoinChain(local, &JoinChainRequest{ChainId: "chain1", Genesis: []byte("not a bundle")})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.PauseChain(local, &ChainAdminRequest{ChainId: "chain1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.LeaveChain(local, &LeaveChainRequest{ChainId: "chain1", Archive: true})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSubscriptionRegistry(t *testing.T) {
+	r := &subscriptionRegistry{cancels: make(map[string]map[uint64]context.CancelFunc)}
+	ctx1, done1 := r.add("chain1", context.Background())
+	ctx2, done2 := r.add("chain2", context.Background())
+	defer done2()
+
+	r.cancelChain("chain1")
+	<-ctx1.Done()
+	require.NoError(t, ctx2.Err())
+
+	done1()
+	require.NotContains(t, r.cancels, "chain1")
+	require.Len(t, r.cancels["chain2"], 1)
+}
+
+func TestChildDispatcher_Drain(t *testing.T) {
+	child := newChildDispatcher("chain1", nil)
+	txResultC, err := child.register("tx1")
+	require.NoError(t, err)
+
+	child.drain(&commonPb.Result{Code: commonPb.TxStatusCode_INTERNAL_ERROR, Message: chainLeftMsg})
+	r := <-txResultC
+	require.Equal(t, commonPb.TxStatusCode_INTERNAL_ERROR, r.Result.Code)
+	require.Equal(t, chainLeftMsg, r.Result.Message)
+
+	child.unregister("tx1", txResultC)
+	require.Empty(t, child.txRegs)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+)
+
+// the keys of the local config the rpc server applies at runtime, see confreload.Register. The subscriber rate
+// limit, the tls and the port need a restart.
+const (
+	reloadKeySyncTxResultTimeout = "rpc.sync_tx_result_timeout"
+	reloadKeyRateLimit           = "rpc.ratelimit"
+	reloadKeyRateLimitRules      = RateLimitRulesConfigKey
+	reloadKeyHealth              = HealthConfigKey
+	reloadKeyChainAdmin          = ChainAdminConfigKey
+)
+
+// reloadRPCConfig apply the reloaded keys of the rpc config. The sync tx result timeout is read by each request,
+// the rate limit interceptors recreate their buckets, the reloaded parts of the black list replace those in effect,
+// keeping the other parts set by UpdateDebugConfig, and the rate limit rules, the readiness thresholds and the node
+// admins are loaded again.
+func reloadRPCConfig(keys []string) error {
+	rateLimitChanged, rulesChanged, healthChanged, chainAdminChanged := false, false, false, false
+	for _, key := range keys {
+		switch {
+		case strings.HasPrefix(key, reloadKeyChainAdmin+"."):
+			chainAdminChanged = true
+		case key == reloadKeyRateLimitRules || strings.HasPrefix(key, reloadKeyRateLimitRules+"."):
+			rulesChanged = true
+		case strings.HasPrefix(key, reloadKeyRateLimit+"."):
+			rateLimitChanged = true
+		case strings.HasPrefix(key, reloadKeyHealth+"."):
+			healthChanged = true
+		}
+	}
+	parts, err := loadBlackListConfig(keys)
+	if err != nil {
+		return err
+	}
+	if len(parts) > 0 {
+		if err = replaceBlackList(parts); err != nil {
+			return err
+		}
+	}
+	if chainAdminChanged {
+		if err = initNodeAdmins(); err != nil {
+			return err
+		}
+		log.Infof("rpc node admins reloaded, %d certs", len(loadNodeAdmins().certs))
+	}
+	if rulesChanged {
+		if err = initRateLimitRules(); err != nil {
+			return err
+		}
+		log.Infof("rpc rate limit rules reloaded, %d rules", len(currentRateLimitRules()))
+	}
+	if healthChanged {
+		if err = initHealthThresholds(); err != nil {
+			return err
+		}
+		log.Infof("rpc health thresholds reloaded, %+v", loadHealthThresholds())
+	}
+	if rateLimitChanged {
+		atomic.AddUint64(&rateLimitVersion, 1)
+		log.Infof("rpc rate limit reloaded, %+v", confreload.Config().RpcConfig.RateLimitConfig)
+	}
+	return nil
+}
+
+// ReloadConfig - reload the local config file of the node, the report tells the keys which are applied and those
+// which still need a restart, see confreload.Report
+func (s *ApiService) ReloadConfig(ctx context.Context, _ *ReloadConfigRequest) (*ReloadConfigReport, error) {
+	var resp *ReloadConfigReport
+	err := s.dealChainAdminResult(ctx, "ReloadConfig", "", func() error {
+		report, err := confreload.Reload(localconf.ConfigFilepath)
+		if err != nil {
+			return err
+		}
+		s.log.Infof("[%s] call ReloadConfig, applied: %v, need restart: %v", GetClientAddr(ctx),
+			report.Applied, report.NeedRestart)
+		resp = &ReloadConfigReport{Applied: report.Applied, NeedRestart: report.NeedRestart, Failed: report.Failed}
+		return nil
+	})
+	return resp, err
+}
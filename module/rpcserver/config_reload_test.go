@@ -0,0 +1,40 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadRPCConfig(t *testing.T) {
+	holder := &rateLimitBucketsHolder{}
+	buckets := holder.load()
+	require.Same(t, buckets, holder.load())
+	require.NoError(t, reloadRPCConfig([]string{reloadKeySyncTxResultTimeout}))
+	require.Same(t, buckets, holder.load())
+	require.NoError(t, reloadRPCConfig([]string{reloadKeyRateLimit + ".token_per_second"}))
+	require.NotSame(t, buckets, holder.load())
+
+	addresses := localconf.ChainMakerConfig.RpcConfig.BlackList.Addresses
+	rules := currentBlackList()
+	defer func() {
+		localconf.ChainMakerConfig.RpcConfig.BlackList.Addresses = addresses
+		blackList.Store(rules)
+	}()
+
+	localconf.ChainMakerConfig.RpcConfig.BlackList.Addresses = []string{"10.1.0.0/16"}
+	require.NoError(t, reloadRPCConfig([]string{BlackListAddressesConfigKey}))
+	require.NotEmpty(t, currentBlackList().check(peerContext("10.1.2.3:12301", nil)))
+	require.Empty(t, currentBlackList().check(peerContext("10.2.2.3:12301", nil)))
+
+	localconf.ChainMakerConfig.RpcConfig.BlackList.Addresses = []string{"10.1.0.0/66"}
+	require.Error(t, reloadRPCConfig([]string{BlackListAddressesConfigKey}))
+	require.NotEmpty(t, currentBlackList().check(peerContext("10.1.2.3:12301", nil)))
+}
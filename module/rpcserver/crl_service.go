@@ -0,0 +1,100 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"chainmaker.org/chainmaker-go/module/accesscontrol"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// CRLExpiryPolicyKey the key of UpdateDebugConfig which changes the policy applied to the CRLs past their
+// NextUpdate on all the chains of the node, until the node restarts. The value is ignore, warn or reject, which
+// refuses the txs submitted to this node only, see checkCRLExpiry.
+const CRLExpiryPolicyKey = "CRLExpiryPolicy"
+
+// GetRevocationLists - get the status of the certificate revocation lists of the chain, the issuer, the number of
+// the revoked certificates, thisUpdate and nextUpdate of each CRL
+func (s *ApiService) GetRevocationLists(ctx context.Context, req *ChainAdminRequest) (*RevocationLists, error) {
+	var resp *RevocationLists
+	err := s.dealChainAdminResult(ctx, "GetRevocationLists", req.ChainId, func() error {
+		lists, err := s.chainMakerServer.GetRevocationLists(req.ChainId)
+		if err != nil {
+			return err
+		}
+		resp = &RevocationLists{ExpiryPolicy: accesscontrol.GetCRLExpiryPolicy()}
+		for _, list := range lists {
+			revocationList := &RevocationList{
+				Aki:        list.AKI,
+				Issuer:     list.Issuer,
+				Count:      int64(list.Count),
+				ThisUpdate: list.ThisUpdate.Unix(),
+				Expired:    list.Expired,
+			}
+			if !list.NextUpdate.IsZero() {
+				revocationList.NextUpdate = list.NextUpdate.Unix()
+			}
+			resp.Lists = append(resp.Lists, revocationList)
+		}
+		return nil
+	})
+	return resp, err
+}
+
+// prepareCRLExpiryPolicy parse the CRLExpiryPolicyKey pair of an UpdateDebugConfig request, and returns the other
+// pairs and the function changing the policy
+func (s *ApiService) prepareCRLExpiryPolicy(pairs []*configPb.ConfigKeyValue) ([]*configPb.ConfigKeyValue,
+	func() error, error) {
+	var (
+		rest  []*configPb.ConfigKeyValue
+		apply func() error
+	)
+	for _, pair := range pairs {
+		if pair.Key != CRLExpiryPolicyKey {
+			rest = append(rest, pair)
+			continue
+		}
+		policy := pair.Value
+		if !accesscontrol.IsValidCRLExpiryPolicy(policy) {
+			return nil, nil, fmt.Errorf("invalid %s, unknown CRL expiry policy: %s", CRLExpiryPolicyKey, policy)
+		}
+		apply = func() error {
+			if err := accesscontrol.SetCRLExpiryPolicy(policy); err != nil {
+				return fmt.Errorf("invalid %s, %s", CRLExpiryPolicyKey, err)
+			}
+			s.log.Infof("update CRL expiry policy to %s", policy)
+			return nil
+		}
+	}
+	return rest, apply, nil
+}
+
+// checkCRLExpiry refuse the tx if its sender or an endorser is issued by a CA whose CRL is expired and the policy is
+// to reject. It is applied when the tx is submitted only, the verification of the blocks never depends on the policy.
+func checkCRLExpiry(ac protocol.AccessControlProvider, tx *commonPb.Transaction) error {
+	provider, ok := ac.(accesscontrol.RevocationListProvider)
+	if !ok || accesscontrol.GetCRLExpiryPolicy() != accesscontrol.CRLExpiryPolicyReject {
+		return nil
+	}
+	endorsements := tx.Endorsers
+	if tx.Sender != nil {
+		endorsements = append([]*commonPb.EndorsementEntry{tx.Sender}, endorsements...)
+	}
+	for _, endorsement := range endorsements {
+		if endorsement == nil || endorsement.Signer == nil {
+			continue
+		}
+		if err := provider.CheckRevocationListsExpiry(endorsement.Signer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"errors"
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/accesscontrol"
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/logger/v2"
+	acPb "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetRevocationLists(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	_, err := s.GetRevocationLists(peerContext("10.1.2.3:12301", nil), &ChainAdminRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.GetRevocationLists(peerContext("127.0.0.1:12301", nil), &ChainAdminRequest{ChainId: "chain1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestUpdateCRLExpiryPolicy(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+	defer func() {
+		require.NoError(t, accesscontrol.SetCRLExpiryPolicy(accesscontrol.CRLExpiryPolicyWarn))
+	}()
+
+	ctx := peerContext("127.0.0.1:12301", nil)
+	resp, err := s.UpdateDebugConfig(ctx, &configPb.DebugConfigRequest{Pairs: []*configPb.ConfigKeyValue{
+		{Key: CRLExpiryPolicyKey, Value: "deny"},
+	}})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Code)
+
+	resp, err = s.UpdateDebugConfig(ctx, &configPb.DebugConfigRequest{Pairs: []*configPb.ConfigKeyValue{
+		{Key: CRLExpiryPolicyKey, Value: accesscontrol.CRLExpiryPolicyReject},
+	}})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+	require.Equal(t, accesscontrol.CRLExpiryPolicyReject, accesscontrol.GetCRLExpiryPolicy())
+}
+
+type mockRevocationListProvider struct {
+	protocol.AccessControlProvider
+	expired map[string]bool
+}
+
+func (m *mockRevocationListProvider) GetRevocationLists() []*accesscontrol.RevocationListStatus {
+	return nil
+}
+
+func (m *mockRevocationListProvider) CheckRevocationListsExpiry(member *acPb.Member) error {
+	if m.expired[member.OrgId] {
+		return errors.New("CRL is expired")
+	}
+	return nil
+}
+
+func TestCheckCRLExpiry(t *testing.T) {
+	defer func() {
+		require.NoError(t, accesscontrol.SetCRLExpiryPolicy(accesscontrol.CRLExpiryPolicyWarn))
+	}()
+	ac := &mockRevocationListProvider{expired: map[string]bool{"org2": true}}
+	tx := &commonPb.Transaction{
+		Sender:    &commonPb.EndorsementEntry{Signer: &acPb.Member{OrgId: "org1"}},
+		Endorsers: []*commonPb.EndorsementEntry{{Signer: &acPb.Member{OrgId: "org2"}}},
+	}
+
+	require.NoError(t, checkCRLExpiry(ac, tx))
+	require.NoError(t, accesscontrol.SetCRLExpiryPolicy(accesscontrol.CRLExpiryPolicyReject))
+	require.Error(t, checkCRLExpiry(ac, tx))
+	tx.Endorsers = nil
+	require.NoError(t, checkCRLExpiry(ac, tx))
+}
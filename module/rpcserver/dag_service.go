@@ -0,0 +1,128 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+
+	"chainmaker.org/chainmaker-go/module/core/common/scheduler"
+)
+
+// the formats of the result of AnalyzeBlockDAG
+const (
+	DAGFormatJson = "json"
+	DAGFormatDot  = "dot"
+)
+
+// DefaultHotKeysTop the number of the keys returned by GetHotKeys without a top
+const DefaultHotKeysTop = 20
+
+// AnalyzeBlockDAG - analyze the DAG of the block at the height rebuilt from the stored read-write sets, the txs
+// conflicting on which keys, the critical path, the parallelism width and the conflict rate, or only the graphviz
+// dot of the DAG if the format is dot
+func (s *ApiService) AnalyzeBlockDAG(ctx context.Context, req *AnalyzeBlockDAGRequest) (*BlockDAGAnalysis, error) {
+	var resp *BlockDAGAnalysis
+	err := s.dealChainAdminResult(ctx, "AnalyzeBlockDAG", req.ChainId, func() error {
+		if req.Format != "" && req.Format != DAGFormatJson && req.Format != DAGFormatDot {
+			return invalidChainAdminArg("invalid format, %s or %s is expected", DAGFormatJson, DAGFormatDot)
+		}
+		analysis, err := s.chainMakerServer.AnalyzeBlockDAG(req.ChainId, req.Height)
+		if err != nil {
+			return err
+		}
+		if req.Format == DAGFormatDot {
+			resp = &BlockDAGAnalysis{BlockHeight: analysis.BlockHeight, Dot: analysis.DOT()}
+			return nil
+		}
+		resp = newBlockDAGAnalysis(analysis)
+		return nil
+	})
+	return resp, err
+}
+
+// GetHotKeys - aggregate the accesses of the keys by the txs of the blocks from the start height to the end height,
+// or to the last block without it, and return the keys with the most conflicts, at most top of them
+func (s *ApiService) GetHotKeys(ctx context.Context, req *GetHotKeysRequest) (*HotKeyReport, error) {
+	var resp *HotKeyReport
+	err := s.dealChainAdminResult(ctx, "GetHotKeys", req.ChainId, func() error {
+		top := req.Top
+		if top == 0 {
+			top = DefaultHotKeysTop
+		}
+		report, err := s.chainMakerServer.GetHotKeys(req.ChainId, req.StartHeight, req.EndHeight, int(top))
+		if err != nil {
+			return err
+		}
+		resp = &HotKeyReport{
+			StartHeight:     report.StartHeight,
+			EndHeight:       report.EndHeight,
+			BlockCount:      int64(report.BlockCount),
+			TxCount:         int64(report.TxCount),
+			ConflictTxCount: int64(report.ConflictTxCount),
+			ConflictRate:    report.ConflictRate,
+		}
+		for _, key := range report.Keys {
+			resp.Keys = append(resp.Keys, &HotKeyStat{
+				ContractName: key.ContractName,
+				Key:          key.Key,
+				Reads:        int64(key.Reads),
+				Writes:       int64(key.Writes),
+				Conflicts:    int64(key.Conflicts),
+				Blocks:       int64(key.Blocks),
+			})
+		}
+		return nil
+	})
+	return resp, err
+}
+
+func newBlockDAGAnalysis(analysis *scheduler.DAGAnalysis) *BlockDAGAnalysis {
+	resp := &BlockDAGAnalysis{
+		BlockHeight:        analysis.BlockHeight,
+		TxCount:            int64(analysis.TxCount),
+		DagRebuilt:         analysis.DAGRebuilt,
+		CriticalPathLength: int64(analysis.CriticalPathLength),
+		CriticalPath:       toInt64s(analysis.CriticalPath),
+		ParallelismWidth:   int64(analysis.ParallelismWidth),
+		ConflictTxCount:    int64(analysis.ConflictTxCount),
+		ConflictRate:       analysis.ConflictRate,
+		WindowConflictRate: analysis.WindowConflictRate,
+	}
+	for _, tx := range analysis.Txs {
+		resp.Txs = append(resp.Txs, &DAGTx{
+			Index:        int64(tx.Index),
+			TxId:         tx.TxId,
+			ContractName: tx.ContractName,
+			Method:       tx.Method,
+			Depth:        int64(tx.Depth),
+			Dependencies: toInt64s(tx.Dependencies),
+		})
+	}
+	for _, conflict := range analysis.Conflicts {
+		txConflict := &TxConflict{From: int64(conflict.From), To: int64(conflict.To)}
+		for _, key := range conflict.Keys {
+			txConflict.Keys = append(txConflict.Keys, &ConflictKey{
+				ContractName: key.ContractName,
+				Key:          key.Key,
+				Type:         key.Type,
+			})
+		}
+		resp.Conflicts = append(resp.Conflicts, txConflict)
+	}
+	return resp
+}
+
+func toInt64s(nums []int) []int64 {
+	if nums == nil {
+		return nil
+	}
+	result := make([]int64, len(nums))
+	for i, num := range nums {
+		result[i] = int64(num)
+	}
+	return result
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/logger/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAnalyzeBlockDAG(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+	local := peerContext("127.0.0.1:12301", nil)
+
+	_, err := s.AnalyzeBlockDAG(peerContext("10.1.2.3:12301", nil), &AnalyzeBlockDAGRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.AnalyzeBlockDAG(local, &AnalyzeBlockDAGRequest{ChainId: "chain1", Height: 1, Format: "svg"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	for _, format := range []string{"", DAGFormatJson, DAGFormatDot} {
+		_, err = s.AnalyzeBlockDAG(local, &AnalyzeBlockDAGRequest{ChainId: "chain1", Height: 1, Format: format})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	}
+}
+
+func TestGetHotKeys(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	_, err := s.GetHotKeys(peerContext("10.1.2.3:12301", nil), &GetHotKeysRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.GetHotKeys(peerContext("127.0.0.1:12301", nil), &GetHotKeysRequest{ChainId: "chain1", StartHeight: 1})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
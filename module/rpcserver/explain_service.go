@@ -0,0 +1,104 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"chainmaker.org/chainmaker/utils/v2"
+	"github.com/gogo/protobuf/proto"
+)
+
+// ExplainAuthorization - judge the candidate endorsements by the endorsement policy of a resource without
+// submitting anything, and return the resolved policy, the verdict of each endorsement and the overall decision.
+// The resource is either the resource name of the request or that of its unsigned tx, whose sender and endorsers
+// are judged along with the candidate endorsements.
+func (s *ApiService) ExplainAuthorization(ctx context.Context, req *ExplainAuthorizationRequest) (
+	*AuthorizationExplanation, error) {
+	var resp *AuthorizationExplanation
+	err := s.dealChainAdminResult(ctx, "ExplainAuthorization", req.ChainId, func() error {
+		resourceName, endorsements, message, targetOrgId, err := parseExplainAuthorizationRequest(req)
+		if err != nil {
+			return err
+		}
+		explanation, err := s.chainMakerServer.ExplainAuthorization(req.ChainId, resourceName, endorsements,
+			message, targetOrgId)
+		if err != nil {
+			return err
+		}
+		resp = &AuthorizationExplanation{
+			ResourceName:      explanation.ResourceName,
+			Policy:            explanation.Policy,
+			SignatureVerified: explanation.SignatureVerified,
+			Allowed:           explanation.Allowed,
+			Reason:            explanation.Reason,
+		}
+		for _, verdict := range explanation.Endorsements {
+			resp.Endorsements = append(resp.Endorsements, &EndorsementVerdict{
+				Index:    int64(verdict.Index),
+				OrgId:    verdict.OrgId,
+				MemberId: verdict.MemberId,
+				Role:     verdict.Role,
+				Counted:  verdict.Counted,
+				Reason:   verdict.Reason,
+			})
+		}
+		return nil
+	})
+	return resp, err
+}
+
+// parseExplainAuthorizationRequest get the resource name, the endorsements, the signed message and the target
+// organization from the request
+func parseExplainAuthorizationRequest(req *ExplainAuthorizationRequest) (resourceName string,
+	endorsements []*commonPb.EndorsementEntry, message []byte, targetOrgId string, err error) {
+	resourceName, message, targetOrgId = req.ResourceName, req.Message, req.TargetOrgId
+	if len(message) == 0 {
+		message = nil
+	}
+
+	if len(req.Tx) > 0 {
+		tx := &commonPb.Transaction{}
+		if err = proto.Unmarshal(req.Tx, tx); err != nil || tx.Payload == nil {
+			return "", nil, nil, "", invalidChainAdminArg("invalid tx, not a marshaled transaction")
+		}
+		if resourceName == "" {
+			resourceName = utils.GetTxResourceName(tx)
+		}
+		if message == nil {
+			if message, err = utils.CalcUnsignedTxBytes(tx); err != nil {
+				return "", nil, nil, "", err
+			}
+		}
+		if targetOrgId == "" {
+			for _, pair := range tx.Payload.Parameters {
+				if pair.Key == protocol.ConfigNameOrgId {
+					targetOrgId = string(pair.Value)
+					break
+				}
+			}
+		}
+		endorsements = append(endorsements, tx.Endorsers...)
+		if tx.Sender != nil {
+			endorsements = append(endorsements, tx.Sender)
+		}
+	}
+	if resourceName == "" {
+		return "", nil, nil, "", invalidChainAdminArg("neither the resource name nor the tx is given")
+	}
+
+	for i, raw := range req.Endorsements {
+		entry := &commonPb.EndorsementEntry{}
+		if err = proto.Unmarshal(raw, entry); err != nil {
+			return "", nil, nil, "", invalidChainAdminArg("invalid endorsement %d, %s", i, err)
+		}
+		endorsements = append(endorsements, entry)
+	}
+	return resourceName, endorsements, message, targetOrgId, nil
+}
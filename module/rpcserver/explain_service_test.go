@@ -0,0 +1,73 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/logger/v2"
+	acPb "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseExplainAuthorizationRequest(t *testing.T) {
+	_, _, _, _, err := parseExplainAuthorizationRequest(&ExplainAuthorizationRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, _, _, _, err = parseExplainAuthorizationRequest(&ExplainAuthorizationRequest{Tx: []byte("not a tx")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	sender := &commonPb.EndorsementEntry{Signer: &acPb.Member{OrgId: "org1"}, Signature: []byte("sender")}
+	tx := &commonPb.Transaction{
+		Payload: &commonPb.Payload{
+			ChainId:      "chain1",
+			ContractName: "CHAIN_CONFIG",
+			Method:       "NODE_ORG_ADD",
+			Parameters:   []*commonPb.KeyValuePair{{Key: protocol.ConfigNameOrgId, Value: []byte("org2")}},
+		},
+		Sender: sender,
+	}
+	txBytes, err := proto.Marshal(tx)
+	require.NoError(t, err)
+	candidate, err := proto.Marshal(&commonPb.EndorsementEntry{Signer: &acPb.Member{OrgId: "org3"}})
+	require.NoError(t, err)
+
+	resourceName, endorsements, message, targetOrgId, err := parseExplainAuthorizationRequest(
+		&ExplainAuthorizationRequest{Tx: txBytes, Endorsements: [][]byte{candidate}})
+	require.NoError(t, err)
+	require.Equal(t, "CHAIN_CONFIG-NODE_ORG_ADD", resourceName)
+	require.Equal(t, "org2", targetOrgId)
+	require.NotEmpty(t, message)
+	require.Len(t, endorsements, 2)
+	require.Equal(t, "org1", endorsements[0].Signer.OrgId)
+	require.Equal(t, "org3", endorsements[1].Signer.OrgId)
+
+	_, _, _, _, err = parseExplainAuthorizationRequest(&ExplainAuthorizationRequest{
+		ResourceName: "CHAIN_CONFIG-NODE_ORG_ADD",
+		Endorsements: [][]byte{[]byte("not an endorsement")},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestExplainAuthorization(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	_, err := s.ExplainAuthorization(peerContext("10.1.2.3:12301", nil), &ExplainAuthorizationRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.ExplainAuthorization(peerContext("127.0.0.1:12301", nil), &ExplainAuthorizationRequest{
+		ChainId:      "chain1",
+		ResourceName: "CHAIN_CONFIG-NODE_ORG_ADD",
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
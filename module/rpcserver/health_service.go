@@ -0,0 +1,285 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"google.golang.org/grpc/codes"
+	healthPb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// the http paths of the liveness and readiness probes, served by the mix server next to the gateway. /healthz
+// is 200 as long as the process serves it, without looking at the chains. /readyz returns the health of each
+// chain as json, ?chain_id= limits it to one chain, and is 503 unless every chain is ready.
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// HealthConfigKey the section of chainmaker.yml holding the readiness thresholds, loaded when the rpc server is
+// created, a zero threshold disables its check
+const HealthConfigKey = "rpc.health"
+
+// HealthThresholdsKey the key of UpdateDebugConfig which replaces the readiness thresholds at runtime until the
+// node restarts, the value is a json object of the same keys as HealthConfigKey, for example:
+//
+//	{"max_sync_lag": 10, "max_block_idle_seconds": 600, "max_tx_pool_fullness": 0.95}
+const HealthThresholdsKey = "HealthThresholds"
+
+// the status of the liveness and the readiness reports
+const (
+	healthStatusAlive    = "alive"
+	healthStatusReady    = "ready"
+	healthStatusNotReady = "not_ready"
+)
+
+// healthWatchInterval how often a watch of the grpc health service checks the readiness
+const healthWatchInterval = 5 * time.Second
+
+// healthThresholds above which a chain is not ready
+type healthThresholds struct {
+	// MaxSyncLag the most blocks the chain may be behind the highest peer
+	MaxSyncLag uint64 `json:"max_sync_lag" mapstructure:"max_sync_lag"`
+	// MaxBlockIdle the most seconds since the timestamp of the last committed block
+	MaxBlockIdle int64 `json:"max_block_idle_seconds" mapstructure:"max_block_idle_seconds"`
+	// MaxTxPoolFullness the most ratio of the common txs to the capacity of the tx pool
+	MaxTxPoolFullness float64 `json:"max_tx_pool_fullness" mapstructure:"max_tx_pool_fullness"`
+}
+
+func (t *healthThresholds) validate() error {
+	if t.MaxBlockIdle < 0 || t.MaxTxPoolFullness < 0 {
+		return errors.New("thresholds must not be negative")
+	}
+	return nil
+}
+
+// defaultHealthThresholds the readiness thresholds if chainmaker.yml has none
+var defaultHealthThresholds = &healthThresholds{MaxSyncLag: 10, MaxTxPoolFullness: 0.95}
+
+var currentHealthThresholds atomic.Value // *healthThresholds
+
+// loadHealthThresholds returns the readiness thresholds in effect
+func loadHealthThresholds() *healthThresholds {
+	if thresholds, ok := currentHealthThresholds.Load().(*healthThresholds); ok {
+		return thresholds
+	}
+	return defaultHealthThresholds
+}
+
+// initHealthThresholds load the readiness thresholds of HealthConfigKey, the defaults fill the absent keys
+func initHealthThresholds() error {
+	thresholds := *defaultHealthThresholds
+	if err := confreload.UnmarshalExtension(HealthConfigKey, &thresholds); err != nil {
+		return err
+	}
+	if err := thresholds.validate(); err != nil {
+		return fmt.Errorf("invalid %s, %s", HealthConfigKey, err)
+	}
+	currentHealthThresholds.Store(&thresholds)
+	return nil
+}
+
+// prepareHealthThresholds parse the HealthThresholdsKey pair of an UpdateDebugConfig request, and returns the
+// other pairs and the function replacing the thresholds
+func prepareHealthThresholds(pairs []*configPb.ConfigKeyValue) ([]*configPb.ConfigKeyValue, func() error, error) {
+	var (
+		rest  []*configPb.ConfigKeyValue
+		apply func() error
+	)
+	for _, pair := range pairs {
+		if pair.Key != HealthThresholdsKey {
+			rest = append(rest, pair)
+			continue
+		}
+		thresholds := &healthThresholds{}
+		if err := json.Unmarshal([]byte(pair.Value), thresholds); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s, %s", HealthThresholdsKey, err)
+		}
+		if err := thresholds.validate(); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s, %s", HealthThresholdsKey, err)
+		}
+		value := pair.Value
+		apply = func() error {
+			currentHealthThresholds.Store(thresholds)
+			log.Infof("update health thresholds to %s", value)
+			return nil
+		}
+	}
+	return rest, apply, nil
+}
+
+// chainHealthReport the health of a chain and whether it is ready
+type chainHealthReport struct {
+	*blockchain.ChainHealth
+	Ready   bool     `json:"ready"`
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// healthReport the health of the chains of the node
+type healthReport struct {
+	Status string               `json:"status"`
+	Chains []*chainHealthReport `json:"chains,omitempty"`
+}
+
+// newHealthReport collect the health of the chain, or all the chains if chainId is empty. The node is ready if it
+// has chains and they are all ready.
+func newHealthReport(chainMakerServer *blockchain.ChainMakerServer, chainId string) (*healthReport, error) {
+	var healths []*blockchain.ChainHealth
+	if chainId == "" {
+		healths = chainMakerServer.GetAllChainHealth()
+	} else {
+		health, err := chainMakerServer.GetChainHealth(chainId)
+		if err != nil {
+			return nil, err
+		}
+		healths = append(healths, health)
+	}
+
+	thresholds := loadHealthThresholds()
+	report := &healthReport{Status: healthStatusReady}
+	if len(healths) == 0 {
+		report.Status = healthStatusNotReady
+	}
+	for _, health := range healths {
+		reasons := checkChainReadiness(health, thresholds)
+		report.Chains = append(report.Chains, &chainHealthReport{
+			ChainHealth: health,
+			Ready:       len(reasons) == 0,
+			Reasons:     reasons,
+		})
+		if len(reasons) > 0 {
+			report.Status = healthStatusNotReady
+		}
+	}
+	return report, nil
+}
+
+// checkChainReadiness returns why the chain is not ready, none if it is ready. A paused chain is ready as it
+// serves queries.
+func checkChainReadiness(health *blockchain.ChainHealth, thresholds *healthThresholds) []string {
+	var reasons []string
+	if health.State != blockchain.ChainStateRunning && health.State != blockchain.ChainStatePaused {
+		return append(reasons, fmt.Sprintf("chain is %s", health.State))
+	}
+	for _, m := range health.Modules {
+		if !m.Init || m.Started || m.Paused {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("module %s is not started", m.Name))
+	}
+	if thresholds.MaxSyncLag > 0 && health.SyncLag > thresholds.MaxSyncLag {
+		reasons = append(reasons, fmt.Sprintf("sync lag %d > %d", health.SyncLag, thresholds.MaxSyncLag))
+	}
+	if thresholds.MaxBlockIdle > 0 && health.SinceLastBlock > thresholds.MaxBlockIdle {
+		reasons = append(reasons, fmt.Sprintf("no block for %ds > %ds", health.SinceLastBlock,
+			thresholds.MaxBlockIdle))
+	}
+	if thresholds.MaxTxPoolFullness > 0 && health.TxPoolFullness > thresholds.MaxTxPoolFullness {
+		reasons = append(reasons, fmt.Sprintf("tx pool fullness %.2f > %.2f", health.TxPoolFullness,
+			thresholds.MaxTxPoolFullness))
+	}
+	return reasons
+}
+
+// newLivenessHandler the http handler of the liveness probe, which only tells the process is serving, a node
+// whose chains are slow or stopped is alive
+func newLivenessHandler() http.Handler {
+	bz, _ := json.Marshal(&healthReport{Status: healthStatusAlive})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(bz)
+	})
+}
+
+// newReadinessHandler the http handler of the readiness probe
+func newReadinessHandler(chainMakerServer *blockchain.ChainMakerServer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, err := newHealthReport(chainMakerServer, r.URL.Query().Get("chain_id"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		code := http.StatusOK
+		if report.Status != healthStatusReady {
+			code = http.StatusServiceUnavailable
+		}
+		bz, err := json.Marshal(report)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, _ = w.Write(bz)
+	})
+}
+
+// healthServer the grpc health service, the service name is a chain id, or empty for the whole node, and the
+// status is its readiness
+type healthServer struct {
+	healthPb.UnimplementedHealthServer
+	chainMakerServer *blockchain.ChainMakerServer
+}
+
+func newHealthServer(chainMakerServer *blockchain.ChainMakerServer) *healthServer {
+	return &healthServer{chainMakerServer: chainMakerServer}
+}
+
+// Check returns the readiness of the chain named by the service, or of the node
+func (h *healthServer) Check(ctx context.Context, req *healthPb.HealthCheckRequest) (
+	*healthPb.HealthCheckResponse, error) {
+	report, err := newHealthReport(h.chainMakerServer, req.Service)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &healthPb.HealthCheckResponse{Status: servingStatus(report)}, nil
+}
+
+// Watch send the readiness of the chain named by the service, or of the node, whenever it changes
+func (h *healthServer) Watch(req *healthPb.HealthCheckRequest, stream healthPb.Health_WatchServer) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthPb.HealthCheckResponse_UNKNOWN
+	for {
+		current := healthPb.HealthCheckResponse_SERVICE_UNKNOWN
+		if report, err := newHealthReport(h.chainMakerServer, req.Service); err == nil {
+			current = servingStatus(report)
+		}
+		if current != last {
+			if err := stream.Send(&healthPb.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		}
+	}
+}
+
+func servingStatus(report *healthReport) healthPb.HealthCheckResponse_ServingStatus {
+	if report.Status == healthStatusReady {
+		return healthPb.HealthCheckResponse_SERVING
+	}
+	return healthPb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,114 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	localconf "chainmaker.org/chainmaker/localconf/v2"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthPb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckChainReadiness(t *testing.T) {
+	thresholds := &healthThresholds{MaxSyncLag: 10, MaxBlockIdle: 60, MaxTxPoolFullness: 0.9}
+	health := &blockchain.ChainHealth{
+		ChainId: "chain1",
+		State:   blockchain.ChainStateRunning,
+		Modules: []*blockchain.ModuleHealth{
+			{Name: "Store", Init: true, Started: true},
+			{Name: "Consensus", Init: false},
+		},
+		SyncLag:        10,
+		SinceLastBlock: 60,
+		TxPoolFullness: 0.9,
+	}
+	require.Empty(t, checkChainReadiness(health, thresholds))
+
+	health.SyncLag, health.SinceLastBlock, health.TxPoolFullness = 11, 61, 0.91
+	health.Modules = append(health.Modules, &blockchain.ModuleHealth{Name: "Sync", Init: true})
+	require.Len(t, checkChainReadiness(health, thresholds), 4)
+	require.Empty(t, checkChainReadiness(health, &healthThresholds{})[1:])
+
+	health.State = blockchain.ChainStateLeft
+	require.Equal(t, []string{"chain is LEFT"}, checkChainReadiness(health, thresholds))
+}
+
+func TestUpdateHealthThresholds(t *testing.T) {
+	defer currentHealthThresholds.Store(defaultHealthThresholds)
+
+	rest, err := updateDebugConfig([]*configPb.ConfigKeyValue{
+		{Key: HealthThresholdsKey, Value: `{"max_sync_lag": 3, "max_block_idle_seconds": 600}`},
+		{Key: "other", Value: "1"},
+	}, prepareHealthThresholds)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	require.Equal(t, &healthThresholds{MaxSyncLag: 3, MaxBlockIdle: 600}, loadHealthThresholds())
+
+	_, err = updateDebugConfig([]*configPb.ConfigKeyValue{
+		{Key: HealthThresholdsKey, Value: `{"max_tx_pool_fullness": -1}`},
+	}, prepareHealthThresholds)
+	require.Error(t, err)
+	require.Equal(t, uint64(3), loadHealthThresholds().MaxSyncLag)
+}
+
+func TestInitHealthThresholds(t *testing.T) {
+	oldFile := localconf.ConfigFilepath
+	defer func() {
+		localconf.ConfigFilepath = oldFile
+		currentHealthThresholds.Store(defaultHealthThresholds)
+	}()
+
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("rpc:\n  health:\n    max_block_idle_seconds: 300\n"), 0600))
+	localconf.ConfigFilepath = file
+	require.NoError(t, initHealthThresholds())
+	require.Equal(t, &healthThresholds{MaxSyncLag: 10, MaxBlockIdle: 300, MaxTxPoolFullness: 0.95},
+		loadHealthThresholds())
+
+	file = filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("rpc:\n  health:\n    max_tx_pool_fullness: -1\n"), 0600))
+	localconf.ConfigFilepath = file
+	require.Error(t, initHealthThresholds())
+	require.EqualValues(t, 300, loadHealthThresholds().MaxBlockIdle)
+}
+
+func TestHealthHandler(t *testing.T) {
+	server := blockchain.NewChainMakerServer()
+
+	// liveness looks at no chain, even an unknown one
+	w := httptest.NewRecorder()
+	newLivenessHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, LivenessPath+"?chain_id=chain1", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"status": "alive"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	newReadinessHandler(server).ServeHTTP(w, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.Contains(t, w.Body.String(), healthStatusNotReady)
+
+	w = httptest.NewRecorder()
+	newReadinessHandler(server).ServeHTTP(w,
+		httptest.NewRequest(http.MethodGet, ReadinessPath+"?chain_id=chain1", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	h := newHealthServer(server)
+	resp, err := h.Check(context.Background(), &healthPb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, healthPb.HealthCheckResponse_NOT_SERVING, resp.Status)
+	_, err = h.Check(context.Background(), &healthPb.HealthCheckRequest{Service: "chain1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
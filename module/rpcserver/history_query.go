@@ -0,0 +1,39 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"fmt"
+	"strconv"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+)
+
+// QueryBlockHeightKey the optional parameter of a QUERY_CONTRACT tx which runs the query against the state as of
+// the block height, the value is the height in decimal. It is removed before the parameters reach the contract.
+const QueryBlockHeightKey = "__QUERY_BLOCK_HEIGHT__"
+
+// getQueryBlockHeight returns the target block height of a query and the parameters without it, ok is false if
+// the query has no target height
+func getQueryBlockHeight(params []*commonPb.KeyValuePair) (height uint64, ok bool,
+	rest []*commonPb.KeyValuePair, err error) {
+
+	for i, param := range params {
+		if param.Key != QueryBlockHeightKey {
+			continue
+		}
+		height, err = strconv.ParseUint(string(param.Value), 10, 64)
+		if err != nil {
+			return 0, false, nil, fmt.Errorf("invalid %s %q, it should be a decimal block height",
+				QueryBlockHeightKey, param.Value)
+		}
+		rest = make([]*commonPb.KeyValuePair, 0, len(params)-1)
+		rest = append(append(rest, params[:i]...), params[i+1:]...)
+		return height, true, rest, nil
+	}
+	return 0, false, params, nil
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetQueryBlockHeight(t *testing.T) {
+	params := []*commonPb.KeyValuePair{{Key: "a", Value: []byte("1")}, {Key: "b", Value: []byte("2")}}
+	_, ok, rest, err := getQueryBlockHeight(params)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, params, rest)
+
+	withHeight := []*commonPb.KeyValuePair{params[0], {Key: QueryBlockHeightKey, Value: []byte("42")}, params[1]}
+	height, ok, rest, err := getQueryBlockHeight(withHeight)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(42), height)
+	require.Equal(t, params, rest)
+	require.Len(t, withHeight, 3)
+
+	_, _, _, err = getQueryBlockHeight([]*commonPb.KeyValuePair{{Key: QueryBlockHeightKey, Value: []byte("-1")}})
+	require.Error(t, err)
+}
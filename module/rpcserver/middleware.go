@@ -16,9 +16,10 @@ import (
 	"runtime/debug"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	localconf "chainmaker.org/chainmaker/localconf/v2"
+	"chainmaker.org/chainmaker-go/module/confreload"
 	logger "chainmaker.org/chainmaker/logger/v2"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -57,6 +58,9 @@ func GetClientAddr(ctx context.Context) string {
 
 func getClientIp(ctx context.Context) string {
 	addr := GetClientAddr(ctx)
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
 	return strings.Split(addr, ":")[0]
 }
 
@@ -111,25 +115,65 @@ func MonitorInterceptor(ctx context.Context, req interface{},
 	return resp, err
 }
 
-func getRateLimitBucket(bucketMap *sync.Map, tokenBucketSize, tokenPerSecond int, peerIpAddr string) *rate.Limiter {
-	rateLimitType := localconf.ChainMakerConfig.RpcConfig.RateLimitConfig.Type
+// rateLimitVersion is increased when the rate limit config is reloaded, the interceptors then recreate their
+// buckets from the config
+var rateLimitVersion uint64
+
+// rateLimitBuckets the buckets of a rate limit interceptor, created from the rate limit config of a version
+type rateLimitBuckets struct {
+	version         uint64
+	enabled         bool
+	global          bool
+	tokenBucketSize int
+	tokenPerSecond  int
+	bucketMap       sync.Map
+}
+
+func newRateLimitBuckets() *rateLimitBuckets {
+	conf := confreload.Config().RpcConfig.RateLimitConfig
+	return &rateLimitBuckets{
+		version:         atomic.LoadUint64(&rateLimitVersion),
+		enabled:         conf.Enabled,
+		global:          conf.Type == rateLimitTypeGlobal,
+		tokenBucketSize: conf.TokenBucketSize,
+		tokenPerSecond:  conf.TokenPerSecond,
+	}
+}
+
+// rateLimitBucketsHolder hold the buckets of an interceptor, they are recreated once the config is reloaded
+type rateLimitBucketsHolder struct {
+	current atomic.Value // *rateLimitBuckets
+}
+
+func (h *rateLimitBucketsHolder) load() *rateLimitBuckets {
+	if buckets, ok := h.current.Load().(*rateLimitBuckets); ok &&
+		buckets.version == atomic.LoadUint64(&rateLimitVersion) {
+		return buckets
+	}
+	buckets := newRateLimitBuckets()
+	h.current.Store(buckets)
+	return buckets
+}
+
+func getRateLimitBucket(buckets *rateLimitBuckets, peerIpAddr string) *rate.Limiter {
 	var (
 		bucket interface{}
 		ok     bool
 	)
 
-	if rateLimitType == rateLimitTypeGlobal {
-		if bucket, ok = bucketMap.Load(rateLimitTypeGlobal); ok {
+	if buckets.global {
+		if bucket, ok = buckets.bucketMap.Load(rateLimitTypeGlobal); ok {
 			log.Debug("get rateLimit bucket from global")
 			return bucket.(*rate.Limiter)
 		}
 	} else {
-		if bucket, ok = bucketMap.Load(peerIpAddr); ok {
+		if bucket, ok = buckets.bucketMap.Load(peerIpAddr); ok {
 			log.Debugf("get rateLimit bucket from peerIpAddr [%s]", peerIpAddr)
 			return bucket.(*rate.Limiter)
 		}
 	}
 
+	tokenBucketSize, tokenPerSecond := buckets.tokenBucketSize, buckets.tokenPerSecond
 	if tokenBucketSize >= 0 && tokenPerSecond >= 0 {
 		if tokenBucketSize == 0 {
 			tokenBucketSize = rateLimitDefaultTokenBucketSize
@@ -144,12 +188,12 @@ func getRateLimitBucket(bucketMap *sync.Map, tokenBucketSize, tokenPerSecond int
 		return nil
 	}
 
-	if rateLimitType == rateLimitTypeGlobal {
-		if bucket, ok = bucketMap.LoadOrStore(rateLimitTypeGlobal, bucket); !ok {
+	if buckets.global {
+		if bucket, ok = buckets.bucketMap.LoadOrStore(rateLimitTypeGlobal, bucket); !ok {
 			log.Debug("create rateLimit bucket from global")
 		}
 	} else {
-		if bucket, ok = bucketMap.LoadOrStore(peerIpAddr, bucket); !ok {
+		if bucket, ok = buckets.bucketMap.LoadOrStore(peerIpAddr, bucket); !ok {
 			log.Debugf("create rateLimit bucket from peerIpAddr [%s]", peerIpAddr)
 		}
 	}
@@ -157,67 +201,122 @@ func getRateLimitBucket(bucketMap *sync.Map, tokenBucketSize, tokenPerSecond int
 	return bucket.(*rate.Limiter)
 }
 
-// RateLimitInterceptor - set ratelimit interceptor
-func RateLimitInterceptor() grpc.UnaryServerInterceptor {
-
-	tokenBucketSize := localconf.ChainMakerConfig.RpcConfig.RateLimitConfig.TokenBucketSize
-	tokenPerSecond := localconf.ChainMakerConfig.RpcConfig.RateLimitConfig.TokenPerSecond
-	enabled := localconf.ChainMakerConfig.RpcConfig.RateLimitConfig.Enabled
+// RateLimitInterceptor - set ratelimit interceptor, with the configured bucket and the rules of RateLimitRulesKey,
+// the bucket follows the reloads of the rate limit config
+func RateLimitInterceptor(senderFn txSenderFunc) grpc.UnaryServerInterceptor {
 
-	bucketMap := sync.Map{}
+	holder := &rateLimitBucketsHolder{}
 
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
 		interface{}, error) {
 
-		if enabled {
-			ipAddr := getClientIp(ctx)
-			bucket := getRateLimitBucket(&bucketMap, tokenBucketSize, tokenPerSecond, ipAddr)
-			if bucket != nil && !bucket.Allow() {
-				errMsg := fmt.Sprintf("%s is rejected by ratelimit, try later pls", info.FullMethod)
-				log.Warn(errMsg)
-				return nil, status.Error(codes.ResourceExhausted, errMsg)
+		if buckets := holder.load(); buckets.enabled {
+			if err := checkRateLimitBucket(ctx, buckets, info.FullMethod); err != nil {
+				return nil, err
 			}
 		}
 
+		if err := checkRateLimitRules(newRateLimitRequest(ctx, info.FullMethod, req, senderFn)); err != nil {
+			return nil, err
+		}
+
 		return handler(ctx, req)
 	}
 }
 
-// BlackListInterceptor - set ip blacklist interceptor
-func BlackListInterceptor() grpc.UnaryServerInterceptor {
+// RateLimitStreamInterceptor - set stream ratelimit interceptor, opening a stream takes a token of the configured
+// bucket, and every request received on it is checked against the rules of RateLimitRulesKey
+func RateLimitStreamInterceptor(senderFn txSenderFunc) grpc.StreamServerInterceptor {
+
+	holder := &rateLimitBucketsHolder{}
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if buckets := holder.load(); buckets.enabled {
+			if err := checkRateLimitBucket(ss.Context(), buckets, info.FullMethod); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, &rateLimitServerStream{ServerStream: ss, method: info.FullMethod, senderFn: senderFn})
+	}
+}
+
+// rateLimitServerStream checks every received request against the rate limit rules
+type rateLimitServerStream struct {
+	grpc.ServerStream
+	method   string
+	senderFn txSenderFunc
+}
+
+// RecvMsg receive a request, a request rejected by the rate limit rules is returned as a recvRejectedError
+func (s *rateLimitServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := checkRateLimitRules(newRateLimitRequest(s.Context(), s.method, m, s.senderFn)); err != nil {
+		return &recvRejectedError{err: err}
+	}
+	return nil
+}
+
+// recvRejectedError the error of a request which was received on a stream but rejected, the request is decoded,
+// so that a handler can answer it and go on with the next one rather than fail the stream
+type recvRejectedError struct {
+	err error
+}
+
+func (e *recvRejectedError) Error() string { return e.err.Error() }
+
+func (e *recvRejectedError) Unwrap() error { return e.err }
+
+// GRPCStatus the status of the rejection, for the handlers which fail the stream with it
+func (e *recvRejectedError) GRPCStatus() *status.Status { return status.Convert(e.err) }
 
-	blackIps := localconf.ChainMakerConfig.RpcConfig.BlackList.Addresses
+// checkRateLimitBucket take a token from the configured bucket, global or of the client ip
+func checkRateLimitBucket(ctx context.Context, buckets *rateLimitBuckets, method string) error {
+
+	ipAddr := getClientIp(ctx)
+	bucket := getRateLimitBucket(buckets, ipAddr)
+	if bucket == nil {
+		return nil
+	}
+	reservation := bucket.Reserve()
+	if !reservation.OK() {
+		return rateLimitError(method, -1)
+	}
+	if delay := reservation.Delay(); delay > 0 {
+		reservation.Cancel()
+		return rateLimitError(method, delay)
+	}
+	return nil
+}
+
+// BlackListInterceptor - set blacklist interceptor, the black list may be changed at runtime by UpdateDebugConfig
+func BlackListInterceptor() grpc.UnaryServerInterceptor {
 
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
 		interface{}, error) {
 
-		ipAddr := getClientIp(ctx)
-		for _, blackIp := range blackIps {
-			if ipAddr == blackIp {
-				errMsg := fmt.Sprintf("%s is rejected by black list [%s]", info.FullMethod, ipAddr)
-				log.Warn(errMsg)
-				return nil, status.Error(codes.ResourceExhausted, errMsg)
-			}
+		if reason := currentBlackList().check(ctx); reason != "" {
+			errMsg := fmt.Sprintf("%s is rejected by black list [%s]", info.FullMethod, reason)
+			log.Warn(errMsg)
+			return nil, status.Error(codes.ResourceExhausted, errMsg)
 		}
 
 		return handler(ctx, req)
 	}
 }
 
-// BlackListStreamInterceptor - set ip blacklist interceptor
+// BlackListStreamInterceptor - set blacklist interceptor, the black list may be changed at runtime by UpdateDebugConfig
 func BlackListStreamInterceptor() grpc.StreamServerInterceptor {
 
-	blackIps := localconf.ChainMakerConfig.RpcConfig.BlackList.Addresses
-
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 
-		ipAddr := getClientIp(ss.Context())
-		for _, blackIp := range blackIps {
-			if ipAddr == blackIp {
-				errMsg := fmt.Sprintf("%s is rejected by black list [%s]", info.FullMethod, ipAddr)
-				log.Warn(errMsg)
-				return status.Error(codes.ResourceExhausted, errMsg)
-			}
+		if reason := currentBlackList().check(ss.Context()); reason != "" {
+			errMsg := fmt.Sprintf("%s is rejected by black list [%s]", info.FullMethod, reason)
+			log.Warn(errMsg)
+			return status.Error(codes.ResourceExhausted, errMsg)
 		}
 
 		return handler(srv, ss)
@@ -0,0 +1,85 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
+)
+
+// ListQuarantinedBlocks - list the blocks rejected by the sync of the chain without their raw bytes, and the peers
+// banned for serving them, a height limits it to the blocks at the height
+func (s *ApiService) ListQuarantinedBlocks(ctx context.Context, req *QuarantineRequest) (*QuarantineList, error) {
+	var resp *QuarantineList
+	err := s.dealChainAdminResult(ctx, "ListQuarantinedBlocks", req.ChainId, func() error {
+		quarantine, err := s.chainMakerServer.GetSyncQuarantine(req.ChainId)
+		if err != nil {
+			return err
+		}
+		resp = newQuarantineList(quarantine.Blocks(req.Height, false), quarantine.BannedPeers())
+		return nil
+	})
+	return resp, err
+}
+
+// ExportQuarantinedBlocks - export the blocks rejected by the sync of the chain with their raw bytes, the
+// marshaled common.BlockInfo, a height limits it to the blocks at the height
+func (s *ApiService) ExportQuarantinedBlocks(ctx context.Context, req *QuarantineRequest) (*QuarantineList, error) {
+	var resp *QuarantineList
+	err := s.dealChainAdminResult(ctx, "ExportQuarantinedBlocks", req.ChainId, func() error {
+		quarantine, err := s.chainMakerServer.GetSyncQuarantine(req.ChainId)
+		if err != nil {
+			return err
+		}
+		resp = newQuarantineList(quarantine.Blocks(req.Height, true), nil)
+		return nil
+	})
+	return resp, err
+}
+
+// ClearQuarantinedBlocks - remove the blocks at the height from the quarantine of the chain, or all of them and the
+// bans of the peers without the height
+func (s *ApiService) ClearQuarantinedBlocks(ctx context.Context, req *QuarantineRequest) (*QuarantineCleared,
+	error) {
+	var resp *QuarantineCleared
+	err := s.dealChainAdminResult(ctx, "ClearQuarantinedBlocks", req.ChainId, func() error {
+		quarantine, err := s.chainMakerServer.GetSyncQuarantine(req.ChainId)
+		if err != nil {
+			return err
+		}
+		removed := quarantine.Clear(req.Height)
+		s.log.Infof("clear %d quarantined blocks of chain[%s]", removed, req.ChainId)
+		resp = &QuarantineCleared{Removed: int64(removed)}
+		return nil
+	})
+	return resp, err
+}
+
+func newQuarantineList(blocks []*blockSync.QuarantinedBlock, bannedPeers []*blockSync.BannedPeer) *QuarantineList {
+	list := &QuarantineList{}
+	for _, block := range blocks {
+		list.Blocks = append(list.Blocks, &QuarantinedBlock{
+			Height:   block.Height,
+			Hash:     block.Hash,
+			Digest:   block.Digest,
+			From:     block.From,
+			Reason:   block.Reason,
+			Time:     block.Time,
+			Rejected: int64(block.Rejected),
+			Raw:      block.Raw,
+		})
+	}
+	for _, peer := range bannedPeers {
+		list.BannedPeers = append(list.BannedPeers, &BannedPeer{
+			NodeId:   peer.NodeId,
+			Until:    peer.Until,
+			Offenses: int64(peer.Offenses),
+		})
+	}
+	return list
+}
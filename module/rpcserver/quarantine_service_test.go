@@ -0,0 +1,43 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
+	"chainmaker.org/chainmaker/logger/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQuarantinedBlocks(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+	local := peerContext("127.0.0.1:12301", nil)
+
+	_, err := s.ListQuarantinedBlocks(peerContext("10.1.2.3:12301", nil), &QuarantineRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.ExportQuarantinedBlocks(local, &QuarantineRequest{ChainId: "chain1", Height: 10})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.ClearQuarantinedBlocks(local, &QuarantineRequest{ChainId: "chain1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestNewQuarantineList(t *testing.T) {
+	list := newQuarantineList(
+		[]*blockSync.QuarantinedBlock{{Height: 10, Hash: "aa", From: "node2", Rejected: 2, Raw: []byte("raw")}},
+		[]*blockSync.BannedPeer{{NodeId: "node2", Until: 100, Offenses: 1}})
+	require.Len(t, list.Blocks, 1)
+	require.EqualValues(t, 10, list.Blocks[0].Height)
+	require.EqualValues(t, 2, list.Blocks[0].Rejected)
+	require.Equal(t, []byte("raw"), list.Blocks[0].Raw)
+	require.Equal(t, []*BannedPeer{{NodeId: "node2", Until: 100, Offenses: 1}}, list.BannedPeers)
+}
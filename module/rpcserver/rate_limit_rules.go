@@ -0,0 +1,432 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"chainmaker.org/chainmaker/utils/v2"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimitRulesConfigKey the section of chainmaker.yml holding the rpc rate limit rules, loaded when the rpc
+// server is created and again when the section is reloaded. For example, to keep contract queries from starving
+// invokes, and to give each org its own budget of invokes:
+//
+//	rpc:
+//	  ratelimit:
+//	    rules:
+//	      - tx_type: QUERY_CONTRACT
+//	        token_per_second: 500
+//	        token_bucket_size: 1000
+//	      - method: SendRequest
+//	        tx_type: INVOKE_CONTRACT
+//	        per: org_id
+//	        token_per_second: 100
+const RateLimitRulesConfigKey = "rpc.ratelimit.rules"
+
+// RateLimitRulesKey the key of UpdateDebugConfig which replaces the rpc rate limit rules at runtime until the
+// node restarts or RateLimitRulesConfigKey is reloaded, the value is a json array of the rules of
+// RateLimitRulesConfigKey, an empty array removes every rule, for example:
+//
+//	[{"tx_type": "QUERY_CONTRACT", "token_per_second": 500, "token_bucket_size": 1000},
+//	 {"method": "SendRequest", "tx_type": "INVOKE_CONTRACT", "per": "org_id", "token_per_second": 100}]
+const RateLimitRulesKey = "RateLimitRules"
+
+// the identities a rule may keep separate buckets for
+const (
+	rateLimitPerIp     = "ip"
+	rateLimitPerOrgId  = "org_id"
+	rateLimitPerSender = "sender"
+)
+
+// the most buckets a rule keeps for its identities, the identities beyond it share rateLimitOverflowKey
+const maxRateLimitBuckets = 10000
+
+// rateLimitOverflowKey the key of the bucket shared by the identities of a rule beyond maxRateLimitBuckets
+const rateLimitOverflowKey = "overflow"
+
+// rateLimitSweepInterval how often the idle buckets of a rule are looked for
+const rateLimitSweepInterval = time.Minute
+
+// rateLimitRule limits the requests matching all of its non-empty conditions. The requests share one bucket, or
+// with Per, each ip, org id or sender address has a bucket of its own. The org id and the sender address are
+// those of the verified signer of the request, the requests whose signer is not verified never match the OrgId and
+// Sender conditions, and with Per org_id or sender take a token from the bucket of their client ip. A bucket idle
+// long enough to be refilled is dropped, as a new one is the same.
+type rateLimitRule struct {
+	// Method the gRPC method, such as SendRequest or /api.RpcNode/SendRequest
+	Method string `json:"method,omitempty" mapstructure:"method"`
+	// TxType the tx type of the request, such as QUERY_CONTRACT
+	TxType string `json:"tx_type,omitempty" mapstructure:"tx_type"`
+	// ContractName the contract name of the request
+	ContractName string `json:"contract_name,omitempty" mapstructure:"contract_name"`
+	// OrgId the org id of the verified sender of the request
+	OrgId string `json:"org_id,omitempty" mapstructure:"org_id"`
+	// Sender the address of the verified sender of the request
+	Sender string `json:"sender,omitempty" mapstructure:"sender"`
+	// Per one of ip, org_id or sender, empty for one bucket shared by the rule
+	Per string `json:"per,omitempty" mapstructure:"per"`
+	// TokenPerSecond tokens refilled per second, default rateLimitDefaultTokenPerSecond
+	TokenPerSecond int `json:"token_per_second,omitempty" mapstructure:"token_per_second"`
+	// TokenBucketSize the capacity of each bucket, default rateLimitDefaultTokenBucketSize
+	TokenBucketSize int `json:"token_bucket_size,omitempty" mapstructure:"token_bucket_size"`
+
+	bucketsLock sync.Mutex
+	buckets     map[string]*rateLimitBucket
+	lastSweep   time.Time
+}
+
+type rateLimitBucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// rateLimitRequest what the rules of a request are matched against, the sender is only verified when some
+// matching rule needs it
+type rateLimitRequest struct {
+	ctx       context.Context
+	method    string
+	tx        *commonPb.Transaction
+	verifyFn  txSenderFunc
+	sender    *txSender
+	senderErr error
+	verified  bool
+}
+
+// txSender the identity of the sender of a tx whose signature is verified
+type txSender struct {
+	orgId   string
+	address string
+}
+
+// txSenderFunc verify the signature of the sender of the tx, and returns its identity
+type txSenderFunc func(tx *commonPb.Transaction) (*txSender, error)
+
+var (
+	rateLimitRules atomic.Value // []*rateLimitRule
+	// rateLimitRulesUpdateLock serializes the updates, so that none of them is lost
+	rateLimitRulesUpdateLock sync.Mutex
+)
+
+func currentRateLimitRules() []*rateLimitRule {
+	rules, _ := rateLimitRules.Load().([]*rateLimitRule)
+	return rules
+}
+
+// initRateLimitRules load the rules of RateLimitRulesConfigKey, which replace those set by UpdateDebugConfig, no
+// rules if the section is absent
+func initRateLimitRules() error {
+	rateLimitRulesUpdateLock.Lock()
+	defer rateLimitRulesUpdateLock.Unlock()
+
+	var rules []*rateLimitRule
+	if err := confreload.UnmarshalExtension(RateLimitRulesConfigKey, &rules); err != nil {
+		return err
+	}
+	if err := validateRateLimitRules(rules); err != nil {
+		return fmt.Errorf("invalid %s, %s", RateLimitRulesConfigKey, err)
+	}
+	rateLimitRules.Store(rules)
+	return nil
+}
+
+// prepareRateLimitRules parse the rate limit pair of an UpdateDebugConfig request, and returns the other pairs and
+// the function replacing the rules, every bucket restarts full when they are replaced
+func prepareRateLimitRules(pairs []*configPb.ConfigKeyValue) ([]*configPb.ConfigKeyValue, func() error, error) {
+	var (
+		rest  []*configPb.ConfigKeyValue
+		apply func() error
+	)
+	for _, pair := range pairs {
+		if pair.Key != RateLimitRulesKey {
+			rest = append(rest, pair)
+			continue
+		}
+		rules, err := parseRateLimitRules([]byte(pair.Value))
+		if err != nil {
+			return nil, nil, err
+		}
+		value := pair.Value
+		apply = func() error {
+			rateLimitRulesUpdateLock.Lock()
+			defer rateLimitRulesUpdateLock.Unlock()
+			rateLimitRules.Store(rules)
+			log.Infof("rpc rate limit rules updated: %s", value)
+			return nil
+		}
+	}
+	return rest, apply, nil
+}
+
+func parseRateLimitRules(value []byte) ([]*rateLimitRule, error) {
+	var rules []*rateLimitRule
+	if err := json.Unmarshal(value, &rules); err != nil {
+		return nil, fmt.Errorf("%s should be a json array of rules, %s", RateLimitRulesKey, err)
+	}
+	if err := validateRateLimitRules(rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// validateRateLimitRules check the rules and fill the default token settings
+func validateRateLimitRules(rules []*rateLimitRule) error {
+	for i, rule := range rules {
+		if rule == nil {
+			return fmt.Errorf("invalid rate limit rule %d, it is null", i)
+		}
+		switch rule.Per {
+		case "", rateLimitPerIp, rateLimitPerOrgId, rateLimitPerSender:
+		default:
+			return fmt.Errorf("invalid rate limit rule %d, unknown per %q", i, rule.Per)
+		}
+		if rule.TxType != "" {
+			if _, ok := commonPb.TxType_value[rule.TxType]; !ok {
+				return fmt.Errorf("invalid rate limit rule %d, unknown tx type %q", i, rule.TxType)
+			}
+		}
+		if rule.TokenPerSecond < 0 || rule.TokenBucketSize < 0 {
+			return fmt.Errorf("invalid rate limit rule %d, negative token settings", i)
+		}
+		if rule.TokenPerSecond == 0 {
+			rule.TokenPerSecond = rateLimitDefaultTokenPerSecond
+		}
+		if rule.TokenBucketSize == 0 {
+			rule.TokenBucketSize = rateLimitDefaultTokenBucketSize
+		}
+	}
+	return nil
+}
+
+// checkRateLimitRules take a token from the bucket of every rule the request matches. If any of them is empty
+// no token is taken and a ResourceExhausted error is returned.
+func checkRateLimitRules(req *rateLimitRequest) error {
+	rules := currentRateLimitRules()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var (
+		reservations []*rate.Reservation
+		delay        time.Duration
+	)
+	for _, rule := range rules {
+		key, ok := rule.match(req)
+		if !ok {
+			continue
+		}
+		reservation := rule.bucket(key, time.Now()).Reserve()
+		if !reservation.OK() {
+			delay = -1
+			break
+		}
+		reservations = append(reservations, reservation)
+		if d := reservation.Delay(); d > delay {
+			delay = d
+		}
+	}
+
+	if delay == 0 {
+		return nil
+	}
+	for _, reservation := range reservations {
+		reservation.Cancel()
+	}
+	return rateLimitError(req.method, delay)
+}
+
+// match returns whether the rule applies to the request, and the key of the bucket to take a token from
+func (r *rateLimitRule) match(req *rateLimitRequest) (string, bool) {
+	if r.Method != "" && r.Method != req.method && "/"+r.Method != req.method &&
+		!strings.HasSuffix(req.method, "/"+r.Method) {
+		return "", false
+	}
+
+	if !r.needTx() {
+		if r.Per == rateLimitPerIp {
+			return getClientIp(req.ctx), true
+		}
+		return "", true
+	}
+	if !r.matchTx(req.tx) {
+		return "", false
+	}
+
+	if r.OrgId != "" || r.Sender != "" {
+		sender, err := req.getSender()
+		if err != nil || (r.OrgId != "" && sender.orgId != r.OrgId) || (r.Sender != "" && sender.address != r.Sender) {
+			return "", false
+		}
+	}
+
+	switch r.Per {
+	case rateLimitPerIp:
+		return getClientIp(req.ctx), true
+	case rateLimitPerOrgId, rateLimitPerSender:
+		sender, err := req.getSender()
+		if err != nil {
+			// the claimed signer may be anyone's, the client is only known by its address
+			return "ip:" + getClientIp(req.ctx), true
+		}
+		if r.Per == rateLimitPerOrgId {
+			return "org:" + sender.orgId, true
+		}
+		return "addr:" + sender.address, true
+	}
+	return "", true
+}
+
+// needTx returns whether the rule needs the tx of the request, requests without one never match such rules
+func (r *rateLimitRule) needTx() bool {
+	return r.TxType != "" || r.ContractName != "" || r.OrgId != "" || r.Sender != "" ||
+		r.Per == rateLimitPerOrgId || r.Per == rateLimitPerSender
+}
+
+func (r *rateLimitRule) matchTx(tx *commonPb.Transaction) bool {
+	if tx == nil || tx.Payload == nil {
+		return false
+	}
+	if r.TxType != "" && tx.Payload.TxType.String() != r.TxType {
+		return false
+	}
+	return r.ContractName == "" || tx.Payload.ContractName == r.ContractName
+}
+
+// bucket the bucket of the key, the identities beyond maxRateLimitBuckets share one
+func (r *rateLimitRule) bucket(key string, now time.Time) *rate.Limiter {
+	r.bucketsLock.Lock()
+	defer r.bucketsLock.Unlock()
+
+	if r.buckets == nil {
+		r.buckets = make(map[string]*rateLimitBucket)
+	}
+	r.sweep(now)
+	bucket, ok := r.buckets[key]
+	if !ok && len(r.buckets) >= maxRateLimitBuckets {
+		key = rateLimitOverflowKey
+		bucket, ok = r.buckets[key]
+	}
+	if !ok {
+		bucket = &rateLimitBucket{limiter: rate.NewLimiter(rate.Limit(r.TokenPerSecond), r.TokenBucketSize)}
+		r.buckets[key] = bucket
+	}
+	bucket.lastUsed = now
+	return bucket.limiter
+}
+
+// sweep drop the buckets idle for longer than it takes to refill them, at most once per rateLimitSweepInterval
+func (r *rateLimitRule) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	r.lastSweep = now
+	refill := time.Duration(float64(r.TokenBucketSize) / float64(r.TokenPerSecond) * float64(time.Second))
+	for key, bucket := range r.buckets {
+		if now.Sub(bucket.lastUsed) > refill {
+			delete(r.buckets, key)
+		}
+	}
+}
+
+func newRateLimitRequest(ctx context.Context, method string, msg interface{},
+	verifyFn txSenderFunc) *rateLimitRequest {
+
+	req := &rateLimitRequest{ctx: ctx, method: method, verifyFn: verifyFn}
+	if txRequest, ok := msg.(*commonPb.TxRequest); ok && txRequest != nil {
+		req.tx = &commonPb.Transaction{Payload: txRequest.Payload, Sender: txRequest.Sender}
+	}
+	return req
+}
+
+// getSender the verified sender of the tx, verified once per request
+func (req *rateLimitRequest) getSender() (*txSender, error) {
+	if !req.verified {
+		req.verified = true
+		if req.verifyFn == nil || req.tx.GetSender().GetSigner() == nil {
+			req.senderErr = fmt.Errorf("sender of tx is unknown")
+		} else {
+			req.sender, req.senderErr = req.verifyFn(req.tx)
+		}
+	}
+	return req.sender, req.senderErr
+}
+
+// rateLimitError the ResourceExhausted error of a rejected request, with a RetryInfo detail telling the client
+// how long to wait, negative delay when the request can never pass
+func rateLimitError(method string, delay time.Duration) error {
+	errMsg := fmt.Sprintf("%s is rejected by ratelimit, try later pls", method)
+	log.Warn(errMsg)
+	st := status.New(codes.ResourceExhausted, errMsg)
+	if delay <= 0 {
+		return st.Err()
+	}
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// endorsementRefiner the access control which verifies the members and the signatures of endorsements
+type endorsementRefiner interface {
+	RefineEndorsements(endorsements []*commonPb.EndorsementEntry, msg []byte) []*commonPb.EndorsementEntry
+}
+
+// newTxSenderFunc verify the member and the signature of the sender through the access control of the chain of
+// the tx, and resolves its org id and address
+func newTxSenderFunc(chainMakerServer *blockchain.ChainMakerServer) txSenderFunc {
+	return func(tx *commonPb.Transaction) (*txSender, error) {
+		bcChain, err := chainMakerServer.GetBlockchain(tx.Payload.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		ac := bcChain.GetAccessControl()
+		refiner, ok := ac.(endorsementRefiner)
+		if !ok {
+			return nil, fmt.Errorf("access control of chain[%s] can not verify the sender", tx.Payload.ChainId)
+		}
+		txBytes, err := utils.CalcUnsignedTxBytes(tx)
+		if err != nil {
+			return nil, err
+		}
+		if len(refiner.RefineEndorsements([]*commonPb.EndorsementEntry{tx.Sender}, txBytes)) == 0 {
+			return nil, fmt.Errorf("sender of tx[%s] is not verified", tx.Payload.TxId)
+		}
+		member, err := ac.NewMember(tx.Sender.Signer)
+		if err != nil {
+			return nil, err
+		}
+		store, err := chainMakerServer.GetStore(tx.Payload.ChainId)
+		if err != nil {
+			return nil, err
+		}
+		publicKeyPEM, err := publicKeyPEMFromMember(tx.Sender.Signer, store)
+		if err != nil {
+			return nil, err
+		}
+		addr, _, err := ac.GetAddressFromCache(publicKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		return &txSender{orgId: member.GetOrgId(), address: addr}, nil
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"chainmaker.org/chainmaker/localconf/v2"
+	pbac "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const sendRequestMethod = "/api.RpcNode/SendRequest"
+
+func newTestTxRequest(txType commonPb.TxType, contractName, orgId string) *commonPb.TxRequest {
+	return &commonPb.TxRequest{
+		Payload: &commonPb.Payload{ChainId: "chain1", TxType: txType, ContractName: contractName},
+		Sender:  &commonPb.EndorsementEntry{Signer: &pbac.Member{OrgId: orgId}},
+	}
+}
+
+func TestParseRateLimitRules_Malformed(t *testing.T) {
+	for _, rules := range []string{
+		`not json`,
+		`[null]`,
+		`[{"per": "contract"}]`,
+		`[{"tx_type": "NOT_A_TX_TYPE"}]`,
+		`[{"token_per_second": -1}]`,
+	} {
+		_, err := parseRateLimitRules([]byte(rules))
+		require.Error(t, err, rules)
+	}
+
+	rules, err := parseRateLimitRules([]byte(`[{"method": "SendRequest"}]`))
+	require.NoError(t, err)
+	require.Equal(t, rateLimitDefaultTokenPerSecond, rules[0].TokenPerSecond)
+	require.Equal(t, rateLimitDefaultTokenBucketSize, rules[0].TokenBucketSize)
+}
+
+func TestInitRateLimitRules(t *testing.T) {
+	oldFile := localconf.ConfigFilepath
+	defer func() {
+		localconf.ConfigFilepath = oldFile
+		rateLimitRules.Store([]*rateLimitRule(nil))
+	}()
+
+	file := filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`rpc:
+  ratelimit:
+    enabled: false
+    rules:
+      - tx_type: QUERY_CONTRACT
+        token_per_second: 500
+      - method: SendRequest
+        per: org_id
+`), 0600))
+	localconf.ConfigFilepath = file
+	require.NoError(t, initRateLimitRules())
+	rules := currentRateLimitRules()
+	require.Len(t, rules, 2)
+	require.Equal(t, "QUERY_CONTRACT", rules[0].TxType)
+	require.Equal(t, 500, rules[0].TokenPerSecond)
+	require.Equal(t, rateLimitDefaultTokenBucketSize, rules[0].TokenBucketSize)
+	require.Equal(t, rateLimitPerOrgId, rules[1].Per)
+
+	// an invalid rule keeps the rules in effect
+	file = filepath.Join(t.TempDir(), "chainmaker.yml")
+	require.NoError(t, ioutil.WriteFile(file, []byte("rpc:\n  ratelimit:\n    rules:\n      - per: contract\n"),
+		0600))
+	localconf.ConfigFilepath = file
+	require.Error(t, initRateLimitRules())
+	require.Equal(t, rules, currentRateLimitRules())
+}
+
+// testSenderFn verifies the signers of the org ids ending with "-verified" only
+func testSenderFn(tx *commonPb.Transaction) (*txSender, error) {
+	orgId := tx.Sender.Signer.OrgId
+	if !strings.HasSuffix(orgId, "-verified") {
+		return nil, errors.New("invalid signature")
+	}
+	return &txSender{orgId: orgId, address: orgId + "-addr"}, nil
+}
+
+func TestCheckRateLimitRules(t *testing.T) {
+	defer rateLimitRules.Store([]*rateLimitRule(nil))
+
+	rest, err := updateDebugConfig([]*configPb.ConfigKeyValue{
+		{Key: "IsExtreme", Value: "true"},
+		{Key: RateLimitRulesKey, Value: `[
+			{"tx_type": "QUERY_CONTRACT", "token_per_second": 1, "token_bucket_size": 1},
+			{"method": "SendRequest", "tx_type": "INVOKE_CONTRACT", "per": "org_id",
+			 "token_per_second": 1, "token_bucket_size": 2}]`},
+	}, prepareRateLimitRules)
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+
+	check := func(ctx context.Context, req *commonPb.TxRequest) error {
+		return checkRateLimitRules(newRateLimitRequest(ctx, sendRequestMethod, req, testSenderFn))
+	}
+	ctx := peerContext("10.1.2.3:12301", nil)
+
+	query := newTestTxRequest(commonPb.TxType_QUERY_CONTRACT, "contract1", "org1-verified")
+	require.NoError(t, check(ctx, query))
+	err = check(ctx, query)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	require.Greater(t, details[0].(*errdetails.RetryInfo).RetryDelay.AsDuration().Nanoseconds(), int64(0))
+
+	// queries exhausting their bucket do not starve invokes, and every verified org has a bucket of its own
+	invoke := newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org1-verified")
+	require.NoError(t, check(ctx, invoke))
+	require.NoError(t, check(ctx, invoke))
+	require.Error(t, check(ctx, invoke))
+	require.NoError(t, check(ctx, newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org2-verified")))
+
+	// a signer which is not verified takes a token from the bucket of its client ip, claiming another org gives no
+	// new bucket, and the bucket of the org claimed is not drained
+	spoofed := newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org3")
+	require.NoError(t, check(ctx, spoofed))
+	require.NoError(t, check(ctx, spoofed))
+	require.Error(t, check(ctx, newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org4")))
+	require.NoError(t, check(peerContext("10.1.2.4:12301", nil), spoofed))
+	require.NoError(t, check(ctx, newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", "org3-verified")))
+
+	// requests which are not txs only match rules without tx conditions
+	require.NoError(t, checkRateLimitRules(newRateLimitRequest(context.Background(), sendRequestMethod,
+		&configPb.ChainMakerVersionRequest{}, nil)))
+}
+
+func TestCheckRateLimitRules_Sender(t *testing.T) {
+	defer rateLimitRules.Store([]*rateLimitRule(nil))
+
+	_, err := updateDebugConfig([]*configPb.ConfigKeyValue{{Key: RateLimitRulesKey,
+		Value: `[{"sender": "addr1", "token_per_second": 1, "token_bucket_size": 1}]`}}, prepareRateLimitRules)
+	require.NoError(t, err)
+
+	var verified int
+	senderFn := func(tx *commonPb.Transaction) (*txSender, error) {
+		verified++
+		return testSenderFn(tx)
+	}
+	check := func(orgId string) error {
+		req := newTestTxRequest(commonPb.TxType_INVOKE_CONTRACT, "contract1", orgId)
+		return checkRateLimitRules(newRateLimitRequest(context.Background(), sendRequestMethod, req, senderFn))
+	}
+
+	require.NoError(t, check("org1-verified"))
+	require.NoError(t, check("org1-verified"))
+	require.Equal(t, 2, verified)
+
+	_, err = updateDebugConfig([]*configPb.ConfigKeyValue{{Key: RateLimitRulesKey,
+		Value: `[{"sender": "org1-verified-addr", "token_per_second": 1, "token_bucket_size": 1},
+			{"org_id": "org2-verified", "token_per_second": 1, "token_bucket_size": 1}]`}}, prepareRateLimitRules)
+	require.NoError(t, err)
+	require.NoError(t, check("org1-verified"))
+	require.Error(t, check("org1-verified"))
+	require.NoError(t, check("org2-verified"))
+	require.Error(t, check("org2-verified"))
+	// the conditions on the sender never match a signer which is not verified
+	require.NoError(t, check("org2"))
+	require.NoError(t, check("org3-verified"))
+}
+
+func TestRateLimitRuleBuckets(t *testing.T) {
+	rule := &rateLimitRule{TokenPerSecond: 10, TokenBucketSize: 580}
+	now := time.Now()
+	rule.bucket("10.1.2.3", now)
+	rule.bucket("10.1.2.4", now.Add(5*time.Second))
+	require.Len(t, rule.buckets, 2)
+
+	// the bucket idle for longer than its refill time is dropped at the next sweep
+	rule.bucket("10.1.2.5", now.Add(rateLimitSweepInterval+time.Second))
+	require.Len(t, rule.buckets, 2)
+	require.NotContains(t, rule.buckets, "10.1.2.3")
+
+	// the identities beyond the bound share one bucket
+	for i := len(rule.buckets); i < maxRateLimitBuckets; i++ {
+		rule.bucket(fmt.Sprintf("addr%d", i), now)
+	}
+	require.Len(t, rule.buckets, maxRateLimitBuckets)
+	overflow := rule.bucket("10.9.9.9", now)
+	require.Same(t, overflow, rule.bucket("10.9.9.8", now))
+	require.Len(t, rule.buckets, maxRateLimitBuckets+1)
+	require.Contains(t, rule.buckets, rateLimitOverflowKey)
+}
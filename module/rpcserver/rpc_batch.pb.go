@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpc_batch.proto
+
+package rpcserver
+
+import (
+	common "chainmaker.org/chainmaker/pb-go/v2/common"
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("rpc_batch.proto", fileDescriptor_bc440744a119f7bc) }
+
+var fileDescriptor_bc440744a119f7bc = []byte{
+	// 203 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2f, 0x2a, 0x48, 0x8e,
+	0x4f, 0x4a, 0x2c, 0x49, 0xce, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8,
+	0x94, 0x12, 0x49, 0xce, 0xcf, 0xcd, 0xcd, 0xcf, 0xd3, 0x2f, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e,
+	0x81, 0x48, 0x49, 0x09, 0xc3, 0x45, 0x8b, 0x4b, 0x73, 0xa0, 0x82, 0x46, 0x13, 0x18, 0xb9, 0x38,
+	0x82, 0x0a, 0x92, 0x9d, 0x40, 0x46, 0x08, 0xd9, 0x73, 0x09, 0x04, 0xa7, 0xe6, 0xa5, 0x04, 0x41,
+	0xb4, 0x41, 0xc4, 0x04, 0xf5, 0x20, 0xda, 0xf4, 0x42, 0x2a, 0xa0, 0xe2, 0x52, 0x42, 0xc8, 0x42,
+	0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0x0c, 0x1a, 0x8c, 0x06, 0x8c, 0x42, 0xce, 0x5c, 0x22,
+	0xe8, 0x06, 0x04, 0x57, 0xe6, 0x25, 0x93, 0x64, 0x88, 0x93, 0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17,
+	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
+	0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0x26, 0x67, 0x24, 0x66, 0xe6, 0xe5, 0x26, 0x66, 0xa7, 0x16,
+	0xe9, 0xe5, 0x17, 0xa5, 0xeb, 0x23, 0xb8, 0xba, 0xe9, 0xf9, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39,
+	0xa9, 0xfa, 0x45, 0x05, 0xc9, 0xc5, 0xa9, 0x45, 0x65, 0xa9, 0x45, 0x49, 0x6c, 0x60, 0x2f, 0x1a,
+	0x03, 0x06, 0x00, 0x46, 0x5a, 0xfc, 0xd7, 0x25, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RpcBatchClient is the client API for RpcBatch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RpcBatchClient interface {
+	// the client streams the TxRequests of INVOKE_CONTRACT and closes its side when done, the server streams
+	// one TxResponse per tx in the order of the requests, a chunk at a time as the requests arrive. A tx
+	// rejected by the rate limit rules gets a response with the rejection, the other txs of the batch go on.
+	SendRequestBatch(ctx context.Context, opts ...grpc.CallOption) (RpcBatch_SendRequestBatchClient, error)
+	// like SendRequestBatch, but each TxResponse carries the result of the tx like SendRequestSync
+	SendRequestBatchSync(ctx context.Context, opts ...grpc.CallOption) (RpcBatch_SendRequestBatchSyncClient, error)
+}
+
+type rpcBatchClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRpcBatchClient(cc *grpc.ClientConn) RpcBatchClient {
+	return &rpcBatchClient{cc}
+}
+
+func (c *rpcBatchClient) SendRequestBatch(ctx context.Context, opts ...grpc.CallOption) (RpcBatch_SendRequestBatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcBatch_serviceDesc.Streams[0], "/api.RpcBatch/SendRequestBatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcBatchSendRequestBatchClient{stream}
+	return x, nil
+}
+
+type RpcBatch_SendRequestBatchClient interface {
+	Send(*common.TxRequest) error
+	Recv() (*common.TxResponse, error)
+	grpc.ClientStream
+}
+
+type rpcBatchSendRequestBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcBatchSendRequestBatchClient) Send(m *common.TxRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rpcBatchSendRequestBatchClient) Recv() (*common.TxResponse, error) {
+	m := new(common.TxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcBatchClient) SendRequestBatchSync(ctx context.Context, opts ...grpc.CallOption) (RpcBatch_SendRequestBatchSyncClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcBatch_serviceDesc.Streams[1], "/api.RpcBatch/SendRequestBatchSync", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcBatchSendRequestBatchSyncClient{stream}
+	return x, nil
+}
+
+type RpcBatch_SendRequestBatchSyncClient interface {
+	Send(*common.TxRequest) error
+	Recv() (*common.TxResponse, error)
+	grpc.ClientStream
+}
+
+type rpcBatchSendRequestBatchSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcBatchSendRequestBatchSyncClient) Send(m *common.TxRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rpcBatchSendRequestBatchSyncClient) Recv() (*common.TxResponse, error) {
+	m := new(common.TxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RpcBatchServer is the server API for RpcBatch service.
+type RpcBatchServer interface {
+	// the client streams the TxRequests of INVOKE_CONTRACT and closes its side when done, the server streams
+	// one TxResponse per tx in the order of the requests, a chunk at a time as the requests arrive. A tx
+	// rejected by the rate limit rules gets a response with the rejection, the other txs of the batch go on.
+	SendRequestBatch(RpcBatch_SendRequestBatchServer) error
+	// like SendRequestBatch, but each TxResponse carries the result of the tx like SendRequestSync
+	SendRequestBatchSync(RpcBatch_SendRequestBatchSyncServer) error
+}
+
+// UnimplementedRpcBatchServer can be embedded to have forward compatible implementations.
+type UnimplementedRpcBatchServer struct {
+}
+
+func (*UnimplementedRpcBatchServer) SendRequestBatch(srv RpcBatch_SendRequestBatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendRequestBatch not implemented")
+}
+func (*UnimplementedRpcBatchServer) SendRequestBatchSync(srv RpcBatch_SendRequestBatchSyncServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendRequestBatchSync not implemented")
+}
+
+func RegisterRpcBatchServer(s *grpc.Server, srv RpcBatchServer) {
+	s.RegisterService(&_RpcBatch_serviceDesc, srv)
+}
+
+func _RpcBatch_SendRequestBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RpcBatchServer).SendRequestBatch(&rpcBatchSendRequestBatchServer{stream})
+}
+
+type RpcBatch_SendRequestBatchServer interface {
+	Send(*common.TxResponse) error
+	Recv() (*common.TxRequest, error)
+	grpc.ServerStream
+}
+
+type rpcBatchSendRequestBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcBatchSendRequestBatchServer) Send(m *common.TxResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rpcBatchSendRequestBatchServer) Recv() (*common.TxRequest, error) {
+	m := new(common.TxRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _RpcBatch_SendRequestBatchSync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RpcBatchServer).SendRequestBatchSync(&rpcBatchSendRequestBatchSyncServer{stream})
+}
+
+type RpcBatch_SendRequestBatchSyncServer interface {
+	Send(*common.TxResponse) error
+	Recv() (*common.TxRequest, error)
+	grpc.ServerStream
+}
+
+type rpcBatchSendRequestBatchSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcBatchSendRequestBatchSyncServer) Send(m *common.TxResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rpcBatchSendRequestBatchSyncServer) Recv() (*common.TxRequest, error) {
+	m := new(common.TxRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _RpcBatch_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.RpcBatch",
+	HandlerType: (*RpcBatchServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SendRequestBatch",
+			Handler:       _RpcBatch_SendRequestBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SendRequestBatchSync",
+			Handler:       _RpcBatch_SendRequestBatchSync_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "rpc_batch.proto",
+}
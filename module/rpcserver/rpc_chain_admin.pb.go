@@ -0,0 +1,9617 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpc_chain_admin.proto
+
+package rpcserver
+
+import (
+	accesscontrol "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	sync "chainmaker.org/chainmaker/pb-go/v2/sync"
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChainAdminRequest the request of the methods which take a chain only
+type ChainAdminRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *ChainAdminRequest) Reset()         { *m = ChainAdminRequest{} }
+func (m *ChainAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*ChainAdminRequest) ProtoMessage()    {}
+func (*ChainAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{0}
+}
+func (m *ChainAdminRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainAdminRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainAdminRequest.Merge(m, src)
+}
+func (m *ChainAdminRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainAdminRequest proto.InternalMessageInfo
+
+func (m *ChainAdminRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+type JoinChainRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the genesis bundle of the chain to join, see blockchain.ExtractGenesisBundle
+	Genesis []byte `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+}
+
+func (m *JoinChainRequest) Reset()         { *m = JoinChainRequest{} }
+func (m *JoinChainRequest) String() string { return proto.CompactTextString(m) }
+func (*JoinChainRequest) ProtoMessage()    {}
+func (*JoinChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{1}
+}
+func (m *JoinChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinChainRequest.Merge(m, src)
+}
+func (m *JoinChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinChainRequest proto.InternalMessageInfo
+
+func (m *JoinChainRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *JoinChainRequest) GetGenesis() []byte {
+	if m != nil {
+		return m.Genesis
+	}
+	return nil
+}
+
+type LeaveChainRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// archive the data of the chain for restore-dbs before it is removed
+	Archive bool `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (m *LeaveChainRequest) Reset()         { *m = LeaveChainRequest{} }
+func (m *LeaveChainRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaveChainRequest) ProtoMessage()    {}
+func (*LeaveChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{2}
+}
+func (m *LeaveChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaveChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaveChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaveChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaveChainRequest.Merge(m, src)
+}
+func (m *LeaveChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaveChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaveChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaveChainRequest proto.InternalMessageInfo
+
+func (m *LeaveChainRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *LeaveChainRequest) GetArchive() bool {
+	if m != nil {
+		return m.Archive
+	}
+	return false
+}
+
+// ChainStatus the state of a chain and the result of the last lifecycle operation on it, see blockchain.ChainStatus
+type ChainStatus struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// RUNNING, PAUSED, LEAVING, LEFT or FAILED
+	State   string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Height  uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// the manifest file of the archived data of a left chain, for restore-dbs
+	Archive    string `protobuf:"bytes,5,opt,name=archive,proto3" json:"archive,omitempty"`
+	UpdateTime string `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+}
+
+func (m *ChainStatus) Reset()         { *m = ChainStatus{} }
+func (m *ChainStatus) String() string { return proto.CompactTextString(m) }
+func (*ChainStatus) ProtoMessage()    {}
+func (*ChainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{3}
+}
+func (m *ChainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainStatus.Merge(m, src)
+}
+func (m *ChainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainStatus proto.InternalMessageInfo
+
+func (m *ChainStatus) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ChainStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ChainStatus) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ChainStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChainStatus) GetArchive() string {
+	if m != nil {
+		return m.Archive
+	}
+	return ""
+}
+
+func (m *ChainStatus) GetUpdateTime() string {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return ""
+}
+
+type ReloadConfigRequest struct {
+}
+
+func (m *ReloadConfigRequest) Reset()         { *m = ReloadConfigRequest{} }
+func (m *ReloadConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigRequest) ProtoMessage()    {}
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{4}
+}
+func (m *ReloadConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReloadConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReloadConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReloadConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigRequest.Merge(m, src)
+}
+func (m *ReloadConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReloadConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigRequest proto.InternalMessageInfo
+
+// ReloadConfigReport the result of a reload, the keys are the paths of the yml keys joined by dots
+type ReloadConfigReport struct {
+	Applied     []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	NeedRestart []string `protobuf:"bytes,2,rep,name=need_restart,json=needRestart,proto3" json:"need_restart,omitempty"`
+	// the error of each key whose module failed to apply it, the key is left unchanged
+	Failed map[string]string `protobuf:"bytes,3,rep,name=failed,proto3" json:"failed,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *ReloadConfigReport) Reset()         { *m = ReloadConfigReport{} }
+func (m *ReloadConfigReport) String() string { return proto.CompactTextString(m) }
+func (*ReloadConfigReport) ProtoMessage()    {}
+func (*ReloadConfigReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{5}
+}
+func (m *ReloadConfigReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReloadConfigReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReloadConfigReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReloadConfigReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReloadConfigReport.Merge(m, src)
+}
+func (m *ReloadConfigReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReloadConfigReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReloadConfigReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReloadConfigReport proto.InternalMessageInfo
+
+func (m *ReloadConfigReport) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ReloadConfigReport) GetNeedRestart() []string {
+	if m != nil {
+		return m.NeedRestart
+	}
+	return nil
+}
+
+func (m *ReloadConfigReport) GetFailed() map[string]string {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type QuarantineRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// limit the call to the blocks at the height, 0 for all the blocks
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuarantineRequest) Reset()         { *m = QuarantineRequest{} }
+func (m *QuarantineRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantineRequest) ProtoMessage()    {}
+func (*QuarantineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{6}
+}
+func (m *QuarantineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineRequest.Merge(m, src)
+}
+func (m *QuarantineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantineRequest proto.InternalMessageInfo
+
+func (m *QuarantineRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QuarantineRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QuarantinedBlock a block rejected during sync, kept for forensic analysis
+type QuarantinedBlock struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the hash claimed by the header in hex, a tampered block may carry the hash of the genuine one
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// the sha256 of raw in hex, which tells the quarantined blocks apart
+	Digest string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	// the peer the block is received from
+	From   string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the unix time of the first rejection, and how many times the block is rejected
+	Time     int64 `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	Rejected int64 `protobuf:"varint,7,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// the marshaled common.BlockInfo, exported only
+	Raw []byte `protobuf:"bytes,8,opt,name=raw,proto3" json:"raw,omitempty"`
+}
+
+func (m *QuarantinedBlock) Reset()         { *m = QuarantinedBlock{} }
+func (m *QuarantinedBlock) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedBlock) ProtoMessage()    {}
+func (*QuarantinedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{7}
+}
+func (m *QuarantinedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedBlock.Merge(m, src)
+}
+func (m *QuarantinedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedBlock proto.InternalMessageInfo
+
+func (m *QuarantinedBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QuarantinedBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *QuarantinedBlock) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *QuarantinedBlock) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *QuarantinedBlock) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *QuarantinedBlock) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *QuarantinedBlock) GetRejected() int64 {
+	if m != nil {
+		return m.Rejected
+	}
+	return 0
+}
+
+func (m *QuarantinedBlock) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
+}
+
+// BannedPeer a peer from which no blocks are synced until the ban expires
+type BannedPeer struct {
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// the unix time the ban expires
+	Until    int64 `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	Offenses int64 `protobuf:"varint,3,opt,name=offenses,proto3" json:"offenses,omitempty"`
+}
+
+func (m *BannedPeer) Reset()         { *m = BannedPeer{} }
+func (m *BannedPeer) String() string { return proto.CompactTextString(m) }
+func (*BannedPeer) ProtoMessage()    {}
+func (*BannedPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{8}
+}
+func (m *BannedPeer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BannedPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BannedPeer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BannedPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeer.Merge(m, src)
+}
+func (m *BannedPeer) XXX_Size() int {
+	return m.Size()
+}
+func (m *BannedPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BannedPeer proto.InternalMessageInfo
+
+func (m *BannedPeer) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *BannedPeer) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *BannedPeer) GetOffenses() int64 {
+	if m != nil {
+		return m.Offenses
+	}
+	return 0
+}
+
+type QuarantineList struct {
+	Blocks []*QuarantinedBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// listed only
+	BannedPeers []*BannedPeer `protobuf:"bytes,2,rep,name=banned_peers,json=bannedPeers,proto3" json:"banned_peers,omitempty"`
+}
+
+func (m *QuarantineList) Reset()         { *m = QuarantineList{} }
+func (m *QuarantineList) String() string { return proto.CompactTextString(m) }
+func (*QuarantineList) ProtoMessage()    {}
+func (*QuarantineList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{9}
+}
+func (m *QuarantineList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantineList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineList.Merge(m, src)
+}
+func (m *QuarantineList) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantineList proto.InternalMessageInfo
+
+func (m *QuarantineList) GetBlocks() []*QuarantinedBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *QuarantineList) GetBannedPeers() []*BannedPeer {
+	if m != nil {
+		return m.BannedPeers
+	}
+	return nil
+}
+
+type QuarantineCleared struct {
+	Removed int64 `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (m *QuarantineCleared) Reset()         { *m = QuarantineCleared{} }
+func (m *QuarantineCleared) String() string { return proto.CompactTextString(m) }
+func (*QuarantineCleared) ProtoMessage()    {}
+func (*QuarantineCleared) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{10}
+}
+func (m *QuarantineCleared) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineCleared) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineCleared.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantineCleared) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineCleared.Merge(m, src)
+}
+func (m *QuarantineCleared) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineCleared) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineCleared.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantineCleared proto.InternalMessageInfo
+
+func (m *QuarantineCleared) GetRemoved() int64 {
+	if m != nil {
+		return m.Removed
+	}
+	return 0
+}
+
+type ExplainAuthorizationRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the resource whose endorsement policy is resolved, that of tx if it is empty
+	ResourceName string `protobuf:"bytes,2,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// the marshaled common.Transaction, unsigned or not, whose sender and endorsers are judged along with the
+	// endorsements
+	Tx []byte `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
+	// the marshaled common.EndorsementEntry of the candidate endorsements
+	Endorsements [][]byte `protobuf:"bytes,4,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	// the message the endorsements sign, the unsigned bytes of tx if it is empty, the signatures are not verified
+	// without both
+	Message []byte `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// the org owning the target of the SELF rule, that of the ORG_ID parameter of tx if it is empty
+	TargetOrgId string `protobuf:"bytes,6,opt,name=target_org_id,json=targetOrgId,proto3" json:"target_org_id,omitempty"`
+}
+
+func (m *ExplainAuthorizationRequest) Reset()         { *m = ExplainAuthorizationRequest{} }
+func (m *ExplainAuthorizationRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainAuthorizationRequest) ProtoMessage()    {}
+func (*ExplainAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{11}
+}
+func (m *ExplainAuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainAuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainAuthorizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExplainAuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainAuthorizationRequest.Merge(m, src)
+}
+func (m *ExplainAuthorizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainAuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainAuthorizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainAuthorizationRequest proto.InternalMessageInfo
+
+func (m *ExplainAuthorizationRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *ExplainAuthorizationRequest) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationRequest) GetEndorsements() [][]byte {
+	if m != nil {
+		return m.Endorsements
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *ExplainAuthorizationRequest) GetTargetOrgId() string {
+	if m != nil {
+		return m.TargetOrgId
+	}
+	return ""
+}
+
+// EndorsementVerdict whether an endorsement is counted by the policy, and why not if it is not
+type EndorsementVerdict struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// the organization the signer claims, and the member id and the role of the signer if it is resolved
+	OrgId    string `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	MemberId string `protobuf:"bytes,3,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role     string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Counted  bool   `protobuf:"varint,5,opt,name=counted,proto3" json:"counted,omitempty"`
+	Reason   string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EndorsementVerdict) Reset()         { *m = EndorsementVerdict{} }
+func (m *EndorsementVerdict) String() string { return proto.CompactTextString(m) }
+func (*EndorsementVerdict) ProtoMessage()    {}
+func (*EndorsementVerdict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{12}
+}
+func (m *EndorsementVerdict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndorsementVerdict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndorsementVerdict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndorsementVerdict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndorsementVerdict.Merge(m, src)
+}
+func (m *EndorsementVerdict) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndorsementVerdict) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndorsementVerdict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndorsementVerdict proto.InternalMessageInfo
+
+func (m *EndorsementVerdict) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EndorsementVerdict) GetOrgId() string {
+	if m != nil {
+		return m.OrgId
+	}
+	return ""
+}
+
+func (m *EndorsementVerdict) GetMemberId() string {
+	if m != nil {
+		return m.MemberId
+	}
+	return ""
+}
+
+func (m *EndorsementVerdict) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *EndorsementVerdict) GetCounted() bool {
+	if m != nil {
+		return m.Counted
+	}
+	return false
+}
+
+func (m *EndorsementVerdict) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// AuthorizationExplanation the endorsement policy resolved for a resource, the verdict of each endorsement and the
+// overall decision
+type AuthorizationExplanation struct {
+	ResourceName string `protobuf:"bytes,1,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	// absent if the resource has no endorsement policy, which allows any endorsements
+	Policy            *accesscontrol.Policy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	SignatureVerified bool                  `protobuf:"varint,3,opt,name=signature_verified,json=signatureVerified,proto3" json:"signature_verified,omitempty"`
+	Endorsements      []*EndorsementVerdict `protobuf:"bytes,4,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	Allowed           bool                  `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// why the endorsements are rejected, or why they are allowed without being judged
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *AuthorizationExplanation) Reset()         { *m = AuthorizationExplanation{} }
+func (m *AuthorizationExplanation) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationExplanation) ProtoMessage()    {}
+func (*AuthorizationExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{13}
+}
+func (m *AuthorizationExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationExplanation.Merge(m, src)
+}
+func (m *AuthorizationExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationExplanation proto.InternalMessageInfo
+
+func (m *AuthorizationExplanation) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *AuthorizationExplanation) GetPolicy() *accesscontrol.Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (m *AuthorizationExplanation) GetSignatureVerified() bool {
+	if m != nil {
+		return m.SignatureVerified
+	}
+	return false
+}
+
+func (m *AuthorizationExplanation) GetEndorsements() []*EndorsementVerdict {
+	if m != nil {
+		return m.Endorsements
+	}
+	return nil
+}
+
+func (m *AuthorizationExplanation) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *AuthorizationExplanation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// RevocationList the status of the CRL of a CA
+type RevocationList struct {
+	// the authority key identifier in hex
+	Aki    string `protobuf:"bytes,1,opt,name=aki,proto3" json:"aki,omitempty"`
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Count  int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// the unix times of thisUpdate and nextUpdate, next_update is 0 if the CRL does not tell
+	ThisUpdate int64 `protobuf:"varint,4,opt,name=this_update,json=thisUpdate,proto3" json:"this_update,omitempty"`
+	NextUpdate int64 `protobuf:"varint,5,opt,name=next_update,json=nextUpdate,proto3" json:"next_update,omitempty"`
+	Expired    bool  `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *RevocationList) Reset()         { *m = RevocationList{} }
+func (m *RevocationList) String() string { return proto.CompactTextString(m) }
+func (*RevocationList) ProtoMessage()    {}
+func (*RevocationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{14}
+}
+func (m *RevocationList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocationList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationList.Merge(m, src)
+}
+func (m *RevocationList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationList proto.InternalMessageInfo
+
+func (m *RevocationList) GetAki() string {
+	if m != nil {
+		return m.Aki
+	}
+	return ""
+}
+
+func (m *RevocationList) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *RevocationList) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RevocationList) GetThisUpdate() int64 {
+	if m != nil {
+		return m.ThisUpdate
+	}
+	return 0
+}
+
+func (m *RevocationList) GetNextUpdate() int64 {
+	if m != nil {
+		return m.NextUpdate
+	}
+	return 0
+}
+
+func (m *RevocationList) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+type RevocationLists struct {
+	// the policy applied to the expired CRLs, see CRLExpiryPolicyKey
+	ExpiryPolicy string            `protobuf:"bytes,1,opt,name=expiry_policy,json=expiryPolicy,proto3" json:"expiry_policy,omitempty"`
+	Lists        []*RevocationList `protobuf:"bytes,2,rep,name=lists,proto3" json:"lists,omitempty"`
+}
+
+func (m *RevocationLists) Reset()         { *m = RevocationLists{} }
+func (m *RevocationLists) String() string { return proto.CompactTextString(m) }
+func (*RevocationLists) ProtoMessage()    {}
+func (*RevocationLists) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{15}
+}
+func (m *RevocationLists) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevocationLists) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevocationLists.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevocationLists) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevocationLists.Merge(m, src)
+}
+func (m *RevocationLists) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevocationLists) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevocationLists.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevocationLists proto.InternalMessageInfo
+
+func (m *RevocationLists) GetExpiryPolicy() string {
+	if m != nil {
+		return m.ExpiryPolicy
+	}
+	return ""
+}
+
+func (m *RevocationLists) GetLists() []*RevocationList {
+	if m != nil {
+		return m.Lists
+	}
+	return nil
+}
+
+type AnalyzeBlockDAGRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// json or dot, dot returns the graphviz dot of the DAG only
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (m *AnalyzeBlockDAGRequest) Reset()         { *m = AnalyzeBlockDAGRequest{} }
+func (m *AnalyzeBlockDAGRequest) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeBlockDAGRequest) ProtoMessage()    {}
+func (*AnalyzeBlockDAGRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{16}
+}
+func (m *AnalyzeBlockDAGRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AnalyzeBlockDAGRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AnalyzeBlockDAGRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AnalyzeBlockDAGRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeBlockDAGRequest.Merge(m, src)
+}
+func (m *AnalyzeBlockDAGRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AnalyzeBlockDAGRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeBlockDAGRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzeBlockDAGRequest proto.InternalMessageInfo
+
+func (m *AnalyzeBlockDAGRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *AnalyzeBlockDAGRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AnalyzeBlockDAGRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+// DAGTx a tx in the DAG of a block
+type DAGTx struct {
+	Index        int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	TxId         string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ContractName string `protobuf:"bytes,3,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Method       string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// the depth of the tx in the DAG from 1, the txs at the same depth can be executed in parallel
+	Depth        int64   `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Dependencies []int64 `protobuf:"varint,6,rep,packed,name=dependencies,proto3" json:"dependencies,omitempty"`
+}
+
+func (m *DAGTx) Reset()         { *m = DAGTx{} }
+func (m *DAGTx) String() string { return proto.CompactTextString(m) }
+func (*DAGTx) ProtoMessage()    {}
+func (*DAGTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{17}
+}
+func (m *DAGTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DAGTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DAGTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DAGTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DAGTx.Merge(m, src)
+}
+func (m *DAGTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *DAGTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DAGTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DAGTx proto.InternalMessageInfo
+
+func (m *DAGTx) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DAGTx) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *DAGTx) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *DAGTx) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *DAGTx) GetDepth() int64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *DAGTx) GetDependencies() []int64 {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+// ConflictKey a key on which two txs conflict, the key is in hex with the prefix 0x if it is not printable
+type ConflictKey struct {
+	ContractName string `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *ConflictKey) Reset()         { *m = ConflictKey{} }
+func (m *ConflictKey) String() string { return proto.CompactTextString(m) }
+func (*ConflictKey) ProtoMessage()    {}
+func (*ConflictKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{18}
+}
+func (m *ConflictKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictKey.Merge(m, src)
+}
+func (m *ConflictKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictKey proto.InternalMessageInfo
+
+func (m *ConflictKey) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *ConflictKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ConflictKey) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// TxConflict the keys on which a tx conflicts with an earlier tx of the block
+type TxConflict struct {
+	From int64          `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   int64          `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Keys []*ConflictKey `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *TxConflict) Reset()         { *m = TxConflict{} }
+func (m *TxConflict) String() string { return proto.CompactTextString(m) }
+func (*TxConflict) ProtoMessage()    {}
+func (*TxConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{19}
+}
+func (m *TxConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxConflict.Merge(m, src)
+}
+func (m *TxConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxConflict proto.InternalMessageInfo
+
+func (m *TxConflict) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *TxConflict) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *TxConflict) GetKeys() []*ConflictKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// BlockDAGAnalysis the dependencies between the txs of a block and the conflicts causing them, see
+// scheduler.DAGAnalysis
+type BlockDAGAnalysis struct {
+	BlockHeight uint64   `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TxCount     int64    `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Txs         []*DAGTx `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+	// true if the block has no DAG matching its txs, and the dependencies are rebuilt from the conflicts
+	DagRebuilt bool          `protobuf:"varint,4,opt,name=dag_rebuilt,json=dagRebuilt,proto3" json:"dag_rebuilt,omitempty"`
+	Conflicts  []*TxConflict `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// the number of the txs on the longest dependency chain, and the indexes of its txs from the first executed
+	CriticalPathLength int64   `protobuf:"varint,6,opt,name=critical_path_length,json=criticalPathLength,proto3" json:"critical_path_length,omitempty"`
+	CriticalPath       []int64 `protobuf:"varint,7,rep,packed,name=critical_path,json=criticalPath,proto3" json:"critical_path,omitempty"`
+	// the max number of the txs at the same depth of the DAG
+	ParallelismWidth   int64   `protobuf:"varint,8,opt,name=parallelism_width,json=parallelismWidth,proto3" json:"parallelism_width,omitempty"`
+	ConflictTxCount    int64   `protobuf:"varint,9,opt,name=conflict_tx_count,json=conflictTxCount,proto3" json:"conflict_tx_count,omitempty"`
+	ConflictRate       float64 `protobuf:"fixed64,10,opt,name=conflict_rate,json=conflictRate,proto3" json:"conflict_rate,omitempty"`
+	WindowConflictRate float64 `protobuf:"fixed64,11,opt,name=window_conflict_rate,json=windowConflictRate,proto3" json:"window_conflict_rate,omitempty"`
+	// the graphviz dot of the DAG, the only field set if the format is dot
+	Dot string `protobuf:"bytes,12,opt,name=dot,proto3" json:"dot,omitempty"`
+}
+
+func (m *BlockDAGAnalysis) Reset()         { *m = BlockDAGAnalysis{} }
+func (m *BlockDAGAnalysis) String() string { return proto.CompactTextString(m) }
+func (*BlockDAGAnalysis) ProtoMessage()    {}
+func (*BlockDAGAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{20}
+}
+func (m *BlockDAGAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockDAGAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockDAGAnalysis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockDAGAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDAGAnalysis.Merge(m, src)
+}
+func (m *BlockDAGAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockDAGAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDAGAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDAGAnalysis proto.InternalMessageInfo
+
+func (m *BlockDAGAnalysis) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetTxs() []*DAGTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *BlockDAGAnalysis) GetDagRebuilt() bool {
+	if m != nil {
+		return m.DagRebuilt
+	}
+	return false
+}
+
+func (m *BlockDAGAnalysis) GetConflicts() []*TxConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+func (m *BlockDAGAnalysis) GetCriticalPathLength() int64 {
+	if m != nil {
+		return m.CriticalPathLength
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetCriticalPath() []int64 {
+	if m != nil {
+		return m.CriticalPath
+	}
+	return nil
+}
+
+func (m *BlockDAGAnalysis) GetParallelismWidth() int64 {
+	if m != nil {
+		return m.ParallelismWidth
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetConflictTxCount() int64 {
+	if m != nil {
+		return m.ConflictTxCount
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetConflictRate() float64 {
+	if m != nil {
+		return m.ConflictRate
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetWindowConflictRate() float64 {
+	if m != nil {
+		return m.WindowConflictRate
+	}
+	return 0
+}
+
+func (m *BlockDAGAnalysis) GetDot() string {
+	if m != nil {
+		return m.Dot
+	}
+	return ""
+}
+
+type GetHotKeysRequest struct {
+	ChainId     string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// 0 for the last block
+	EndHeight uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// the number of the keys returned, 20 if it is 0
+	Top uint64 `protobuf:"varint,4,opt,name=top,proto3" json:"top,omitempty"`
+}
+
+func (m *GetHotKeysRequest) Reset()         { *m = GetHotKeysRequest{} }
+func (m *GetHotKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHotKeysRequest) ProtoMessage()    {}
+func (*GetHotKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{21}
+}
+func (m *GetHotKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetHotKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetHotKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetHotKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHotKeysRequest.Merge(m, src)
+}
+func (m *GetHotKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetHotKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHotKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHotKeysRequest proto.InternalMessageInfo
+
+func (m *GetHotKeysRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetHotKeysRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GetHotKeysRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *GetHotKeysRequest) GetTop() uint64 {
+	if m != nil {
+		return m.Top
+	}
+	return 0
+}
+
+// HotKeyStat the accesses of a key by the txs of the blocks, the reads and the writes are counted by the txs
+type HotKeyStat struct {
+	ContractName string `protobuf:"bytes,1,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Reads        int64  `protobuf:"varint,3,opt,name=reads,proto3" json:"reads,omitempty"`
+	Writes       int64  `protobuf:"varint,4,opt,name=writes,proto3" json:"writes,omitempty"`
+	// the conflicts between the txs of the same block on the key
+	Conflicts int64 `protobuf:"varint,5,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// the blocks whose txs access the key
+	Blocks int64 `protobuf:"varint,6,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *HotKeyStat) Reset()         { *m = HotKeyStat{} }
+func (m *HotKeyStat) String() string { return proto.CompactTextString(m) }
+func (*HotKeyStat) ProtoMessage()    {}
+func (*HotKeyStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{22}
+}
+func (m *HotKeyStat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotKeyStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotKeyStat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HotKeyStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotKeyStat.Merge(m, src)
+}
+func (m *HotKeyStat) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotKeyStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotKeyStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotKeyStat proto.InternalMessageInfo
+
+func (m *HotKeyStat) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *HotKeyStat) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HotKeyStat) GetReads() int64 {
+	if m != nil {
+		return m.Reads
+	}
+	return 0
+}
+
+func (m *HotKeyStat) GetWrites() int64 {
+	if m != nil {
+		return m.Writes
+	}
+	return 0
+}
+
+func (m *HotKeyStat) GetConflicts() int64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+func (m *HotKeyStat) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// HotKeyReport the keys accessed most by the txs of the blocks of a height range
+type HotKeyReport struct {
+	StartHeight     uint64        `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight       uint64        `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	BlockCount      int64         `protobuf:"varint,3,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	TxCount         int64         `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	ConflictTxCount int64         `protobuf:"varint,5,opt,name=conflict_tx_count,json=conflictTxCount,proto3" json:"conflict_tx_count,omitempty"`
+	ConflictRate    float64       `protobuf:"fixed64,6,opt,name=conflict_rate,json=conflictRate,proto3" json:"conflict_rate,omitempty"`
+	Keys            []*HotKeyStat `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *HotKeyReport) Reset()         { *m = HotKeyReport{} }
+func (m *HotKeyReport) String() string { return proto.CompactTextString(m) }
+func (*HotKeyReport) ProtoMessage()    {}
+func (*HotKeyReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{23}
+}
+func (m *HotKeyReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HotKeyReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HotKeyReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HotKeyReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HotKeyReport.Merge(m, src)
+}
+func (m *HotKeyReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *HotKeyReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_HotKeyReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HotKeyReport proto.InternalMessageInfo
+
+func (m *HotKeyReport) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *HotKeyReport) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *HotKeyReport) GetBlockCount() int64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *HotKeyReport) GetTxCount() int64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *HotKeyReport) GetConflictTxCount() int64 {
+	if m != nil {
+		return m.ConflictTxCount
+	}
+	return 0
+}
+
+func (m *HotKeyReport) GetConflictRate() float64 {
+	if m != nil {
+		return m.ConflictRate
+	}
+	return 0
+}
+
+func (m *HotKeyReport) GetKeys() []*HotKeyStat {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// SyncPeerScore the score of a peer which the blocks are requested from, and the observations of its responses
+type SyncPeerScore struct {
+	NodeId        string  `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	LatencyMs     int64   `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Responses     uint64  `protobuf:"varint,4,opt,name=responses,proto3" json:"responses,omitempty"`
+	Timeouts      uint64  `protobuf:"varint,5,opt,name=timeouts,proto3" json:"timeouts,omitempty"`
+	InvalidBlocks uint64  `protobuf:"varint,6,opt,name=invalid_blocks,json=invalidBlocks,proto3" json:"invalid_blocks,omitempty"`
+	Penalty       float64 `protobuf:"fixed64,7,opt,name=penalty,proto3" json:"penalty,omitempty"`
+}
+
+func (m *SyncPeerScore) Reset()         { *m = SyncPeerScore{} }
+func (m *SyncPeerScore) String() string { return proto.CompactTextString(m) }
+func (*SyncPeerScore) ProtoMessage()    {}
+func (*SyncPeerScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{24}
+}
+func (m *SyncPeerScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPeerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncPeerScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncPeerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPeerScore.Merge(m, src)
+}
+func (m *SyncPeerScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPeerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPeerScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPeerScore proto.InternalMessageInfo
+
+func (m *SyncPeerScore) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *SyncPeerScore) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *SyncPeerScore) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *SyncPeerScore) GetResponses() uint64 {
+	if m != nil {
+		return m.Responses
+	}
+	return 0
+}
+
+func (m *SyncPeerScore) GetTimeouts() uint64 {
+	if m != nil {
+		return m.Timeouts
+	}
+	return 0
+}
+
+func (m *SyncPeerScore) GetInvalidBlocks() uint64 {
+	if m != nil {
+		return m.InvalidBlocks
+	}
+	return 0
+}
+
+func (m *SyncPeerScore) GetPenalty() float64 {
+	if m != nil {
+		return m.Penalty
+	}
+	return 0
+}
+
+// SyncPeerScores the peer select strategy in use and the scores of the peers, sorted by node id
+type SyncPeerScores struct {
+	Strategy string           `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Peers    []*SyncPeerScore `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (m *SyncPeerScores) Reset()         { *m = SyncPeerScores{} }
+func (m *SyncPeerScores) String() string { return proto.CompactTextString(m) }
+func (*SyncPeerScores) ProtoMessage()    {}
+func (*SyncPeerScores) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{25}
+}
+func (m *SyncPeerScores) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncPeerScores) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncPeerScores.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncPeerScores) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncPeerScores.Merge(m, src)
+}
+func (m *SyncPeerScores) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncPeerScores) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncPeerScores.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncPeerScores proto.InternalMessageInfo
+
+func (m *SyncPeerScores) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *SyncPeerScores) GetPeers() []*SyncPeerScore {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type GetChainSyncStateRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// list the other nodes in the state
+	WithPeers bool `protobuf:"varint,2,opt,name=with_peers,json=withPeers,proto3" json:"with_peers,omitempty"`
+}
+
+func (m *GetChainSyncStateRequest) Reset()         { *m = GetChainSyncStateRequest{} }
+func (m *GetChainSyncStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetChainSyncStateRequest) ProtoMessage()    {}
+func (*GetChainSyncStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{26}
+}
+func (m *GetChainSyncStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetChainSyncStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetChainSyncStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetChainSyncStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetChainSyncStateRequest.Merge(m, src)
+}
+func (m *GetChainSyncStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetChainSyncStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetChainSyncStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetChainSyncStateRequest proto.InternalMessageInfo
+
+func (m *GetChainSyncStateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetChainSyncStateRequest) GetWithPeers() bool {
+	if m != nil {
+		return m.WithPeers
+	}
+	return false
+}
+
+// ChainSyncState the sync state of a chain and the scores of the peers the blocks are requested from
+type ChainSyncState struct {
+	State      *sync.SyncState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	PeerScores *SyncPeerScores `protobuf:"bytes,2,opt,name=peer_scores,json=peerScores,proto3" json:"peer_scores,omitempty"`
+}
+
+func (m *ChainSyncState) Reset()         { *m = ChainSyncState{} }
+func (m *ChainSyncState) String() string { return proto.CompactTextString(m) }
+func (*ChainSyncState) ProtoMessage()    {}
+func (*ChainSyncState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c8080bb88ecf177, []int{27}
+}
+func (m *ChainSyncState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainSyncState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainSyncState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainSyncState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainSyncState.Merge(m, src)
+}
+func (m *ChainSyncState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainSyncState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainSyncState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainSyncState proto.InternalMessageInfo
+
+func (m *ChainSyncState) GetState() *sync.SyncState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ChainSyncState) GetPeerScores() *SyncPeerScores {
+	if m != nil {
+		return m.PeerScores
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ChainAdminRequest)(nil), "api.ChainAdminRequest")
+	proto.RegisterType((*JoinChainRequest)(nil), "api.JoinChainRequest")
+	proto.RegisterType((*LeaveChainRequest)(nil), "api.LeaveChainRequest")
+	proto.RegisterType((*ChainStatus)(nil), "api.ChainStatus")
+	proto.RegisterType((*ReloadConfigRequest)(nil), "api.ReloadConfigRequest")
+	proto.RegisterType((*ReloadConfigReport)(nil), "api.ReloadConfigReport")
+	proto.RegisterMapType((map[string]string)(nil), "api.ReloadConfigReport.FailedEntry")
+	proto.RegisterType((*QuarantineRequest)(nil), "api.QuarantineRequest")
+	proto.RegisterType((*QuarantinedBlock)(nil), "api.QuarantinedBlock")
+	proto.RegisterType((*BannedPeer)(nil), "api.BannedPeer")
+	proto.RegisterType((*QuarantineList)(nil), "api.QuarantineList")
+	proto.RegisterType((*QuarantineCleared)(nil), "api.QuarantineCleared")
+	proto.RegisterType((*ExplainAuthorizationRequest)(nil), "api.ExplainAuthorizationRequest")
+	proto.RegisterType((*EndorsementVerdict)(nil), "api.EndorsementVerdict")
+	proto.RegisterType((*AuthorizationExplanation)(nil), "api.AuthorizationExplanation")
+	proto.RegisterType((*RevocationList)(nil), "api.RevocationList")
+	proto.RegisterType((*RevocationLists)(nil), "api.RevocationLists")
+	proto.RegisterType((*AnalyzeBlockDAGRequest)(nil), "api.AnalyzeBlockDAGRequest")
+	proto.RegisterType((*DAGTx)(nil), "api.DAGTx")
+	proto.RegisterType((*ConflictKey)(nil), "api.ConflictKey")
+	proto.RegisterType((*TxConflict)(nil), "api.TxConflict")
+	proto.RegisterType((*BlockDAGAnalysis)(nil), "api.BlockDAGAnalysis")
+	proto.RegisterType((*GetHotKeysRequest)(nil), "api.GetHotKeysRequest")
+	proto.RegisterType((*HotKeyStat)(nil), "api.HotKeyStat")
+	proto.RegisterType((*HotKeyReport)(nil), "api.HotKeyReport")
+	proto.RegisterType((*SyncPeerScore)(nil), "api.SyncPeerScore")
+	proto.RegisterType((*SyncPeerScores)(nil), "api.SyncPeerScores")
+	proto.RegisterType((*GetChainSyncStateRequest)(nil), "api.GetChainSyncStateRequest")
+	proto.RegisterType((*ChainSyncState)(nil), "api.ChainSyncState")
+}
+
+func init() { proto.RegisterFile("rpc_chain_admin.proto", fileDescriptor_0c8080bb88ecf177) }
+
+var fileDescriptor_0c8080bb88ecf177 = []byte{
+	// 2013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5f, 0x73, 0x1c, 0x47,
+	0x11, 0xd7, 0xde, 0xea, 0x4e, 0x52, 0xdf, 0x59, 0x7f, 0xc6, 0xb2, 0x7d, 0x51, 0x62, 0x45, 0x59,
+	0x93, 0x2a, 0x01, 0x25, 0x39, 0x25, 0x28, 0xca, 0x21, 0xbc, 0xd8, 0xb2, 0x2d, 0x3b, 0x18, 0x30,
+	0x63, 0xe3, 0x00, 0x2f, 0x5b, 0xa3, 0xdd, 0xd6, 0xdd, 0xa0, 0xbd, 0x9d, 0x65, 0x66, 0x4e, 0xba,
+	0xcb, 0x63, 0x5e, 0xe1, 0x81, 0x0f, 0x41, 0x15, 0x45, 0xc1, 0xc7, 0xe0, 0x01, 0x9e, 0xc8, 0x1b,
+	0x79, 0xa4, 0xec, 0x2f, 0x42, 0xcd, 0x9f, 0xbd, 0xdd, 0x3b, 0x9d, 0x12, 0xc5, 0xbc, 0x5c, 0x4d,
+	0xf7, 0xf4, 0xf4, 0xf4, 0xf6, 0xaf, 0xbb, 0xa7, 0xfb, 0xe0, 0x86, 0x2c, 0x92, 0x38, 0xe9, 0x33,
+	0x9e, 0xc7, 0x2c, 0x1d, 0xf0, 0x7c, 0xbf, 0x90, 0x42, 0x0b, 0x12, 0xb2, 0x82, 0x6f, 0x6d, 0xb1,
+	0x24, 0x41, 0xa5, 0x12, 0x91, 0x6b, 0x29, 0xb2, 0xbb, 0x85, 0xc8, 0x78, 0x32, 0x76, 0x02, 0x5b,
+	0x6b, 0x6a, 0x9c, 0x27, 0x77, 0xcd, 0x8f, 0x63, 0x44, 0xfb, 0xb0, 0x71, 0x68, 0xd4, 0xdc, 0x37,
+	0x5a, 0x28, 0xfe, 0x7e, 0x88, 0x4a, 0x93, 0x77, 0x60, 0xd9, 0xe9, 0xe6, 0x69, 0x37, 0xd8, 0x09,
+	0x76, 0x57, 0xe8, 0x92, 0xa5, 0x9f, 0xa6, 0xd1, 0x11, 0xac, 0x7f, 0x2a, 0x78, 0x6e, 0xcf, 0x7c,
+	0xb3, 0x38, 0xe9, 0xc2, 0x52, 0x0f, 0x73, 0x54, 0x5c, 0x75, 0x1b, 0x3b, 0xc1, 0x6e, 0x87, 0x96,
+	0x64, 0xf4, 0x04, 0x36, 0x9e, 0x21, 0x3b, 0xc3, 0x6f, 0xa1, 0x89, 0xc9, 0xa4, 0xcf, 0xcf, 0xd0,
+	0x6a, 0x5a, 0xa6, 0x25, 0x19, 0xfd, 0x2d, 0x80, 0xb6, 0xd5, 0xf2, 0x42, 0x33, 0x3d, 0x54, 0x5f,
+	0xa7, 0x64, 0x13, 0x9a, 0x4a, 0x33, 0xed, 0x54, 0xac, 0x50, 0x47, 0x90, 0x9b, 0xd0, 0xea, 0x23,
+	0xef, 0xf5, 0x75, 0x37, 0xdc, 0x09, 0x76, 0x17, 0xa9, 0xa7, 0xcc, 0x95, 0x03, 0x54, 0x8a, 0xf5,
+	0xb0, 0xbb, 0xe8, 0xf4, 0x78, 0xb2, 0x6e, 0x4c, 0xd3, 0xed, 0x78, 0x92, 0xbc, 0x0f, 0xed, 0x61,
+	0x91, 0x32, 0x8d, 0xb1, 0xe6, 0x03, 0xec, 0xb6, 0xec, 0x2e, 0x38, 0xd6, 0x4b, 0x3e, 0xc0, 0xe8,
+	0x06, 0x5c, 0xa7, 0x98, 0x09, 0x96, 0x1e, 0x8a, 0xfc, 0x84, 0xf7, 0xfc, 0x97, 0x47, 0xff, 0x0a,
+	0x80, 0x4c, 0xf3, 0x0b, 0x21, 0xad, 0x09, 0xac, 0x28, 0x32, 0x8e, 0xe6, 0x53, 0x42, 0x7b, 0x91,
+	0x23, 0xc9, 0x07, 0xd0, 0xc9, 0x11, 0xd3, 0x58, 0xa2, 0xd2, 0x4c, 0xea, 0x6e, 0xc3, 0x6e, 0xb7,
+	0x0d, 0x8f, 0x3a, 0x16, 0xf9, 0x04, 0x5a, 0x27, 0x8c, 0x67, 0x98, 0x76, 0xc3, 0x9d, 0x70, 0xb7,
+	0x7d, 0x70, 0x67, 0x9f, 0x15, 0x7c, 0xff, 0xe2, 0x2d, 0xfb, 0x8f, 0xad, 0xd4, 0xa3, 0x5c, 0xcb,
+	0x31, 0xf5, 0x47, 0xb6, 0x3e, 0x86, 0x76, 0x8d, 0x4d, 0xd6, 0x21, 0x3c, 0xc5, 0xb1, 0xf7, 0xa7,
+	0x59, 0x1a, 0x5f, 0x9e, 0xb1, 0x6c, 0x38, 0xf1, 0xa5, 0x25, 0x7e, 0xdc, 0xb8, 0x17, 0x44, 0x8f,
+	0x61, 0xe3, 0x97, 0x43, 0x26, 0x59, 0xae, 0x79, 0x8e, 0x57, 0x80, 0xb6, 0xf2, 0x7f, 0xa3, 0xee,
+	0xff, 0xe8, 0x1f, 0x01, 0xac, 0x57, 0x8a, 0xd2, 0x07, 0x99, 0x48, 0x4e, 0x6b, 0xc2, 0xc1, 0x14,
+	0x58, 0x04, 0x16, 0xfb, 0x4c, 0xf5, 0xbd, 0x35, 0x76, 0x6d, 0x64, 0x53, 0xde, 0x43, 0xe5, 0x80,
+	0x5d, 0xa1, 0x9e, 0x32, 0xb2, 0x27, 0x52, 0x0c, 0x3c, 0xaa, 0x76, 0x6d, 0x64, 0x25, 0x32, 0x25,
+	0x72, 0x8f, 0xa8, 0xa7, 0x8c, 0xec, 0x04, 0xc9, 0x90, 0xda, 0x35, 0xd9, 0x82, 0x65, 0x89, 0xbf,
+	0xc3, 0x44, 0x63, 0xda, 0x5d, 0xb2, 0xfc, 0x09, 0x6d, 0x1c, 0x25, 0xd9, 0x79, 0x77, 0xd9, 0x46,
+	0xbb, 0x59, 0x46, 0x9f, 0x01, 0x3c, 0x60, 0x79, 0x8e, 0xe9, 0x73, 0x44, 0x49, 0x6e, 0xc1, 0x52,
+	0x2e, 0x52, 0xac, 0xdc, 0xd0, 0x32, 0xa4, 0x8b, 0xcd, 0x61, 0xae, 0x79, 0x66, 0xbf, 0x20, 0xa4,
+	0x8e, 0x30, 0x57, 0x89, 0x93, 0x13, 0xcc, 0x15, 0x2a, 0xfb, 0x11, 0x21, 0x9d, 0xd0, 0x91, 0x82,
+	0xd5, 0xca, 0x3d, 0xcf, 0xb8, 0xd2, 0x64, 0x0f, 0x5a, 0xc7, 0xc6, 0x4b, 0xca, 0x46, 0x4b, 0xfb,
+	0xe0, 0x86, 0x45, 0x7c, 0xd6, 0x87, 0xd4, 0x0b, 0x91, 0x03, 0xe8, 0x1c, 0x5b, 0xcb, 0xe2, 0x02,
+	0x51, 0x2a, 0x1b, 0x43, 0xed, 0x83, 0x35, 0x7b, 0xa8, 0x32, 0x99, 0xb6, 0x8f, 0x27, 0x6b, 0x15,
+	0xed, 0xd5, 0xc1, 0x3d, 0xcc, 0x90, 0x49, 0xb4, 0xc9, 0x29, 0x71, 0x20, 0xce, 0xd0, 0x7d, 0x54,
+	0x48, 0x4b, 0x32, 0xfa, 0x77, 0x00, 0xef, 0x3e, 0x1a, 0x15, 0x99, 0x29, 0x31, 0x43, 0xdd, 0x17,
+	0x92, 0x7f, 0xce, 0x34, 0x17, 0x57, 0xc9, 0xf8, 0x3b, 0x70, 0x4d, 0xa2, 0x12, 0x43, 0x99, 0x60,
+	0x9c, 0xb3, 0x41, 0x19, 0x68, 0x9d, 0x92, 0xf9, 0x73, 0x36, 0x40, 0xb2, 0x0a, 0x0d, 0x3d, 0xb2,
+	0x9e, 0xe9, 0xd0, 0x86, 0x1e, 0x91, 0x08, 0x3a, 0x98, 0xa7, 0x42, 0x2a, 0x1c, 0x60, 0xae, 0x55,
+	0x77, 0x71, 0x27, 0xdc, 0xed, 0xd0, 0x29, 0x5e, 0x3d, 0xaf, 0x9b, 0xae, 0x28, 0x79, 0x92, 0x44,
+	0x70, 0x4d, 0x33, 0xd9, 0x43, 0x1d, 0x0b, 0xd9, 0x33, 0x26, 0xb9, 0xfc, 0x6d, 0x3b, 0xe6, 0x2f,
+	0x64, 0xef, 0x69, 0x1a, 0xfd, 0x39, 0x00, 0xf2, 0xa8, 0x52, 0xf7, 0x0a, 0x65, 0xca, 0x13, 0x6d,
+	0xe0, 0xe3, 0x79, 0x8a, 0x23, 0xef, 0x00, 0x47, 0x90, 0x1b, 0xd0, 0xf2, 0x9a, 0x7c, 0x96, 0x08,
+	0xa3, 0x83, 0xbc, 0x0b, 0x2b, 0x03, 0x1c, 0x1c, 0xa3, 0x34, 0x3b, 0x2e, 0x36, 0x97, 0x1d, 0xe3,
+	0x69, 0x6a, 0x22, 0x4e, 0x8a, 0xac, 0xac, 0x39, 0x76, 0x6d, 0x4c, 0x4e, 0xc4, 0x30, 0x37, 0x01,
+	0xd7, 0x74, 0xd5, 0xcf, 0x93, 0xb5, 0xb8, 0x6d, 0xd5, 0xe3, 0x36, 0xfa, 0x63, 0x03, 0xba, 0x53,
+	0x1e, 0xb7, 0x28, 0xe4, 0x76, 0x79, 0xd1, 0xb5, 0xc1, 0x1c, 0xd7, 0xee, 0x41, 0xcb, 0xbd, 0x1d,
+	0xd6, 0x76, 0x1b, 0x4c, 0xf5, 0x87, 0x65, 0xff, 0xb9, 0xdd, 0xa4, 0x5e, 0x88, 0xec, 0x01, 0x51,
+	0xbc, 0x97, 0x33, 0x3d, 0x94, 0x18, 0x9f, 0xa1, 0xe4, 0x27, 0x1c, 0xdd, 0xc7, 0x2d, 0xd3, 0x8d,
+	0xc9, 0xce, 0x2b, 0xbf, 0x41, 0x3e, 0x99, 0x03, 0x54, 0xfb, 0xe0, 0x96, 0x8d, 0xbd, 0x8b, 0xee,
+	0xbd, 0x88, 0x20, 0xcb, 0x32, 0x71, 0x5e, 0xb9, 0xc3, 0x93, 0x97, 0xba, 0xe3, 0xef, 0x01, 0xac,
+	0x52, 0x3c, 0x13, 0x89, 0x75, 0x80, 0x4d, 0x96, 0x75, 0x08, 0xd9, 0x29, 0x2f, 0x4b, 0x1a, 0x3b,
+	0xe5, 0xe6, 0x30, 0x57, 0x6a, 0x88, 0xd2, 0xa3, 0xe5, 0x29, 0x83, 0xad, 0x75, 0xb7, 0xcf, 0x40,
+	0x47, 0x98, 0x52, 0xaf, 0xfb, 0x5c, 0xc5, 0xae, 0xb8, 0x5b, 0xb8, 0x42, 0x0a, 0x86, 0xf5, 0x2b,
+	0xcb, 0x31, 0x02, 0x39, 0x8e, 0x74, 0x29, 0xd0, 0x74, 0x02, 0x86, 0xe5, 0x05, 0xba, 0xb0, 0x84,
+	0xa3, 0x82, 0x4b, 0x74, 0x81, 0xb6, 0x4c, 0x4b, 0x32, 0x62, 0xb0, 0x36, 0x6d, 0xad, 0x32, 0x98,
+	0xd9, 0xdd, 0x71, 0xec, 0x51, 0xf1, 0x98, 0x39, 0xa6, 0x03, 0x83, 0x7c, 0x17, 0x9a, 0x99, 0x91,
+	0xf6, 0xa9, 0x7c, 0xdd, 0x57, 0xfc, 0xba, 0x26, 0xea, 0x24, 0xa2, 0x04, 0x6e, 0xde, 0xcf, 0x59,
+	0x36, 0xfe, 0x1c, 0x6d, 0x51, 0x78, 0x78, 0xff, 0xe8, 0xed, 0x4b, 0xb5, 0xe1, 0x9f, 0x08, 0x39,
+	0x60, 0x93, 0x4a, 0xeb, 0xa8, 0xe8, 0xaf, 0x01, 0x34, 0x1f, 0xde, 0x3f, 0x7a, 0x39, 0xba, 0x24,
+	0x3f, 0xae, 0x43, 0x53, 0x8f, 0xaa, 0xf4, 0x58, 0xd4, 0x23, 0x97, 0xf8, 0x36, 0xc6, 0x58, 0xa2,
+	0x5d, 0x74, 0x3a, 0x9d, 0x9d, 0x92, 0x69, 0xa3, 0xf3, 0x26, 0xb4, 0x06, 0xa8, 0xfb, 0x22, 0xf5,
+	0x79, 0xe2, 0x29, 0x73, 0x4f, 0x8a, 0x85, 0xee, 0x7b, 0x77, 0x3b, 0xc2, 0x94, 0x85, 0x14, 0x0b,
+	0xcc, 0x53, 0xcc, 0x13, 0x8e, 0xaa, 0xdb, 0xda, 0x09, 0x77, 0x43, 0x3a, 0xc5, 0x8b, 0x7e, 0x0d,
+	0x6d, 0xf3, 0x2a, 0x66, 0x3c, 0xd1, 0x3f, 0xc5, 0xf1, 0x45, 0x2b, 0x82, 0x39, 0x56, 0xf8, 0x67,
+	0xb1, 0x51, 0x3d, 0x8b, 0xe6, 0xbd, 0x18, 0x17, 0xa5, 0xcd, 0x76, 0x1d, 0xbd, 0x02, 0x78, 0x39,
+	0x2a, 0x75, 0x4f, 0x5e, 0x1f, 0xe7, 0x08, 0xbb, 0xb6, 0x65, 0x4c, 0xf8, 0xca, 0xdf, 0xd0, 0x82,
+	0x7c, 0x07, 0x16, 0x4f, 0x71, 0xac, 0xfc, 0xc3, 0xbd, 0x6e, 0x61, 0xac, 0x19, 0x47, 0xed, 0x6e,
+	0xf4, 0x9f, 0x10, 0xd6, 0x4b, 0xf0, 0x2c, 0x96, 0x8a, 0x2b, 0xd3, 0x18, 0xd8, 0xf2, 0x1e, 0x4f,
+	0x3d, 0x93, 0x6d, 0xcb, 0x7b, 0xe2, 0xd0, 0x7a, 0x07, 0x96, 0xf5, 0x28, 0x76, 0x21, 0xed, 0xee,
+	0x5c, 0xd2, 0xa3, 0x43, 0x43, 0x92, 0xf7, 0x20, 0xd4, 0xa3, 0xf2, 0x5e, 0xb0, 0xf7, 0x5a, 0xfc,
+	0xa8, 0x61, 0x9b, 0x88, 0x4e, 0x59, 0x2f, 0x96, 0x78, 0x3c, 0xe4, 0x99, 0xb6, 0x9e, 0x5f, 0xa6,
+	0x90, 0xb2, 0x1e, 0x75, 0x1c, 0xb2, 0x07, 0x2b, 0x89, 0x37, 0x53, 0x75, 0x9b, 0xb5, 0xe7, 0xa4,
+	0xfa, 0x7e, 0x5a, 0x49, 0x90, 0x8f, 0x60, 0x33, 0x91, 0x5c, 0xf3, 0x84, 0x65, 0x71, 0xc1, 0x74,
+	0x3f, 0xce, 0x30, 0xef, 0xe9, 0xbe, 0x7f, 0x6c, 0x49, 0xb9, 0xf7, 0x9c, 0xe9, 0xfe, 0x33, 0xbb,
+	0x63, 0x51, 0xa9, 0x9f, 0xe8, 0x2e, 0x39, 0x24, 0xeb, 0xa2, 0xe4, 0xfb, 0xb0, 0x51, 0x30, 0xc9,
+	0xb2, 0x0c, 0x33, 0xae, 0x06, 0xf1, 0x39, 0x4f, 0x75, 0xdf, 0xbe, 0xc8, 0x21, 0x5d, 0xaf, 0x6d,
+	0x7c, 0x66, 0xf8, 0xe4, 0x7b, 0xb0, 0x51, 0x1a, 0x14, 0x4f, 0xbc, 0xb2, 0x62, 0x85, 0xd7, 0xca,
+	0x8d, 0x97, 0xde, 0x3b, 0x2e, 0x26, 0x9c, 0xac, 0x34, 0x39, 0x0d, 0x3b, 0xc1, 0x6e, 0x40, 0x3b,
+	0x25, 0x93, 0x9a, 0xac, 0xfe, 0x08, 0x36, 0xcf, 0x79, 0x9e, 0x8a, 0xf3, 0x78, 0x5a, 0xb6, 0x6d,
+	0x65, 0x89, 0xdb, 0x3b, 0xac, 0x9f, 0x58, 0x87, 0x30, 0x15, 0xba, 0xdb, 0x71, 0x51, 0x94, 0x0a,
+	0x1d, 0x7d, 0x11, 0xc0, 0xc6, 0x11, 0xea, 0x27, 0xc2, 0xa0, 0xad, 0xae, 0x90, 0x98, 0x1f, 0x40,
+	0xc7, 0x36, 0x7d, 0xf1, 0x54, 0x7a, 0xb6, 0x2d, 0xcf, 0xa3, 0x7e, 0x1b, 0x00, 0xf3, 0x34, 0x9e,
+	0x6a, 0x75, 0x57, 0x30, 0x4f, 0xfd, 0xf6, 0x3a, 0x84, 0x5a, 0x14, 0x16, 0xd3, 0x45, 0x6a, 0x96,
+	0xd1, 0x5f, 0x02, 0x00, 0x67, 0x81, 0xe9, 0xac, 0xdf, 0x36, 0x21, 0x36, 0xa1, 0x29, 0x91, 0xa5,
+	0x65, 0xfb, 0xe2, 0x08, 0x93, 0xbe, 0xe7, 0x92, 0x6b, 0x54, 0xbe, 0x6e, 0x7a, 0x8a, 0xbc, 0x37,
+	0x1d, 0x40, 0x66, 0xab, 0x62, 0x98, 0x53, 0xbe, 0xbf, 0x71, 0x11, 0xe2, 0xa9, 0xe8, 0x8b, 0x06,
+	0x74, 0x9c, 0xa5, 0xbe, 0x6f, 0x9e, 0x75, 0x47, 0xf0, 0x4d, 0xee, 0x68, 0xcc, 0xba, 0xe3, 0x7d,
+	0x70, 0x29, 0x13, 0xd7, 0x2b, 0x3f, 0x58, 0x96, 0x8b, 0x85, 0x7a, 0x12, 0x2d, 0x4e, 0x27, 0xd1,
+	0xdc, 0x90, 0x6a, 0x5e, 0x31, 0xa4, 0x5a, 0x73, 0x42, 0xea, 0x8e, 0x2f, 0x07, 0x4b, 0xb5, 0x8c,
+	0xaa, 0x90, 0xf1, 0xd5, 0xe0, 0xab, 0x00, 0xae, 0xbd, 0x18, 0xe7, 0x89, 0xe9, 0xd3, 0x5e, 0x24,
+	0x42, 0xe2, 0xd7, 0xf6, 0x9a, 0xca, 0x48, 0xd8, 0xcf, 0x0e, 0xa8, 0x23, 0x8c, 0x47, 0x32, 0xa6,
+	0x31, 0x4f, 0xc6, 0xf1, 0xa0, 0x84, 0x6b, 0xc5, 0x73, 0x7e, 0x66, 0xa1, 0x91, 0xa8, 0x0a, 0x61,
+	0x7b, 0x51, 0x17, 0x26, 0x15, 0xc3, 0x34, 0xaa, 0xa6, 0x37, 0x16, 0x43, 0x8f, 0xdb, 0x22, 0x9d,
+	0xd0, 0xe4, 0x43, 0x58, 0xe5, 0xf9, 0x19, 0xcb, 0x78, 0x1a, 0xd7, 0xe0, 0x5b, 0xa4, 0xd7, 0x3c,
+	0xd7, 0xd6, 0x30, 0xfb, 0xaa, 0x17, 0x98, 0xb3, 0x4c, 0x8f, 0x6d, 0x57, 0x1d, 0xd0, 0x92, 0x8c,
+	0x5e, 0xc1, 0xea, 0xd4, 0x97, 0xd9, 0xeb, 0x94, 0x36, 0xfe, 0xea, 0x95, 0x0f, 0xe1, 0x84, 0x26,
+	0xbb, 0xd0, 0xac, 0xf7, 0xb3, 0xc4, 0xba, 0x6b, 0xea, 0x3c, 0x75, 0x02, 0xd1, 0x4b, 0xe8, 0x1e,
+	0xa1, 0x76, 0xc3, 0xe3, 0x38, 0x4f, 0x8c, 0x33, 0xaf, 0x32, 0xb0, 0xdc, 0x06, 0x38, 0xe7, 0xba,
+	0x3f, 0xe9, 0x9a, 0x4d, 0x15, 0x5c, 0x31, 0x1c, 0xd7, 0x22, 0x0f, 0x60, 0x75, 0x5a, 0x25, 0xf9,
+	0xb0, 0x9c, 0x3b, 0x03, 0xdb, 0x49, 0xad, 0xed, 0xdb, 0x09, 0xbc, 0xba, 0xd2, 0xed, 0x92, 0x1f,
+	0x42, 0xdb, 0xa8, 0x8c, 0x2d, 0x1c, 0xca, 0xb7, 0x5d, 0xd7, 0x2f, 0x9a, 0xaf, 0x28, 0x14, 0x93,
+	0xf5, 0xc1, 0x1f, 0x96, 0xe1, 0x1a, 0x2d, 0x92, 0x6a, 0x8c, 0x27, 0x3f, 0x82, 0x95, 0xc9, 0x90,
+	0x4e, 0xdc, 0x0c, 0x30, 0x3b, 0xb4, 0x6f, 0xf9, 0x37, 0xa5, 0x9a, 0x9b, 0xa3, 0x05, 0x72, 0x0f,
+	0xe0, 0x39, 0x1b, 0x2a, 0x37, 0x93, 0x93, 0x9b, 0x95, 0x44, 0xfd, 0xdf, 0x81, 0xb9, 0x27, 0x3f,
+	0x86, 0x36, 0x45, 0x35, 0x1c, 0xbc, 0xc5, 0xd1, 0x7b, 0x00, 0xd5, 0x1f, 0x01, 0xfe, 0xe4, 0x85,
+	0x7f, 0x06, 0xe6, 0x9e, 0xfc, 0x09, 0xac, 0x4e, 0xd0, 0xb3, 0xbc, 0x6f, 0x75, 0xef, 0x21, 0x74,
+	0xea, 0xa3, 0x30, 0xe9, 0xce, 0x99, 0x8e, 0xdd, 0xe9, 0x5b, 0x97, 0xcc, 0xcd, 0xd1, 0x02, 0x79,
+	0x0c, 0x37, 0x4c, 0x4f, 0x35, 0x3b, 0x61, 0x95, 0x96, 0x5c, 0x18, 0x83, 0xb7, 0xae, 0xcf, 0xf0,
+	0xcd, 0xe9, 0x68, 0x81, 0x3c, 0x81, 0x5b, 0x8f, 0x46, 0x46, 0xe7, 0xff, 0xad, 0xe9, 0x53, 0xb8,
+	0x69, 0xa7, 0xb2, 0xab, 0x2b, 0x9a, 0xe5, 0xfb, 0xa1, 0x2e, 0x5a, 0x20, 0xbf, 0x81, 0xcd, 0x79,
+	0xb3, 0x1b, 0xd9, 0x71, 0x5d, 0xfa, 0xe5, 0x63, 0xdd, 0xd6, 0x6d, 0x2b, 0x71, 0xd9, 0xfc, 0x11,
+	0x2d, 0x90, 0x87, 0x40, 0x8e, 0x50, 0xcf, 0xf6, 0xb8, 0x97, 0xe1, 0xb7, 0x39, 0xa7, 0x8f, 0x35,
+	0x18, 0x1e, 0xc1, 0xda, 0x4c, 0x0f, 0x4b, 0xde, 0x75, 0x37, 0xcf, 0xed, 0x6c, 0xb7, 0x5c, 0x2e,
+	0xcc, 0xb6, 0x4c, 0x36, 0x7e, 0xa1, 0x7a, 0x6e, 0xbd, 0x19, 0x17, 0xde, 0xdf, 0xad, 0x8d, 0x5a,
+	0xe1, 0x9d, 0x84, 0xc0, 0x03, 0xfb, 0x52, 0xcf, 0x94, 0xa7, 0xcb, 0x3e, 0x64, 0x5e, 0x32, 0x47,
+	0x0b, 0xe4, 0xa9, 0xd5, 0x31, 0x53, 0x34, 0x6e, 0x97, 0x56, 0xcc, 0xad, 0x4f, 0x5e, 0xd5, 0xf4,
+	0x5e, 0xb4, 0xf0, 0xe0, 0xe8, 0x9f, 0xaf, 0xb7, 0x83, 0x2f, 0x5f, 0x6f, 0x07, 0xff, 0x7d, 0xbd,
+	0x1d, 0xfc, 0xe9, 0xcd, 0xf6, 0xc2, 0x97, 0x6f, 0xb6, 0x17, 0xbe, 0x7a, 0xb3, 0xbd, 0xf0, 0xdb,
+	0x3d, 0x5b, 0xbe, 0x06, 0xec, 0x14, 0xe5, 0xbe, 0x90, 0xbd, 0xbb, 0x15, 0xb9, 0xd7, 0x13, 0x77,
+	0x07, 0x22, 0x1d, 0x66, 0x78, 0x57, 0x16, 0x89, 0x42, 0x79, 0x86, 0xf2, 0xb8, 0x65, 0xff, 0x20,
+	0xfc, 0xc1, 0xff, 0x06, 0x00, 0x0b, 0x04, 0x99, 0x66, 0x6b, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RpcChainAdminClient is the client API for RpcChainAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RpcChainAdminClient interface {
+	// join the chain of a genesis bundle, see blockchain.ExtractGenesisBundle
+	JoinChain(ctx context.Context, in *JoinChainRequest, opts ...grpc.CallOption) (*ChainStatus, error)
+	// stop the consensus of a chain, it keeps serving queries
+	PauseChain(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*ChainStatus, error)
+	// start the consensus of a paused chain
+	ResumeChain(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*ChainStatus, error)
+	// stop and forget a chain, and archive its data if asked
+	LeaveChain(ctx context.Context, in *LeaveChainRequest, opts ...grpc.CallOption) (*ChainStatus, error)
+	// get the state of a chain and the result of the last operation on it
+	GetChainStatus(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*ChainStatus, error)
+	// reload the local config file of the node, see confreload.Reload
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigReport, error)
+	// list the blocks rejected by the sync of a chain without their raw bytes, and the peers banned for serving them
+	ListQuarantinedBlocks(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineList, error)
+	// export the blocks rejected by the sync of a chain with their raw bytes
+	ExportQuarantinedBlocks(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineList, error)
+	// remove the blocks at the height from the quarantine of a chain, or all of them and the bans of the peers
+	// without the height
+	ClearQuarantinedBlocks(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineCleared, error)
+	// judge the candidate endorsements by the endorsement policy of a resource without submitting anything
+	ExplainAuthorization(ctx context.Context, in *ExplainAuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationExplanation, error)
+	// get the status of the certificate revocation lists of a chain
+	GetRevocationLists(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*RevocationLists, error)
+	// analyze the DAG of a block rebuilt from the stored read-write sets
+	AnalyzeBlockDAG(ctx context.Context, in *AnalyzeBlockDAGRequest, opts ...grpc.CallOption) (*BlockDAGAnalysis, error)
+	// aggregate the accesses of the keys by the txs of a range of blocks, and return the keys with the most conflicts
+	GetHotKeys(ctx context.Context, in *GetHotKeysRequest, opts ...grpc.CallOption) (*HotKeyReport, error)
+	// get the peer select strategy in use by the sync of a chain, and the score of each peer
+	GetSyncPeerScores(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*SyncPeerScores, error)
+	// get the sync state of a chain like RpcNode.GetSyncState, along with the peer select strategy and the peer scores
+	GetChainSyncState(ctx context.Context, in *GetChainSyncStateRequest, opts ...grpc.CallOption) (*ChainSyncState, error)
+}
+
+type rpcChainAdminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRpcChainAdminClient(cc *grpc.ClientConn) RpcChainAdminClient {
+	return &rpcChainAdminClient{cc}
+}
+
+func (c *rpcChainAdminClient) JoinChain(ctx context.Context, in *JoinChainRequest, opts ...grpc.CallOption) (*ChainStatus, error) {
+	out := new(ChainStatus)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/JoinChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) PauseChain(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*ChainStatus, error) {
+	out := new(ChainStatus)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/PauseChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) ResumeChain(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*ChainStatus, error) {
+	out := new(ChainStatus)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/ResumeChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) LeaveChain(ctx context.Context, in *LeaveChainRequest, opts ...grpc.CallOption) (*ChainStatus, error) {
+	out := new(ChainStatus)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/LeaveChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) GetChainStatus(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*ChainStatus, error) {
+	out := new(ChainStatus)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/GetChainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigReport, error) {
+	out := new(ReloadConfigReport)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) ListQuarantinedBlocks(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineList, error) {
+	out := new(QuarantineList)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/ListQuarantinedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) ExportQuarantinedBlocks(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineList, error) {
+	out := new(QuarantineList)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/ExportQuarantinedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) ClearQuarantinedBlocks(ctx context.Context, in *QuarantineRequest, opts ...grpc.CallOption) (*QuarantineCleared, error) {
+	out := new(QuarantineCleared)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/ClearQuarantinedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) ExplainAuthorization(ctx context.Context, in *ExplainAuthorizationRequest, opts ...grpc.CallOption) (*AuthorizationExplanation, error) {
+	out := new(AuthorizationExplanation)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/ExplainAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) GetRevocationLists(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*RevocationLists, error) {
+	out := new(RevocationLists)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/GetRevocationLists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) AnalyzeBlockDAG(ctx context.Context, in *AnalyzeBlockDAGRequest, opts ...grpc.CallOption) (*BlockDAGAnalysis, error) {
+	out := new(BlockDAGAnalysis)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/AnalyzeBlockDAG", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) GetHotKeys(ctx context.Context, in *GetHotKeysRequest, opts ...grpc.CallOption) (*HotKeyReport, error) {
+	out := new(HotKeyReport)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/GetHotKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) GetSyncPeerScores(ctx context.Context, in *ChainAdminRequest, opts ...grpc.CallOption) (*SyncPeerScores, error) {
+	out := new(SyncPeerScores)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/GetSyncPeerScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcChainAdminClient) GetChainSyncState(ctx context.Context, in *GetChainSyncStateRequest, opts ...grpc.CallOption) (*ChainSyncState, error) {
+	out := new(ChainSyncState)
+	err := c.cc.Invoke(ctx, "/api.RpcChainAdmin/GetChainSyncState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RpcChainAdminServer is the server API for RpcChainAdmin service.
+type RpcChainAdminServer interface {
+	// join the chain of a genesis bundle, see blockchain.ExtractGenesisBundle
+	JoinChain(context.Context, *JoinChainRequest) (*ChainStatus, error)
+	// stop the consensus of a chain, it keeps serving queries
+	PauseChain(context.Context, *ChainAdminRequest) (*ChainStatus, error)
+	// start the consensus of a paused chain
+	ResumeChain(context.Context, *ChainAdminRequest) (*ChainStatus, error)
+	// stop and forget a chain, and archive its data if asked
+	LeaveChain(context.Context, *LeaveChainRequest) (*ChainStatus, error)
+	// get the state of a chain and the result of the last operation on it
+	GetChainStatus(context.Context, *ChainAdminRequest) (*ChainStatus, error)
+	// reload the local config file of the node, see confreload.Reload
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigReport, error)
+	// list the blocks rejected by the sync of a chain without their raw bytes, and the peers banned for serving them
+	ListQuarantinedBlocks(context.Context, *QuarantineRequest) (*QuarantineList, error)
+	// export the blocks rejected by the sync of a chain with their raw bytes
+	ExportQuarantinedBlocks(context.Context, *QuarantineRequest) (*QuarantineList, error)
+	// remove the blocks at the height from the quarantine of a chain, or all of them and the bans of the peers
+	// without the height
+	ClearQuarantinedBlocks(context.Context, *QuarantineRequest) (*QuarantineCleared, error)
+	// judge the candidate endorsements by the endorsement policy of a resource without submitting anything
+	ExplainAuthorization(context.Context, *ExplainAuthorizationRequest) (*AuthorizationExplanation, error)
+	// get the status of the certificate revocation lists of a chain
+	GetRevocationLists(context.Context, *ChainAdminRequest) (*RevocationLists, error)
+	// analyze the DAG of a block rebuilt from the stored read-write sets
+	AnalyzeBlockDAG(context.Context, *AnalyzeBlockDAGRequest) (*BlockDAGAnalysis, error)
+	// aggregate the accesses of the keys by the txs of a range of blocks, and return the keys with the most conflicts
+	GetHotKeys(context.Context, *GetHotKeysRequest) (*HotKeyReport, error)
+	// get the peer select strategy in use by the sync of a chain, and the score of each peer
+	GetSyncPeerScores(context.Context, *ChainAdminRequest) (*SyncPeerScores, error)
+	// get the sync state of a chain like RpcNode.GetSyncState, along with the peer select strategy and the peer scores
+	GetChainSyncState(context.Context, *GetChainSyncStateRequest) (*ChainSyncState, error)
+}
+
+// UnimplementedRpcChainAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedRpcChainAdminServer struct {
+}
+
+func (*UnimplementedRpcChainAdminServer) JoinChain(ctx context.Context, req *JoinChainRequest) (*ChainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChain not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) PauseChain(ctx context.Context, req *ChainAdminRequest) (*ChainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseChain not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) ResumeChain(ctx context.Context, req *ChainAdminRequest) (*ChainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeChain not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) LeaveChain(ctx context.Context, req *LeaveChainRequest) (*ChainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChain not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) GetChainStatus(ctx context.Context, req *ChainAdminRequest) (*ChainStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainStatus not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) ReloadConfig(ctx context.Context, req *ReloadConfigRequest) (*ReloadConfigReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) ListQuarantinedBlocks(ctx context.Context, req *QuarantineRequest) (*QuarantineList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedBlocks not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) ExportQuarantinedBlocks(ctx context.Context, req *QuarantineRequest) (*QuarantineList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportQuarantinedBlocks not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) ClearQuarantinedBlocks(ctx context.Context, req *QuarantineRequest) (*QuarantineCleared, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearQuarantinedBlocks not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) ExplainAuthorization(ctx context.Context, req *ExplainAuthorizationRequest) (*AuthorizationExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAuthorization not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) GetRevocationLists(ctx context.Context, req *ChainAdminRequest) (*RevocationLists, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevocationLists not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) AnalyzeBlockDAG(ctx context.Context, req *AnalyzeBlockDAGRequest) (*BlockDAGAnalysis, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeBlockDAG not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) GetHotKeys(ctx context.Context, req *GetHotKeysRequest) (*HotKeyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHotKeys not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) GetSyncPeerScores(ctx context.Context, req *ChainAdminRequest) (*SyncPeerScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncPeerScores not implemented")
+}
+func (*UnimplementedRpcChainAdminServer) GetChainSyncState(ctx context.Context, req *GetChainSyncStateRequest) (*ChainSyncState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainSyncState not implemented")
+}
+
+func RegisterRpcChainAdminServer(s *grpc.Server, srv RpcChainAdminServer) {
+	s.RegisterService(&_RpcChainAdmin_serviceDesc, srv)
+}
+
+func _RpcChainAdmin_JoinChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).JoinChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/JoinChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).JoinChain(ctx, req.(*JoinChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_PauseChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).PauseChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/PauseChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).PauseChain(ctx, req.(*ChainAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_ResumeChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).ResumeChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/ResumeChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).ResumeChain(ctx, req.(*ChainAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_LeaveChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).LeaveChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/LeaveChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).LeaveChain(ctx, req.(*LeaveChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_GetChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).GetChainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/GetChainStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).GetChainStatus(ctx, req.(*ChainAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_ListQuarantinedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).ListQuarantinedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/ListQuarantinedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).ListQuarantinedBlocks(ctx, req.(*QuarantineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_ExportQuarantinedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).ExportQuarantinedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/ExportQuarantinedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).ExportQuarantinedBlocks(ctx, req.(*QuarantineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_ClearQuarantinedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).ClearQuarantinedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/ClearQuarantinedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).ClearQuarantinedBlocks(ctx, req.(*QuarantineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_ExplainAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).ExplainAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/ExplainAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).ExplainAuthorization(ctx, req.(*ExplainAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_GetRevocationLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).GetRevocationLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/GetRevocationLists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).GetRevocationLists(ctx, req.(*ChainAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_AnalyzeBlockDAG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeBlockDAGRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).AnalyzeBlockDAG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/AnalyzeBlockDAG",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).AnalyzeBlockDAG(ctx, req.(*AnalyzeBlockDAGRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_GetHotKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHotKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).GetHotKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/GetHotKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).GetHotKeys(ctx, req.(*GetHotKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_GetSyncPeerScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).GetSyncPeerScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/GetSyncPeerScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).GetSyncPeerScores(ctx, req.(*ChainAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcChainAdmin_GetChainSyncState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainSyncStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcChainAdminServer).GetChainSyncState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcChainAdmin/GetChainSyncState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcChainAdminServer).GetChainSyncState(ctx, req.(*GetChainSyncStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RpcChainAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.RpcChainAdmin",
+	HandlerType: (*RpcChainAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinChain",
+			Handler:    _RpcChainAdmin_JoinChain_Handler,
+		},
+		{
+			MethodName: "PauseChain",
+			Handler:    _RpcChainAdmin_PauseChain_Handler,
+		},
+		{
+			MethodName: "ResumeChain",
+			Handler:    _RpcChainAdmin_ResumeChain_Handler,
+		},
+		{
+			MethodName: "LeaveChain",
+			Handler:    _RpcChainAdmin_LeaveChain_Handler,
+		},
+		{
+			MethodName: "GetChainStatus",
+			Handler:    _RpcChainAdmin_GetChainStatus_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _RpcChainAdmin_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedBlocks",
+			Handler:    _RpcChainAdmin_ListQuarantinedBlocks_Handler,
+		},
+		{
+			MethodName: "ExportQuarantinedBlocks",
+			Handler:    _RpcChainAdmin_ExportQuarantinedBlocks_Handler,
+		},
+		{
+			MethodName: "ClearQuarantinedBlocks",
+			Handler:    _RpcChainAdmin_ClearQuarantinedBlocks_Handler,
+		},
+		{
+			MethodName: "ExplainAuthorization",
+			Handler:    _RpcChainAdmin_ExplainAuthorization_Handler,
+		},
+		{
+			MethodName: "GetRevocationLists",
+			Handler:    _RpcChainAdmin_GetRevocationLists_Handler,
+		},
+		{
+			MethodName: "AnalyzeBlockDAG",
+			Handler:    _RpcChainAdmin_AnalyzeBlockDAG_Handler,
+		},
+		{
+			MethodName: "GetHotKeys",
+			Handler:    _RpcChainAdmin_GetHotKeys_Handler,
+		},
+		{
+			MethodName: "GetSyncPeerScores",
+			Handler:    _RpcChainAdmin_GetSyncPeerScores_Handler,
+		},
+		{
+			MethodName: "GetChainSyncState",
+			Handler:    _RpcChainAdmin_GetChainSyncState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc_chain_admin.proto",
+}
+
+func (m *ChainAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JoinChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JoinChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Genesis) > 0 {
+		i -= len(m.Genesis)
+		copy(dAtA[i:], m.Genesis)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Genesis)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaveChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaveChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaveChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Archive {
+		i--
+		if m.Archive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdateTime) > 0 {
+		i -= len(m.UpdateTime)
+		copy(dAtA[i:], m.UpdateTime)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.UpdateTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Archive) > 0 {
+		i -= len(m.Archive)
+		copy(dAtA[i:], m.Archive)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Archive)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReloadConfigReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReloadConfigReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReloadConfigReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failed) > 0 {
+		for k := range m.Failed {
+			v := m.Failed[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NeedRestart) > 0 {
+		for iNdEx := len(m.NeedRestart) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NeedRestart[iNdEx])
+			copy(dAtA[i:], m.NeedRestart[iNdEx])
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.NeedRestart[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Applied) > 0 {
+		for iNdEx := len(m.Applied) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applied[iNdEx])
+			copy(dAtA[i:], m.Applied[iNdEx])
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Applied[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Raw) > 0 {
+		i -= len(m.Raw)
+		copy(dAtA[i:], m.Raw)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Raw)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Rejected != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Rejected))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Time != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BannedPeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BannedPeer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BannedPeer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Offenses != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Offenses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Until != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Until))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BannedPeers) > 0 {
+		for iNdEx := len(m.BannedPeers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BannedPeers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Removed != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Removed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExplainAuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExplainAuthorizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetOrgId) > 0 {
+		i -= len(m.TargetOrgId)
+		copy(dAtA[i:], m.TargetOrgId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.TargetOrgId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Endorsements) > 0 {
+		for iNdEx := len(m.Endorsements) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Endorsements[iNdEx])
+			copy(dAtA[i:], m.Endorsements[iNdEx])
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Endorsements[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ResourceName) > 0 {
+		i -= len(m.ResourceName)
+		copy(dAtA[i:], m.ResourceName)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ResourceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndorsementVerdict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndorsementVerdict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndorsementVerdict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Counted {
+		i--
+		if m.Counted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MemberId) > 0 {
+		i -= len(m.MemberId)
+		copy(dAtA[i:], m.MemberId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.MemberId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrgId) > 0 {
+		i -= len(m.OrgId)
+		copy(dAtA[i:], m.OrgId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.OrgId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Endorsements) > 0 {
+		for iNdEx := len(m.Endorsements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endorsements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SignatureVerified {
+		i--
+		if m.SignatureVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ResourceName) > 0 {
+		i -= len(m.ResourceName)
+		copy(dAtA[i:], m.ResourceName)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ResourceName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocationList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocationList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextUpdate != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.NextUpdate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ThisUpdate != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.ThisUpdate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Aki) > 0 {
+		i -= len(m.Aki)
+		copy(dAtA[i:], m.Aki)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Aki)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevocationLists) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevocationLists) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevocationLists) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lists) > 0 {
+		for iNdEx := len(m.Lists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExpiryPolicy) > 0 {
+		i -= len(m.ExpiryPolicy)
+		copy(dAtA[i:], m.ExpiryPolicy)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ExpiryPolicy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnalyzeBlockDAGRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnalyzeBlockDAGRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnalyzeBlockDAGRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DAGTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DAGTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DAGTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dependencies) > 0 {
+		dAtA3 := make([]byte, len(m.Dependencies)*10)
+		var j2 int
+		for _, num1 := range m.Dependencies {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Depth != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.To != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockDAGAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockDAGAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockDAGAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dot) > 0 {
+		i -= len(m.Dot)
+		copy(dAtA[i:], m.Dot)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Dot)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.WindowConflictRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.WindowConflictRate))))
+		i--
+		dAtA[i] = 0x59
+	}
+	if m.ConflictRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ConflictRate))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.ConflictTxCount != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.ConflictTxCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ParallelismWidth != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.ParallelismWidth))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.CriticalPath) > 0 {
+		dAtA5 := make([]byte, len(m.CriticalPath)*10)
+		var j4 int
+		for _, num1 := range m.CriticalPath {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CriticalPathLength != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.CriticalPathLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DagRebuilt {
+		i--
+		if m.DagRebuilt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetHotKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHotKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetHotKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Top != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Top))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HotKeyStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotKeyStat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotKeyStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Conflicts != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Conflicts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Writes != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Writes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reads != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Reads))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HotKeyReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HotKeyReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HotKeyReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ConflictRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ConflictRate))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if m.ConflictTxCount != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.ConflictTxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPeerScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPeerScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPeerScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Penalty != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Penalty))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.InvalidBlocks != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.InvalidBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Timeouts != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Timeouts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Responses != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.Responses))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LatencyMs != 0 {
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(m.LatencyMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncPeerScores) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPeerScores) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncPeerScores) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetChainSyncStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetChainSyncStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetChainSyncStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithPeers {
+		i--
+		if m.WithPeers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRpcChainAdmin(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainSyncState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainSyncState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainSyncState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeerScores != nil {
+		{
+			size, err := m.PeerScores.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcChainAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcChainAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcChainAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Genesis)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *LeaveChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Archive {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Height))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Archive)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.UpdateTime)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ReloadConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReloadConfigReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			l = len(s)
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	if len(m.NeedRestart) > 0 {
+		for _, s := range m.NeedRestart {
+			l = len(s)
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	if len(m.Failed) > 0 {
+		for k, v := range m.Failed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRpcChainAdmin(uint64(len(k))) + 1 + len(v) + sovRpcChainAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRpcChainAdmin(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QuarantineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuarantinedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Height))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Time))
+	}
+	if m.Rejected != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Rejected))
+	}
+	l = len(m.Raw)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *BannedPeer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Until != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Until))
+	}
+	if m.Offenses != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Offenses))
+	}
+	return n
+}
+
+func (m *QuarantineList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	if len(m.BannedPeers) > 0 {
+		for _, e := range m.BannedPeers {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuarantineCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Removed != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Removed))
+	}
+	return n
+}
+
+func (m *ExplainAuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.ResourceName)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if len(m.Endorsements) > 0 {
+		for _, b := range m.Endorsements {
+			l = len(b)
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.TargetOrgId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *EndorsementVerdict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Index))
+	}
+	l = len(m.OrgId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.MemberId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Counted {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizationExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ResourceName)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.SignatureVerified {
+		n += 2
+	}
+	if len(m.Endorsements) > 0 {
+		for _, e := range m.Endorsements {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *RevocationList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Aki)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Count))
+	}
+	if m.ThisUpdate != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.ThisUpdate))
+	}
+	if m.NextUpdate != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.NextUpdate))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *RevocationLists) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExpiryPolicy)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if len(m.Lists) > 0 {
+		for _, e := range m.Lists {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AnalyzeBlockDAGRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Height))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DAGTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Index))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Depth))
+	}
+	if len(m.Dependencies) > 0 {
+		l = 0
+		for _, e := range m.Dependencies {
+			l += sovRpcChainAdmin(uint64(e))
+		}
+		n += 1 + sovRpcChainAdmin(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ConflictKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TxConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.To))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockDAGAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.BlockHeight))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.TxCount))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	if m.DagRebuilt {
+		n += 2
+	}
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	if m.CriticalPathLength != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.CriticalPathLength))
+	}
+	if len(m.CriticalPath) > 0 {
+		l = 0
+		for _, e := range m.CriticalPath {
+			l += sovRpcChainAdmin(uint64(e))
+		}
+		n += 1 + sovRpcChainAdmin(uint64(l)) + l
+	}
+	if m.ParallelismWidth != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.ParallelismWidth))
+	}
+	if m.ConflictTxCount != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.ConflictTxCount))
+	}
+	if m.ConflictRate != 0 {
+		n += 9
+	}
+	if m.WindowConflictRate != 0 {
+		n += 9
+	}
+	l = len(m.Dot)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHotKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.EndHeight))
+	}
+	if m.Top != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Top))
+	}
+	return n
+}
+
+func (m *HotKeyStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Reads != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Reads))
+	}
+	if m.Writes != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Writes))
+	}
+	if m.Conflicts != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Conflicts))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *HotKeyReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.EndHeight))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.BlockCount))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.TxCount))
+	}
+	if m.ConflictTxCount != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.ConflictTxCount))
+	}
+	if m.ConflictRate != 0 {
+		n += 9
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncPeerScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.LatencyMs))
+	}
+	if m.Responses != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Responses))
+	}
+	if m.Timeouts != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.Timeouts))
+	}
+	if m.InvalidBlocks != 0 {
+		n += 1 + sovRpcChainAdmin(uint64(m.InvalidBlocks))
+	}
+	if m.Penalty != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SyncPeerScores) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovRpcChainAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetChainSyncStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.WithPeers {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChainSyncState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	if m.PeerScores != nil {
+		l = m.PeerScores.Size()
+		n += 1 + l + sovRpcChainAdmin(uint64(l))
+	}
+	return n
+}
+
+func sovRpcChainAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcChainAdmin(x uint64) (n int) {
+	return sovRpcChainAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Genesis = append(m.Genesis[:0], dAtA[iNdEx:postIndex]...)
+			if m.Genesis == nil {
+				m.Genesis = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaveChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaveChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaveChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archive = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archive", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Archive = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReloadConfigReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReloadConfigReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReloadConfigReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeedRestart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NeedRestart = append(m.NeedRestart, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failed == nil {
+				m.Failed = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcChainAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcChainAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRpcChainAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRpcChainAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcChainAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRpcChainAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRpcChainAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRpcChainAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Failed[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantinedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			m.Rejected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rejected |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Raw = append(m.Raw[:0], dAtA[iNdEx:postIndex]...)
+			if m.Raw == nil {
+				m.Raw = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BannedPeer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BannedPeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BannedPeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offenses", wireType)
+			}
+			m.Offenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offenses |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantineList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantineList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantineList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &QuarantinedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BannedPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BannedPeers = append(m.BannedPeers, &BannedPeer{})
+			if err := m.BannedPeers[len(m.BannedPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantineCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantineCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantineCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			m.Removed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Removed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExplainAuthorizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExplainAuthorizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExplainAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endorsements", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endorsements = append(m.Endorsements, make([]byte, postIndex-iNdEx))
+			copy(m.Endorsements[len(m.Endorsements)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetOrgId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetOrgId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndorsementVerdict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndorsementVerdict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndorsementVerdict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrgId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Counted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationExplanation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationExplanation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &accesscontrol.Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureVerified = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endorsements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endorsements = append(m.Endorsements, &EndorsementVerdict{})
+			if err := m.Endorsements[len(m.Endorsements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aki", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aki = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThisUpdate", wireType)
+			}
+			m.ThisUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThisUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUpdate", wireType)
+			}
+			m.NextUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextUpdate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevocationLists) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevocationLists: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevocationLists: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lists = append(m.Lists, &RevocationList{})
+			if err := m.Lists[len(m.Lists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeBlockDAGRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeBlockDAGRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeBlockDAGRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcChainAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Dependencies = append(m.Dependencies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcChainAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcChainAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcChainAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Dependencies) == 0 {
+					m.Dependencies = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcChainAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Dependencies = append(m.Dependencies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &ConflictKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockDAGAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockDAGAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockDAGAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &DAGTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DagRebuilt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DagRebuilt = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, &TxConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalPathLength", wireType)
+			}
+			m.CriticalPathLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalPathLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcChainAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CriticalPath = append(m.CriticalPath, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRpcChainAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRpcChainAdmin
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRpcChainAdmin
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CriticalPath) == 0 {
+					m.CriticalPath = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRpcChainAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CriticalPath = append(m.CriticalPath, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalPath", wireType)
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismWidth", wireType)
+			}
+			m.ParallelismWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParallelismWidth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictTxCount", wireType)
+			}
+			m.ConflictTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictTxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ConflictRate = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowConflictRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.WindowConflictRate = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHotKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHotKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHotKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top", wireType)
+			}
+			m.Top = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotKeyStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotKeyStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotKeyStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reads", wireType)
+			}
+			m.Reads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reads |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
+			}
+			m.Writes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Writes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			m.Conflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conflicts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HotKeyReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HotKeyReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HotKeyReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictTxCount", wireType)
+			}
+			m.ConflictTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictTxCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ConflictRate = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &HotKeyStat{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPeerScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPeerScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPeerScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
+			}
+			m.LatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatencyMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			m.Responses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Responses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeouts", wireType)
+			}
+			m.Timeouts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeouts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidBlocks", wireType)
+			}
+			m.InvalidBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Penalty = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPeerScores) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPeerScores: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPeerScores: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &SyncPeerScore{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetChainSyncStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetChainSyncStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetChainSyncStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithPeers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithPeers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainSyncState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainSyncState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainSyncState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &sync.SyncState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeerScores == nil {
+				m.PeerScores = &SyncPeerScores{}
+			}
+			if err := m.PeerScores.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcChainAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcChainAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpcChainAdmin(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpcChainAdmin
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcChainAdmin
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpcChainAdmin
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRpcChainAdmin
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRpcChainAdmin
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRpcChainAdmin        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcChainAdmin          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRpcChainAdmin = fmt.Errorf("proto: unexpected end of group")
+)
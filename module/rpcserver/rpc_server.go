@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker/common/v2/ca"
 	"chainmaker.org/chainmaker/common/v2/crypto"
 	"chainmaker.org/chainmaker/common/v2/crypto/hash"
@@ -35,6 +36,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
+	healthPb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -71,6 +73,16 @@ const (
 // NewRPCServer - new RPCServer object
 func NewRPCServer(chainMakerServer *blockchain.ChainMakerServer) (*RPCServer, error) {
 
+	if err := initHealthThresholds(); err != nil {
+		return nil, fmt.Errorf("load health thresholds failed, %s", err.Error())
+	}
+	if err := initNodeAdmins(); err != nil {
+		return nil, fmt.Errorf("load node admins failed, %s", err.Error())
+	}
+	if err := initRateLimitRules(); err != nil {
+		return nil, fmt.Errorf("load rate limit rules failed, %s", err.Error())
+	}
+
 	grpcServer, err := newGrpc(chainMakerServer)
 	if err != nil {
 		return nil, fmt.Errorf("new grpc server failed, %s", err.Error())
@@ -86,8 +98,12 @@ func NewRPCServer(chainMakerServer *blockchain.ChainMakerServer) (*RPCServer, er
 		return nil, fmt.Errorf("new root dispatcher failed, %s", err.Error())
 	}
 	dispatcher.Start()
+	chainMakerServer.AddChainLifecycleListener(onChainLifecycle)
+	confreload.Register("rpcserver", []string{reloadKeySyncTxResultTimeout, reloadKeyRateLimit,
+		BlackListAddressesConfigKey, BlackListAllowAddressesConfigKey, BlackListCertSubjectsConfigKey,
+		BlackListOrgIdsConfigKey, reloadKeyHealth, reloadKeyChainAdmin}, reloadRPCConfig)
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		mRecv = monitor.NewCounterVec(monitor.SUBSYSTEM_GRPC, "grpc_msg_received_total",
 			"Total number of RPC messages received on the server.",
 			"grpc_service", "grpc_method")
@@ -286,6 +302,10 @@ func (s *RPCServer) startServer(conn net.Listener, tlsConfig *cmtls.Config) {
 func (s *RPCServer) RegisterHandler() error {
 	apiService := NewApiService(s.ctx, s.chainMakerServer)
 	apiPb.RegisterRpcNodeServer(s.grpcServer, apiService)
+	RegisterRpcSimulatorServer(s.grpcServer, apiService)
+	RegisterRpcBatchServer(s.grpcServer, apiService)
+	RegisterRpcChainAdminServer(s.grpcServer, apiService)
+	healthPb.RegisterHealthServer(s.grpcServer, newHealthServer(s.chainMakerServer))
 	return nil
 }
 
@@ -417,17 +437,19 @@ func (s *RPCServer) checkAndRestart() error {
 // newGrpc - new GRPC object
 func newGrpc(chainMakerServer *blockchain.ChainMakerServer) (*grpc.Server, error) {
 	var opts []grpc.ServerOption
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	senderFn := newTxSenderFunc(chainMakerServer)
+	if confreload.Config().MonitorConfig.Enabled {
 		opts = []grpc.ServerOption{
 			grpc_middleware.WithUnaryServerChain(
 				RecoveryInterceptor,
 				LoggingInterceptor,
 				MonitorInterceptor,
 				BlackListInterceptor(),
-				RateLimitInterceptor(),
+				RateLimitInterceptor(senderFn),
 			),
 			grpc_middleware.WithStreamServerChain(
 				BlackListStreamInterceptor(),
+				RateLimitStreamInterceptor(senderFn),
 				StreamRecoveryInterceptor(),
 			),
 		}
@@ -437,10 +459,11 @@ func newGrpc(chainMakerServer *blockchain.ChainMakerServer) (*grpc.Server, error
 				RecoveryInterceptor,
 				LoggingInterceptor,
 				BlackListInterceptor(),
-				RateLimitInterceptor(),
+				RateLimitInterceptor(senderFn),
 			),
 			grpc_middleware.WithStreamServerChain(
 				BlackListStreamInterceptor(),
+				RateLimitStreamInterceptor(senderFn),
 				StreamRecoveryInterceptor(),
 			),
 		}
@@ -559,8 +582,10 @@ func newMixServer(grpcServer *grpc.Server, chainMakerServer *blockchain.ChainMak
 		httpServer *http.Server
 	)
 
+	mux = http.NewServeMux()
+	mux.Handle(LivenessPath, newLivenessHandler())
+	mux.Handle(ReadinessPath, newReadinessHandler(chainMakerServer))
 	if localconf.ChainMakerConfig.RpcConfig.GatewayConfig.Enabled {
-		mux = http.NewServeMux()
 		gwmux, err := newGateway(chainMakerServer)
 		if err != nil {
 			log.Error(err)
@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rpc_simulator.proto
+
+package rpcserver
+
+import (
+	common "chainmaker.org/chainmaker/pb-go/v2/common"
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SimulateResponse the result of a simulated tx
+type SimulateResponse struct {
+	// the tx with its result and contract events, and the rw set of the tx
+	TxInfo *common.TransactionInfoWithRWSet `protobuf:"bytes,1,opt,name=tx_info,json=txInfo,proto3" json:"tx_info,omitempty"`
+	// the gas breakdown of the tx, all zero before block version 2030102
+	Gas *SimulateGas `protobuf:"bytes,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
+func (m *SimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateResponse) ProtoMessage()    {}
+func (*SimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931ec79a2e3b39f0, []int{0}
+}
+func (m *SimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateResponse.Merge(m, src)
+}
+func (m *SimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateResponse proto.InternalMessageInfo
+
+func (m *SimulateResponse) GetTxInfo() *common.TransactionInfoWithRWSet {
+	if m != nil {
+		return m.TxInfo
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetGas() *SimulateGas {
+	if m != nil {
+		return m.Gas
+	}
+	return nil
+}
+
+// SimulateGas the gas breakdown of a simulated tx
+type SimulateGas struct {
+	// the gas of the tx itself
+	Tx uint64 `protobuf:"varint,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// the gas of the rw set
+	RwSet uint64 `protobuf:"varint,2,opt,name=rw_set,json=rwSet,proto3" json:"rw_set,omitempty"`
+	// the gas of the contract events
+	Events uint64 `protobuf:"varint,3,opt,name=events,proto3" json:"events,omitempty"`
+	// the sum of the above
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *SimulateGas) Reset()         { *m = SimulateGas{} }
+func (m *SimulateGas) String() string { return proto.CompactTextString(m) }
+func (*SimulateGas) ProtoMessage()    {}
+func (*SimulateGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_931ec79a2e3b39f0, []int{1}
+}
+func (m *SimulateGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateGas.Merge(m, src)
+}
+func (m *SimulateGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateGas proto.InternalMessageInfo
+
+func (m *SimulateGas) GetTx() uint64 {
+	if m != nil {
+		return m.Tx
+	}
+	return 0
+}
+
+func (m *SimulateGas) GetRwSet() uint64 {
+	if m != nil {
+		return m.RwSet
+	}
+	return 0
+}
+
+func (m *SimulateGas) GetEvents() uint64 {
+	if m != nil {
+		return m.Events
+	}
+	return 0
+}
+
+func (m *SimulateGas) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SimulateResponse)(nil), "api.SimulateResponse")
+	proto.RegisterType((*SimulateGas)(nil), "api.SimulateGas")
+}
+
+func init() { proto.RegisterFile("rpc_simulator.proto", fileDescriptor_931ec79a2e3b39f0) }
+
+var fileDescriptor_931ec79a2e3b39f0 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x50, 0x3f, 0x6f, 0xea, 0x30,
+	0x1c, 0x4c, 0xf8, 0x93, 0xf7, 0x64, 0x9e, 0x9e, 0xa8, 0x0b, 0x55, 0xc4, 0x10, 0xa1, 0x4c, 0x5d,
+	0x48, 0x24, 0x2a, 0x55, 0xea, 0xda, 0xa1, 0xa8, 0xab, 0x53, 0x09, 0xa9, 0x0b, 0x32, 0xe9, 0x0f,
+	0xb0, 0x4a, 0x6c, 0x63, 0xff, 0x80, 0x7c, 0x8c, 0x7e, 0xac, 0x8e, 0x8c, 0x1d, 0x2b, 0xf8, 0x22,
+	0x15, 0x09, 0x29, 0xed, 0x78, 0x77, 0xbe, 0x3b, 0xff, 0x8e, 0x5c, 0x1a, 0x9d, 0x4e, 0xac, 0xc8,
+	0xd6, 0x4b, 0x8e, 0xca, 0x44, 0xda, 0x28, 0x54, 0xb4, 0xce, 0xb5, 0xe8, 0x75, 0x52, 0x95, 0x65,
+	0x4a, 0xc6, 0x06, 0x56, 0x6b, 0xb0, 0x58, 0x4a, 0x3d, 0xff, 0xc4, 0xa2, 0xe1, 0xd2, 0xf2, 0x14,
+	0x85, 0x92, 0xa5, 0x12, 0xae, 0x48, 0x3b, 0x29, 0x73, 0x80, 0x81, 0xd5, 0x4a, 0x5a, 0xa0, 0x77,
+	0xe4, 0x0f, 0xe6, 0x13, 0x21, 0x67, 0xca, 0x77, 0xfb, 0xee, 0x75, 0x6b, 0xd8, 0x8f, 0x4a, 0x7f,
+	0xf4, 0x74, 0xf6, 0x3f, 0xca, 0x99, 0x1a, 0x0b, 0x5c, 0xb0, 0x71, 0x02, 0xc8, 0x3c, 0xcc, 0x8f,
+	0x04, 0x0d, 0x49, 0x7d, 0xce, 0xad, 0x5f, 0x2b, 0x6c, 0xed, 0x88, 0x6b, 0x11, 0x55, 0xf1, 0x23,
+	0x6e, 0xd9, 0x51, 0x0c, 0xa7, 0xa4, 0xf5, 0x83, 0xa3, 0xff, 0x49, 0x0d, 0xf3, 0xa2, 0xa8, 0xc1,
+	0x6a, 0x98, 0xd3, 0x2e, 0xf1, 0xcc, 0x76, 0x62, 0x01, 0x8b, 0x94, 0x06, 0x6b, 0x9a, 0x6d, 0x02,
+	0x48, 0xaf, 0x88, 0x07, 0x1b, 0x90, 0x68, 0xfd, 0x7a, 0x41, 0x9f, 0x10, 0xed, 0x90, 0x26, 0x2a,
+	0xe4, 0x4b, 0xbf, 0x51, 0xbe, 0x2e, 0xc0, 0xf0, 0x81, 0xfc, 0x63, 0x3a, 0x4d, 0xaa, 0x85, 0xe8,
+	0x2d, 0xf9, 0x5b, 0x75, 0xd2, 0x8b, 0xef, 0x6b, 0x72, 0x56, 0xae, 0xd4, 0xeb, 0xfe, 0xfa, 0x69,
+	0x35, 0x44, 0xe8, 0xdc, 0x8f, 0xde, 0xf7, 0x81, 0xbb, 0xdb, 0x07, 0xee, 0xe7, 0x3e, 0x70, 0xdf,
+	0x0e, 0x81, 0xb3, 0x3b, 0x04, 0xce, 0xc7, 0x21, 0x70, 0x9e, 0x07, 0xe9, 0x82, 0x0b, 0x99, 0xf1,
+	0x57, 0x30, 0x91, 0x32, 0xf3, 0xf8, 0x0c, 0x07, 0x73, 0x15, 0x67, 0xea, 0x65, 0xbd, 0x84, 0xd8,
+	0xe8, 0xd4, 0x82, 0xd9, 0x80, 0x99, 0x7a, 0xc5, 0xdc, 0x37, 0x5f, 0x03, 0x00, 0x7b, 0x69, 0x6d,
+	0x76, 0xba, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RpcSimulatorClient is the client API for RpcSimulator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RpcSimulatorClient interface {
+	// run an INVOKE_CONTRACT or QUERY_CONTRACT tx against the latest state, or the state of the height given by
+	// a __QUERY_BLOCK_HEIGHT__ parameter, without submitting it
+	Simulate(ctx context.Context, in *common.TxRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+}
+
+type rpcSimulatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRpcSimulatorClient(cc *grpc.ClientConn) RpcSimulatorClient {
+	return &rpcSimulatorClient{cc}
+}
+
+func (c *rpcSimulatorClient) Simulate(ctx context.Context, in *common.TxRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/api.RpcSimulator/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RpcSimulatorServer is the server API for RpcSimulator service.
+type RpcSimulatorServer interface {
+	// run an INVOKE_CONTRACT or QUERY_CONTRACT tx against the latest state, or the state of the height given by
+	// a __QUERY_BLOCK_HEIGHT__ parameter, without submitting it
+	Simulate(context.Context, *common.TxRequest) (*SimulateResponse, error)
+}
+
+// UnimplementedRpcSimulatorServer can be embedded to have forward compatible implementations.
+type UnimplementedRpcSimulatorServer struct {
+}
+
+func (*UnimplementedRpcSimulatorServer) Simulate(ctx context.Context, req *common.TxRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+
+func RegisterRpcSimulatorServer(s *grpc.Server, srv RpcSimulatorServer) {
+	s.RegisterService(&_RpcSimulator_serviceDesc, srv)
+}
+
+func _RpcSimulator_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.TxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcSimulatorServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.RpcSimulator/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcSimulatorServer).Simulate(ctx, req.(*common.TxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RpcSimulator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.RpcSimulator",
+	HandlerType: (*RpcSimulatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Simulate",
+			Handler:    _RpcSimulator_Simulate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc_simulator.proto",
+}
+
+func (m *SimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != nil {
+		{
+			size, err := m.Gas.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcSimulator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxInfo != nil {
+		{
+			size, err := m.TxInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRpcSimulator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintRpcSimulator(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Events != 0 {
+		i = encodeVarintRpcSimulator(dAtA, i, uint64(m.Events))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RwSet != 0 {
+		i = encodeVarintRpcSimulator(dAtA, i, uint64(m.RwSet))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tx != 0 {
+		i = encodeVarintRpcSimulator(dAtA, i, uint64(m.Tx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRpcSimulator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRpcSimulator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxInfo != nil {
+		l = m.TxInfo.Size()
+		n += 1 + l + sovRpcSimulator(uint64(l))
+	}
+	if m.Gas != nil {
+		l = m.Gas.Size()
+		n += 1 + l + sovRpcSimulator(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != 0 {
+		n += 1 + sovRpcSimulator(uint64(m.Tx))
+	}
+	if m.RwSet != 0 {
+		n += 1 + sovRpcSimulator(uint64(m.RwSet))
+	}
+	if m.Events != 0 {
+		n += 1 + sovRpcSimulator(uint64(m.Events))
+	}
+	if m.Total != 0 {
+		n += 1 + sovRpcSimulator(uint64(m.Total))
+	}
+	return n
+}
+
+func sovRpcSimulator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRpcSimulator(x uint64) (n int) {
+	return sovRpcSimulator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcSimulator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcSimulator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcSimulator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxInfo == nil {
+				m.TxInfo = &common.TransactionInfoWithRWSet{}
+			}
+			if err := m.TxInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcSimulator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcSimulator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gas == nil {
+				m.Gas = &SimulateGas{}
+			}
+			if err := m.Gas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcSimulator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcSimulator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcSimulator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			m.Tx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RwSet", wireType)
+			}
+			m.RwSet = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RwSet |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			m.Events = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Events |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcSimulator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRpcSimulator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRpcSimulator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRpcSimulator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRpcSimulator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRpcSimulator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRpcSimulator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRpcSimulator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRpcSimulator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRpcSimulator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRpcSimulator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,170 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+//go:generate protoc -I=$CHAINMAKER_PB_PATH -I=. --gogofaster_out=plugins=grpc,paths=source_relative:. rpc_simulator.proto
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/snapshot"
+	commonErr "chainmaker.org/chainmaker/common/v2/errors"
+	"chainmaker.org/chainmaker/logger/v2"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	tbf "chainmaker.org/chainmaker/store/v2/types/blockfile"
+	"chainmaker.org/chainmaker/vm/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulateMethod the full gRPC method of the dry run of a tx, served next to the RpcNode service. It takes a
+// common.TxRequest and returns a SimulateResponse holding the result, the contract events, the rw set and the gas
+// breakdown of the tx, which is never submitted. A QueryBlockHeightKey parameter runs it as of a history height.
+const SimulateMethod = "/api.RpcSimulator/Simulate"
+
+var _ RpcSimulatorServer = (*ApiService)(nil)
+
+// Simulate - run an invoke or query tx against the latest state, or the state of a history height, and return
+// its result, events, rw set and gas without submitting it. The tx is verified like the txs sent to SendRequest.
+func (s *ApiService) Simulate(ctx context.Context, req *commonPb.TxRequest) (*SimulateResponse, error) {
+	if req.Payload == nil || req.Sender == nil || req.Sender.Signer == nil {
+		return nil, status.Error(codes.InvalidArgument, "payload and sender of the tx are required")
+	}
+	startTime := time.Now()
+	tx := &commonPb.Transaction{
+		Payload:   req.Payload,
+		Sender:    req.Sender,
+		Endorsers: req.Endorsers,
+		Payer:     req.Payer,
+	}
+	info, gas, err := s.simulate(tx)
+	elapsed := time.Since(startTime)
+
+	var code, message string
+	if err != nil {
+		code, message = status.Code(err).String(), err.Error()
+	} else {
+		code, message = info.Transaction.Result.Code.String(), info.Transaction.Result.Message
+	}
+
+	// audit log format: ip:port|orgId|chainId|TxType|TxId|Timestamp|ContractName|Method|retCode|retCodeMsg|retMsg
+	// |invokeElapsed
+	s.logBrief.Infof("|%s|%s|%s|SIMULATE_%s|%s|%d|%s|%s|%s|%s|%s|%d", GetClientAddr(ctx), req.Sender.Signer.OrgId,
+		req.Payload.ChainId, req.Payload.TxType, req.Payload.TxId, req.Payload.Timestamp, req.Payload.ContractName,
+		req.Payload.Method, code, code, message, elapsed.Milliseconds())
+
+	if err != nil {
+		return nil, err
+	}
+	gas.Total = gas.Tx + gas.RwSet + gas.Events
+	return &SimulateResponse{TxInfo: info, Gas: gas}, nil
+}
+
+// simulate run the tx in a tx sim context of a query snapshot, which is dropped afterwards
+// nolint: gocyclo
+func (s *ApiService) simulate(tx *commonPb.Transaction) (*commonPb.TransactionInfoWithRWSet, *SimulateGas,
+	error) {
+	chainId := tx.Payload.ChainId
+	if tx.Payload.TxType != commonPb.TxType_INVOKE_CONTRACT && tx.Payload.TxType != commonPb.TxType_QUERY_CONTRACT {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "tx type %s can not be simulated", tx.Payload.TxType)
+	}
+	if chainId == SYSTEM_CHAIN {
+		return nil, nil, status.Error(codes.InvalidArgument, "txs of the system chain can not be simulated")
+	}
+	if errCode, errMsg, code := s.validateTx(tx); errCode != commonErr.ERR_CODE_OK {
+		return nil, nil, status.Error(code, errMsg)
+	}
+
+	store, err := s.chainMakerServer.GetStore(chainId)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, s.getErrMsg(commonErr.ERR_CODE_GET_STORE, err))
+	}
+	vmMgr, err := s.chainMakerServer.GetVmManager(chainId)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, s.getErrMsg(commonErr.ERR_CODE_GET_VM_MGR, err))
+	}
+	queryHeight, isHistory, params, err := getQueryBlockHeight(tx.Payload.Parameters)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log := logger.GetLoggerByChain(logger.MODULE_SNAPSHOT, chainId)
+	var snap protocol.Snapshot
+	if isHistory {
+		snap, err = snapshot.NewHistoryQuerySnapshot(store, queryHeight, log)
+	} else {
+		snap, err = snapshot.NewQuerySnapshot(store, log)
+	}
+	if err == tbf.ErrArchivedBlock {
+		return nil, nil, status.Error(codes.OutOfRange, err.Error())
+	} else if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blockVersion := protocol.DefaultBlockVersion
+	if cc, err1 := s.chainMakerServer.GetChainConf(chainId); err1 == nil && cc.ChainConfig().GetBlockVersion() != 0 {
+		blockVersion = cc.ChainConfig().GetBlockVersion()
+	}
+	txSimContext := vm.NewTxSimContext(vmMgr, snap, tx, blockVersion, log)
+
+	contract, err := txSimContext.GetContractByName(tx.Payload.ContractName)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	var bytecode []byte
+	if contract.RuntimeType != commonPb.RuntimeType_NATIVE &&
+		contract.RuntimeType != commonPb.RuntimeType_GO &&
+		contract.RuntimeType != commonPb.RuntimeType_DOCKER_GO {
+		if bytecode, err = txSimContext.GetContractBytecode(contract.Name); err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	gas := &SimulateGas{}
+	if blockVersion2312 <= blockVersion {
+		if gas.Tx, err = calcTxGasUsed(txSimContext, s.log); err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "calculate tx gas failed, %s", err)
+		}
+	}
+	txResult, _, txStatusCode := vmMgr.RunContract(contract, tx.Payload.Method, bytecode, s.kvPair2Map(params),
+		txSimContext, gas.Tx, tx.Payload.TxType)
+	success := txStatusCode == commonPb.TxStatusCode_SUCCESS && txResult.Code == 0
+	if blockVersion2312 <= blockVersion {
+		if gas.RwSet, err = calcTxRWSetGasUsed(txSimContext, success, s.log); err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "calculate tx rw_set gas failed, %s", err)
+		}
+		if gas.Events, err = calcTxEventGasUsed(txSimContext, txResult.ContractEvent, s.log); err != nil {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "calculate tx events gas failed, %s", err)
+		}
+		txResult.GasUsed += gas.RwSet + gas.Events
+	}
+
+	result := &commonPb.Result{
+		Code:           txStatusCode,
+		ContractResult: txResult,
+		Message:        txStatusCode.String(),
+	}
+	if txStatusCode != commonPb.TxStatusCode_SUCCESS {
+		result.Message = fmt.Sprintf("txStatusCode:%d, resultCode:%d, contractName[%s] method[%s] txType[%s], %s",
+			txStatusCode, txResult.Code, tx.Payload.ContractName, tx.Payload.Method, tx.Payload.TxType,
+			txResult.Message)
+	} else if txResult.Code == 1 {
+		result.Code = commonPb.TxStatusCode_CONTRACT_FAIL
+		result.Message = commonPb.TxStatusCode_CONTRACT_FAIL.String()
+	}
+	tx.Result = result
+
+	return &commonPb.TransactionInfoWithRWSet{
+		Transaction:    tx,
+		BlockHeight:    snap.GetBlockHeight(),
+		BlockTimestamp: snap.GetBlockTimestamp(),
+		RwSet:          txSimContext.GetTxRWSet(success),
+	}, gas, nil
+}
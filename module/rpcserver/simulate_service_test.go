@@ -0,0 +1,81 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker/logger/v2"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSimulate_InvalidTx(t *testing.T) {
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), logBrief: logger.GetLogger(logger.MODULE_BRIEF)}
+
+	_, err := s.Simulate(context.Background(), &commonPb.TxRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.Simulate(context.Background(), newTestTxRequest(commonPb.TxType_ARCHIVE, "contract1", "org1"))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSimulate_UnknownChain(t *testing.T) {
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), logBrief: logger.GetLogger(logger.MODULE_BRIEF),
+		chainMakerServer: blockchain.NewChainMakerServer()}
+
+	// the chain is not on the node, which fails on the node rather than on the tx or its permission
+	_, err := s.Simulate(context.Background(),
+		newTestTxRequest(commonPb.TxType_QUERY_CONTRACT, "contract1", "org1"))
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestSimulateHandler(t *testing.T) {
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), logBrief: logger.GetLogger(logger.MODULE_BRIEF)}
+	req := newTestTxRequest(commonPb.TxType_ARCHIVE, "contract1", "org1")
+	dec := func(in interface{}) error {
+		in.(*commonPb.TxRequest).Payload = req.Payload
+		in.(*commonPb.TxRequest).Sender = req.Sender
+		return nil
+	}
+
+	var fullMethod string
+	interceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		fullMethod = info.FullMethod
+		return handler(ctx, req)
+	}
+	_, err := _RpcSimulator_Simulate_Handler(s, context.Background(), dec, interceptor)
+	require.Equal(t, SimulateMethod, fullMethod)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSimulateResponse_Marshal(t *testing.T) {
+	resp := &SimulateResponse{
+		TxInfo: &commonPb.TransactionInfoWithRWSet{
+			Transaction: &commonPb.Transaction{Payload: &commonPb.Payload{TxId: "tx1"}},
+			BlockHeight: 10,
+			RwSet:       &commonPb.TxRWSet{TxId: "tx1"},
+		},
+		Gas: &SimulateGas{Tx: 1, RwSet: 2, Events: 3, Total: 6},
+	}
+	bz, err := proto.Marshal(resp)
+	require.NoError(t, err)
+
+	decoded := &SimulateResponse{}
+	require.NoError(t, proto.Unmarshal(bz, decoded))
+	require.Equal(t, "tx1", decoded.TxInfo.Transaction.Payload.TxId)
+	require.EqualValues(t, 10, decoded.TxInfo.BlockHeight)
+	require.Equal(t, "tx1", decoded.TxInfo.RwSet.TxId)
+	require.Equal(t, resp.Gas, decoded.Gas)
+}
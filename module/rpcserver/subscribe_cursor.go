@@ -0,0 +1,111 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+//go:generate protoc -I=. --gogofaster_out=paths=source_relative:. subscribe_cursor.proto
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	protocol "chainmaker.org/chainmaker/protocol/v2"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// SubscribeCursorKey the payload parameter of a subscribe request carrying the cursor of the last
+	// result the client processed, the new subscription resumes right after that result
+	SubscribeCursorKey = "CURSOR"
+
+	subscribeCursorVersion = "v1"
+)
+
+// subscribeCursor the position of a result in the ledger: the block height and, for tx subscriptions,
+// the index of the tx in the block. Index -1 means every result of the block was delivered.
+type subscribeCursor struct {
+	method string
+	height uint64
+	index  int64
+}
+
+func newSubscribeCursor(method string, height uint64, index int64) *subscribeCursor {
+	return &subscribeCursor{method: method, height: height, index: index}
+}
+
+// String returns the opaque form of the cursor handed to clients
+func (c *subscribeCursor) String() string {
+	raw := fmt.Sprintf("%s/%s/%d/%d", subscribeCursorVersion, c.method, c.height, c.index)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// nextHeight the first block height which may hold results not delivered yet
+func (c *subscribeCursor) nextHeight() int64 {
+	if c.index < 0 {
+		return int64(c.height) + 1
+	}
+	return int64(c.height)
+}
+
+// delivered returns whether the tx of the given index in the block of the given height was delivered
+func (c *subscribeCursor) delivered(height uint64, index int) bool {
+	return c != nil && (height < c.height || height == c.height && (c.index < 0 || int64(index) <= c.index))
+}
+
+// parseSubscribeCursor decode a cursor issued for the subscription of the given method
+func parseSubscribeCursor(cursor, method string) (*subscribeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscribe cursor, %s", err)
+	}
+	parts := strings.Split(string(raw), "/")
+	if len(parts) != 4 || parts[0] != subscribeCursorVersion {
+		return nil, errors.New("invalid subscribe cursor")
+	}
+	if parts[1] != method {
+		return nil, fmt.Errorf("subscribe cursor of %s can not be used by %s", parts[1], method)
+	}
+	height, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscribe cursor height, %s", err)
+	}
+	index, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil || index < -1 {
+		return nil, fmt.Errorf("invalid subscribe cursor index %s", parts[3])
+	}
+	return newSubscribeCursor(method, height, index), nil
+}
+
+// appendSubscribeCursor append the cursor to the marshaled result as a SubscribeResultCursor
+func appendSubscribeCursor(data []byte, cursor *subscribeCursor) ([]byte, error) {
+	bz, err := proto.Marshal(&SubscribeResultCursor{Cursor: cursor.String()})
+	if err != nil {
+		return nil, err
+	}
+	return append(data, bz...), nil
+}
+
+// SubscribeCursorFromData returns the cursor carried by SubscribeResult.Data, false if there is none
+func SubscribeCursorFromData(data []byte) (string, bool) {
+	cursor := &SubscribeResultCursor{}
+	if err := proto.Unmarshal(data, cursor); err != nil || len(cursor.Cursor) == 0 {
+		return "", false
+	}
+	return cursor.Cursor, true
+}
+
+// isBeyondLastBlock returns whether the height is not in the ledger yet, a subscription resumed from
+// such a height only needs the live phase
+func isBeyondLastBlock(store protocol.BlockchainStore, height int64) (bool, error) {
+	lastBlock, err := store.GetLastBlock()
+	if err != nil {
+		return false, err
+	}
+	return height > int64(lastBlock.Header.BlockHeight), nil
+}
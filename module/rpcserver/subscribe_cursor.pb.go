@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: subscribe_cursor.proto
+
+package rpcserver
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeResultCursor the cursor pushed in SubscribeResult.Data, see SubscribeCursorKey. It is marshaled after
+// the result, and decoding the concatenated messages merges them, so Data decodes as both the result and
+// SubscribeResultCursor. BlockInfo, BlockHeader, Transaction and ContractEventInfoList never use its field number,
+// the clients unaware of the cursor skip it as an unknown field.
+type SubscribeResultCursor struct {
+	// the opaque cursor passed back in the CURSOR parameter to resume the subscription
+	Cursor string `protobuf:"bytes,1000,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *SubscribeResultCursor) Reset()         { *m = SubscribeResultCursor{} }
+func (m *SubscribeResultCursor) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResultCursor) ProtoMessage()    {}
+func (*SubscribeResultCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_984149ae5329dea2, []int{0}
+}
+func (m *SubscribeResultCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeResultCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeResultCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeResultCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResultCursor.Merge(m, src)
+}
+func (m *SubscribeResultCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeResultCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResultCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeResultCursor proto.InternalMessageInfo
+
+func (m *SubscribeResultCursor) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SubscribeResultCursor)(nil), "api.SubscribeResultCursor")
+}
+
+func init() { proto.RegisterFile("subscribe_cursor.proto", fileDescriptor_984149ae5329dea2) }
+
+var fileDescriptor_984149ae5329dea2 = []byte{
+	// 153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0x2e, 0x4d, 0x2a,
+	0x4e, 0x2e, 0xca, 0x4c, 0x4a, 0x8d, 0x4f, 0x2e, 0x2d, 0x2a, 0xce, 0x2f, 0xd2, 0x2b, 0x28, 0xca,
+	0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x54, 0x32, 0xe0, 0x12, 0x0d, 0x86, 0x49, 0x07, 0xa5,
+	0x16, 0x97, 0xe6, 0x94, 0x38, 0x83, 0xd5, 0x08, 0x89, 0x73, 0xb1, 0x41, 0x54, 0x4b, 0xbc, 0x60,
+	0x57, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x72, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
+	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
+	0x58, 0x8e, 0x21, 0x4a, 0x37, 0x39, 0x23, 0x31, 0x33, 0x2f, 0x37, 0x31, 0x3b, 0xb5, 0x48, 0x2f,
+	0xbf, 0x28, 0x5d, 0x1f, 0xc1, 0xd5, 0x4d, 0xcf, 0xd7, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0xd5,
+	0x2f, 0x2a, 0x48, 0x2e, 0x4e, 0x2d, 0x2a, 0x4b, 0x2d, 0x4a, 0x62, 0x03, 0x3b, 0xc3, 0x18, 0x30,
+	0x00, 0xaf, 0xa5, 0x6c, 0xc9, 0xa0, 0x00, 0x00, 0x00,
+}
+
+func (m *SubscribeResultCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeResultCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeResultCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintSubscribeCursor(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x3e
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSubscribeCursor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSubscribeCursor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeResultCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 2 + l + sovSubscribeCursor(uint64(l))
+	}
+	return n
+}
+
+func sovSubscribeCursor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSubscribeCursor(x uint64) (n int) {
+	return sovSubscribeCursor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeResultCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscribeCursor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeResultCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeResultCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1000:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscribeCursor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscribeCursor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscribeCursor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscribeCursor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscribeCursor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSubscribeCursor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSubscribeCursor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscribeCursor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSubscribeCursor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSubscribeCursor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSubscribeCursor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSubscribeCursor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSubscribeCursor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSubscribeCursor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSubscribeCursor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/pb-go/v2/syscontract"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeCursor_Parse(t *testing.T) {
+	method := syscontract.SubscribeFunction_SUBSCRIBE_TX.String()
+	cursor := newSubscribeCursor(method, 10, 3)
+
+	parsed, err := parseSubscribeCursor(cursor.String(), method)
+	require.NoError(t, err)
+	require.Equal(t, cursor, parsed)
+	require.Equal(t, int64(10), parsed.nextHeight())
+	require.True(t, parsed.delivered(10, 3))
+	require.False(t, parsed.delivered(10, 4))
+	require.False(t, parsed.delivered(11, 0))
+
+	complete := newSubscribeCursor(method, 10, -1)
+	require.Equal(t, int64(11), complete.nextHeight())
+	require.True(t, complete.delivered(10, 100))
+
+	var none *subscribeCursor
+	require.False(t, none.delivered(0, 0))
+
+	_, err = parseSubscribeCursor(cursor.String(), syscontract.SubscribeFunction_SUBSCRIBE_BLOCK.String())
+	require.Error(t, err)
+	_, err = parseSubscribeCursor("not a cursor", method)
+	require.Error(t, err)
+}
+
+func TestSubscribeCursor_AppendToData(t *testing.T) {
+	header := &commonPb.BlockHeader{ChainId: "chain1", BlockHeight: 10, BlockHash: []byte("hash")}
+	data, err := proto.Marshal(header)
+	require.NoError(t, err)
+
+	_, ok := SubscribeCursorFromData(data)
+	require.False(t, ok)
+
+	cursor := newSubscribeCursor(syscontract.SubscribeFunction_SUBSCRIBE_BLOCK.String(), 10, -1)
+	data, err = appendSubscribeCursor(data, cursor)
+	require.NoError(t, err)
+
+	value, ok := SubscribeCursorFromData(data)
+	require.True(t, ok)
+	require.Equal(t, cursor.String(), value)
+
+	// clients unaware of the cursor still decode the result
+	decoded := &commonPb.BlockHeader{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	require.Equal(t, header.BlockHeight, decoded.BlockHeight)
+	require.Equal(t, header.BlockHash, decoded.BlockHash)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+)
+
+// SubscribeContractEventFilterKey the payload parameter of a contract event subscription carrying
+// the json encoded content filter, see contractEventFilter
+const SubscribeContractEventFilterKey = "FILTER"
+
+// contractEventFilter the content filter of a contract event subscription. Every condition given must
+// match for an event to be sent, a list matches if any of its values does. For example:
+//
+//	{"data": [{"index": 0, "equals": "transfer"}, {"index": 1, "path": "to.org", "in": ["org1", "org2"]}],
+//	 "senders": ["2a6f..."], "org_ids": ["org1"], "result_codes": ["SUCCESS"]}
+type contractEventFilter struct {
+	// Data conditions on the event data, one per item
+	Data []*eventDataCondition `json:"data,omitempty"`
+	// Senders addresses of the tx sender
+	Senders []string `json:"senders,omitempty"`
+	// OrgIds org ids of the tx sender
+	OrgIds []string `json:"org_ids,omitempty"`
+	// ResultCodes tx result codes, by name such as SUCCESS or by number
+	ResultCodes []string `json:"result_codes,omitempty"`
+
+	senders     map[string]struct{}
+	orgIds      map[string]struct{}
+	resultCodes map[commonPb.TxStatusCode]struct{}
+}
+
+// eventDataCondition a condition on one item of ContractEvent.EventData. Path selects a value inside
+// the item decoded as json, such as "a.b[0]". The value must equal Equals, be one of In or start with
+// Prefix, when none of them is given the value only needs to exist.
+type eventDataCondition struct {
+	Index  int      `json:"index"`
+	Path   string   `json:"path,omitempty"`
+	Equals *string  `json:"equals,omitempty"`
+	In     []string `json:"in,omitempty"`
+	Prefix string   `json:"prefix,omitempty"`
+
+	path []string
+}
+
+// parseContractEventFilter decode and check the filter of a subscribe request
+func parseContractEventFilter(value []byte) (*contractEventFilter, error) {
+	filter := &contractEventFilter{}
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(filter); err != nil {
+		return nil, fmt.Errorf("invalid contract event filter, %s", err)
+	}
+
+	for i, cond := range filter.Data {
+		if cond == nil || cond.Index < 0 {
+			return nil, fmt.Errorf("invalid contract event filter, data[%d] needs a non-negative index", i)
+		}
+		path, err := parseEventDataPath(cond.Path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract event filter, data[%d], %s", i, err)
+		}
+		cond.path = path
+	}
+	filter.senders = stringSet(filter.Senders)
+	filter.orgIds = stringSet(filter.OrgIds)
+	if len(filter.ResultCodes) > 0 {
+		filter.resultCodes = make(map[commonPb.TxStatusCode]struct{}, len(filter.ResultCodes))
+	}
+	for _, code := range filter.ResultCodes {
+		statusCode, err := parseTxStatusCode(code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid contract event filter, %s", err)
+		}
+		filter.resultCodes[statusCode] = struct{}{}
+	}
+	return filter, nil
+}
+
+// matchTx returns whether the tx conditions of the filter match, senderAddr is only called when
+// the filter has senders as it may need to load the certificate of the sender
+func (f *contractEventFilter) matchTx(tx *commonPb.Transaction, senderAddr func() (string, error)) bool {
+	if f == nil {
+		return true
+	}
+	if f.resultCodes != nil {
+		if tx.Result == nil {
+			return false
+		}
+		if _, ok := f.resultCodes[tx.Result.Code]; !ok {
+			return false
+		}
+	}
+	if f.orgIds != nil {
+		if tx.Sender == nil || tx.Sender.Signer == nil {
+			return false
+		}
+		if _, ok := f.orgIds[tx.Sender.Signer.OrgId]; !ok {
+			return false
+		}
+	}
+	if f.senders != nil {
+		if tx.Sender == nil || tx.Sender.Signer == nil {
+			return false
+		}
+		addr, err := senderAddr()
+		if err != nil {
+			return false
+		}
+		if _, ok := f.senders[addr]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// matchEvent returns whether the event data conditions of the filter match
+func (f *contractEventFilter) matchEvent(event *commonPb.ContractEvent) bool {
+	if f == nil {
+		return true
+	}
+	for _, cond := range f.Data {
+		if !cond.match(event.EventData) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *eventDataCondition) match(eventData []string) bool {
+	if c.Index >= len(eventData) {
+		return false
+	}
+	value := eventData[c.Index]
+	if len(c.path) > 0 {
+		var ok bool
+		if value, ok = lookupEventDataPath(value, c.path); !ok {
+			return false
+		}
+	}
+
+	if c.Equals != nil && value != *c.Equals {
+		return false
+	}
+	if len(c.In) > 0 && !containsString(c.In, value) {
+		return false
+	}
+	return strings.HasPrefix(value, c.Prefix)
+}
+
+// parseEventDataPath split a path like "a.b[0].c" or "$.a.b.0.c" into its keys and indexes
+func parseEventDataPath(path string) ([]string, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, nil
+	}
+	var keys []string
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		var indexes []string
+		if i := strings.Index(part, "["); i >= 0 {
+			key = part[:i]
+			for rest := part[i:]; rest != ""; {
+				end := strings.Index(rest, "]")
+				if rest[0] != '[' || end < 0 {
+					return nil, fmt.Errorf("malformed path %q", path)
+				}
+				index := rest[1:end]
+				if n, err := strconv.Atoi(index); err != nil || n < 0 {
+					return nil, fmt.Errorf("malformed index %q in path %q", index, path)
+				}
+				indexes = append(indexes, index)
+				rest = rest[end+1:]
+			}
+		}
+		if key == "" && len(indexes) == 0 {
+			return nil, fmt.Errorf("empty key in path %q", path)
+		}
+		if key != "" {
+			keys = append(keys, key)
+		}
+		keys = append(keys, indexes...)
+	}
+	return keys, nil
+}
+
+// lookupEventDataPath returns the value at the path of the json document data, values which are not
+// strings are returned as compact json
+func lookupEventDataPath(data string, path []string) (string, bool) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return "", false
+	}
+	for _, key := range path {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			value, ok := node[key]
+			if !ok {
+				return "", false
+			}
+			doc = value
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false
+			}
+			doc = node[index]
+		default:
+			return "", false
+		}
+	}
+	if value, ok := doc.(string); ok {
+		return value, true
+	}
+	value, err := json.Marshal(doc)
+	if err != nil {
+		return "", false
+	}
+	return string(value), true
+}
+
+func parseTxStatusCode(code string) (commonPb.TxStatusCode, error) {
+	if value, ok := commonPb.TxStatusCode_value[code]; ok {
+		return commonPb.TxStatusCode(value), nil
+	}
+	value, err := strconv.ParseInt(code, 10, 32)
+	if err == nil {
+		if _, ok := commonPb.TxStatusCode_name[int32(value)]; ok {
+			return commonPb.TxStatusCode(value), nil
+		}
+	}
+	return 0, errors.New("unknown tx result code " + code)
+}
+
+func stringSet(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"errors"
+	"testing"
+
+	pbac "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContractEventFilter_Malformed(t *testing.T) {
+	for _, filter := range []string{
+		`not json`,
+		`{"unknown": 1}`,
+		`{"data": [{"index": -1}]}`,
+		`{"data": [{"index": 0, "path": "a..b"}]}`,
+		`{"data": [{"index": 0, "path": "a[x]"}]}`,
+		`{"data": [{"index": 0, "path": "a[0"}]}`,
+		`{"result_codes": ["NOT_A_CODE"]}`,
+		`{"result_codes": ["99999"]}`,
+	} {
+		_, err := parseContractEventFilter([]byte(filter))
+		require.Error(t, err, filter)
+	}
+
+	filter, err := parseContractEventFilter([]byte(`{}`))
+	require.NoError(t, err)
+	require.True(t, filter.matchEvent(&commonPb.ContractEvent{}))
+}
+
+func TestContractEventFilter_MatchEvent(t *testing.T) {
+	filter, err := parseContractEventFilter([]byte(`{"data": [
+		{"index": 0, "equals": "transfer"},
+		{"index": 1, "path": "$.to.orgs[1]", "in": ["org2", "org3"]},
+		{"index": 1, "path": "amount", "prefix": "10"}]}`))
+	require.NoError(t, err)
+
+	event := &commonPb.ContractEvent{EventData: []string{"transfer",
+		`{"to": {"orgs": ["org1", "org2"]}, "amount": 100}`}}
+	require.True(t, filter.matchEvent(event))
+
+	event.EventData[0] = "mint"
+	require.False(t, filter.matchEvent(event))
+
+	event.EventData = []string{"transfer", `{"to": {"orgs": ["org1"]}, "amount": 100}`}
+	require.False(t, filter.matchEvent(event))
+
+	event.EventData = []string{"transfer", `not json`}
+	require.False(t, filter.matchEvent(event))
+
+	event.EventData = []string{"transfer"}
+	require.False(t, filter.matchEvent(event))
+}
+
+func TestContractEventFilter_MatchTx(t *testing.T) {
+	filter, err := parseContractEventFilter([]byte(
+		`{"senders": ["addr1"], "org_ids": ["org1"], "result_codes": ["SUCCESS"]}`))
+	require.NoError(t, err)
+
+	tx := &commonPb.Transaction{
+		Sender: &commonPb.EndorsementEntry{Signer: &pbac.Member{OrgId: "org1"}},
+		Result: &commonPb.Result{Code: commonPb.TxStatusCode_SUCCESS},
+	}
+	addr := func(a string, err error) func() (string, error) {
+		return func() (string, error) { return a, err }
+	}
+	require.True(t, filter.matchTx(tx, addr("addr1", nil)))
+	require.False(t, filter.matchTx(tx, addr("addr2", nil)))
+	require.False(t, filter.matchTx(tx, addr("", errors.New("no cert"))))
+
+	tx.Result.Code = commonPb.TxStatusCode_CONTRACT_FAIL
+	require.False(t, filter.matchTx(tx, addr("addr1", nil)))
+
+	tx.Result.Code = commonPb.TxStatusCode_SUCCESS
+	tx.Sender.Signer.OrgId = "org2"
+	require.False(t, filter.matchTx(tx, addr("addr1", nil)))
+
+	var none *contractEventFilter
+	require.True(t, none.matchTx(tx, nil))
+}
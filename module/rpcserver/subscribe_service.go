@@ -11,6 +11,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"chainmaker.org/chainmaker-go/module/rpcserver/rateLimiter"
@@ -67,6 +68,11 @@ func (s *ApiService) Subscribe(req *commonPb.TxRequest, server apiPb.RpcNode_Sub
 		return status.Error(codes.Unauthenticated, errMsg)
 	}
 
+	// the subscription ends with the chain if the chain is left
+	ctx, done := chainSubscriptions.add(req.Payload.ChainId, server.Context())
+	defer done()
+	server = &chainSubscribeServer{RpcNode_SubscribeServer: server, ctx: ctx}
+
 	switch req.Payload.Method {
 	case syscontract.SubscribeFunction_SUBSCRIBE_BLOCK.String():
 		return s.dealBlockSubscription(tx, server)
@@ -79,6 +85,59 @@ func (s *ApiService) Subscribe(req *commonPb.TxRequest, server apiPb.RpcNode_Sub
 	return nil
 }
 
+// chainSubscribeServer a subscribe server whose context also ends when the chain is left
+type chainSubscribeServer struct {
+	apiPb.RpcNode_SubscribeServer
+	ctx context.Context
+}
+
+// Context returns the context of the subscription
+func (s *chainSubscribeServer) Context() context.Context {
+	return s.ctx
+}
+
+// subscriptionRegistry the cancel funcs of the subscriptions of each chain
+type subscriptionRegistry struct {
+	mu      sync.Mutex
+	nextId  uint64
+	cancels map[string]map[uint64]context.CancelFunc
+}
+
+var chainSubscriptions = &subscriptionRegistry{cancels: make(map[string]map[uint64]context.CancelFunc)}
+
+// add returns the context of a subscription of the chain, done must be called when the subscription ends
+func (r *subscriptionRegistry) add(chainId string, parent context.Context) (ctx context.Context, done func()) {
+	ctx, cancel := context.WithCancel(parent)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextId++
+	id := r.nextId
+	if r.cancels[chainId] == nil {
+		r.cancels[chainId] = make(map[uint64]context.CancelFunc)
+	}
+	r.cancels[chainId][id] = cancel
+
+	return ctx, func() {
+		cancel()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		delete(r.cancels[chainId], id)
+		if len(r.cancels[chainId]) == 0 {
+			delete(r.cancels, chainId)
+		}
+	}
+}
+
+// cancelChain end all the subscriptions of the chain
+func (r *subscriptionRegistry) cancelChain(chainId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, cancel := range r.cancels[chainId] {
+		cancel()
+	}
+}
+
 func (s *ApiService) checkAndGetLastBlockHeight(store protocol.BlockchainStore,
 	payloadStartBlockHeight int64) (int64, error) {
 
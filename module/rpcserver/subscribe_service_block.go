@@ -12,8 +12,7 @@ import (
 	"fmt"
 	"sync/atomic"
 
-	"chainmaker.org/chainmaker/localconf/v2"
-
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/subscriber/model"
 	"chainmaker.org/chainmaker/common/v2/bytehelper"
 	commonErr "chainmaker.org/chainmaker/common/v2/errors"
@@ -29,7 +28,7 @@ import (
 )
 
 func (s *ApiService) checkDealBlockSubscriptionParams(tx *commonPb.Transaction) (startBlock, endBlock int64,
-	withRWSet, onlyHeader bool, err error) {
+	withRWSet, onlyHeader bool, cursor *subscribeCursor, err error) {
 	for _, kv := range tx.Payload.Parameters {
 		if kv.Key == syscontract.SubscribeBlock_START_BLOCK.String() {
 			startBlock, err = bytehelper.BytesToInt64(kv.Value)
@@ -44,17 +43,19 @@ func (s *ApiService) checkDealBlockSubscriptionParams(tx *commonPb.Transaction)
 				onlyHeader = true
 				withRWSet = false
 			}
+		} else if kv.Key == SubscribeCursorKey {
+			cursor, err = parseSubscribeCursor(string(kv.Value), syscontract.SubscribeFunction_SUBSCRIBE_BLOCK.String())
 		}
 
 		if err != nil {
 			errCode := commonErr.ERR_CODE_CHECK_PAYLOAD_PARAM_SUBSCRIBE_BLOCK
 			errMsg := s.getErrMsg(errCode, err)
-			return 0, 0, false, false,
+			return 0, 0, false, false, nil,
 				status.Error(codes.InvalidArgument, errMsg)
 		}
 	}
 
-	return startBlock, endBlock, withRWSet, onlyHeader, nil
+	return startBlock, endBlock, withRWSet, onlyHeader, cursor, nil
 }
 
 // dealBlockSubscription - deal block subscribe request
@@ -70,6 +71,8 @@ func (s *ApiService) dealBlockSubscription(tx *commonPb.Transaction,
 		endBlock        int64
 		withRWSet       bool
 		onlyHeader      bool
+		cursor          *subscribeCursor
+		resumeLive      bool
 		reqSender       protocol.Role
 		txId            = tx.Payload.TxId
 		chainId         = tx.Payload.ChainId
@@ -78,7 +81,7 @@ func (s *ApiService) dealBlockSubscription(tx *commonPb.Transaction,
 	)
 
 	defer func() {
-		if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+		if confreload.Config().MonitorConfig.Enabled {
 			// metric subscribe active counter
 			s.metricSubscribeActiveCounter.WithLabelValues(chainId, senderAddr, subscribeType, "", "").Dec()
 			// if the function returns an error, count the number of subscription interruptions
@@ -103,14 +106,14 @@ func (s *ApiService) dealBlockSubscription(tx *commonPb.Transaction,
 		return err
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		//metric subscribe total counter
 		s.metricSubscribeTotalCounter.WithLabelValues(chainId, senderAddr, subscribeType, "", "").Inc()
 		//metric subscribe active counter
 		s.metricSubscribeActiveCounter.WithLabelValues(chainId, senderAddr, subscribeType, "", "").Inc()
 	}
 
-	startBlock, endBlock, withRWSet, onlyHeader, err = s.checkDealBlockSubscriptionParams(tx)
+	startBlock, endBlock, withRWSet, onlyHeader, cursor, err = s.checkDealBlockSubscriptionParams(tx)
 	if err != nil {
 		s.log.Warnf(fmt.Sprintf("check deal block subscription params failed, err:%s,[txId:%s].",
 			err, txId))
@@ -128,8 +131,23 @@ func (s *ApiService) dealBlockSubscription(tx *commonPb.Transaction,
 		"Recv block subscribe request: [start:%d]/[end:%d]/[withRWSet:%v]/[onlyHeader:%v]/[txId:%s,chainId:%s]",
 		startBlock, endBlock, withRWSet, onlyHeader, txId, chainId)
 
+	if cursor != nil {
+		// resume right after the cursor, the start block of the request is ignored
+		startBlock = cursor.nextHeight()
+		if endBlock != -1 && startBlock > endBlock {
+			return status.Error(codes.OK, "OK")
+		}
+		if resumeLive, err = isBeyondLastBlock(db, startBlock); err != nil {
+			errCode = commonErr.ERR_CODE_GET_LAST_BLOCK
+			errMsg = s.getErrMsg(errCode, err)
+			s.log.Warnf(errMsg + fmt.Sprintf("[txId:%s, sender:%s]", txId, senderAddr))
+			return status.Error(codes.Internal, errMsg)
+		}
+		s.log.Infof("resume block subscription from height %d[txId:%s, sender:%s]", startBlock, txId, senderAddr)
+	}
+
 	// 计算addr之前，统一在日志中返回string的tx.Sender.Signer.MemberInfo
-	if lastBlockHeight, err = s.checkAndGetLastBlockHeight(db, startBlock); err != nil {
+	if lastBlockHeight, err = s.checkAndGetLastBlockHeight(db, startBlock); err != nil && !resumeLive {
 		if lastBlockHeight > 0 {
 			startBlock = lastBlockHeight
 			s.log.Warnf("Set startBlock to the latestBlockHeight[txId:%s, sender:%s]", txId, senderAddr)
@@ -148,6 +166,12 @@ func (s *ApiService) dealBlockSubscription(tx *commonPb.Transaction,
 		return err
 	}
 
+	// the cursor is ahead of the ledger of this node, the blocks after it are sent in the live phase
+	if resumeLive {
+		return s.sendNewBlock(db, tx, server, endBlock, withRWSet, onlyHeader,
+			startBlock-1, reqSender, reqSenderOrgId, senderAddr)
+	}
+
 	if startBlock == -1 && endBlock == -1 {
 		return s.sendNewBlock(db, tx, server, endBlock, withRWSet, onlyHeader,
 			-1, reqSender, reqSenderOrgId, senderAddr)
@@ -376,6 +400,13 @@ func (s *ApiService) getBlockSubscribeResult(blockInfo *commonPb.BlockInfo,
 		return nil, errors.New(errMsg)
 	}
 
+	cursor := newSubscribeCursor(syscontract.SubscribeFunction_SUBSCRIBE_BLOCK.String(),
+		blockInfo.Block.Header.BlockHeight, -1)
+	if resultBytes, err = appendSubscribeCursor(resultBytes, cursor); err != nil {
+		errMsg := fmt.Sprintf("marshal subscribe cursor failed, %s", err)
+		s.log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
 	result := &commonPb.SubscribeResult{
 		Data: resultBytes,
 	}
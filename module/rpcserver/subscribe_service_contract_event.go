@@ -15,8 +15,7 @@ import (
 
 	"chainmaker.org/chainmaker/utils/v2"
 
-	"chainmaker.org/chainmaker/localconf/v2"
-
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/subscriber/model"
 	"chainmaker.org/chainmaker/common/v2/bytehelper"
 	commonErr "chainmaker.org/chainmaker/common/v2/errors"
@@ -30,7 +29,8 @@ import (
 )
 
 func (s *ApiService) checkDealContractEventSubscriptionParams(tx *commonPb.Transaction) (
-	startBlock int64, endBlock int64, contractName string, topic string, err error) {
+	startBlock int64, endBlock int64, contractName string, topic string, cursor *subscribeCursor,
+	filter *contractEventFilter, err error) {
 
 	for _, kv := range tx.Payload.Parameters {
 		if kv.Key == syscontract.SubscribeContractEvent_START_BLOCK.String() {
@@ -57,6 +57,11 @@ func (s *ApiService) checkDealContractEventSubscriptionParams(tx *commonPb.Trans
 			if kv.Value != nil {
 				topic = string(kv.Value)
 			}
+		} else if kv.Key == SubscribeCursorKey {
+			cursor, err = parseSubscribeCursor(string(kv.Value),
+				syscontract.SubscribeFunction_SUBSCRIBE_CONTRACT_EVENT.String())
+		} else if kv.Key == SubscribeContractEventFilterKey {
+			filter, err = parseContractEventFilter(kv.Value)
 		}
 
 		if err != nil {
@@ -87,10 +92,12 @@ func (s *ApiService) dealContractEventSubscription(tx *commonPb.Transaction,
 		senderAddr    string
 		contractName  string
 		topic         string
+		cursor        *subscribeCursor
+		filter        *contractEventFilter
 	)
 
 	defer func() {
-		if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+		if confreload.Config().MonitorConfig.Enabled {
 			//metric subscribe active counter
 			s.metricSubscribeActiveCounter.WithLabelValues(chainId, senderAddr, subscribeType, contractName, topic).Dec()
 			// if the function returns an error, count the number of subscription interruptions
@@ -116,14 +123,14 @@ func (s *ApiService) dealContractEventSubscription(tx *commonPb.Transaction,
 		return err
 	}
 
-	startBlock, endBlock, contractName, topic, err = s.checkDealContractEventSubscriptionParams(tx)
+	startBlock, endBlock, contractName, topic, cursor, filter, err = s.checkDealContractEventSubscriptionParams(tx)
 	if err != nil {
 		s.log.Warnf(fmt.Sprintf("check deal contract event subscription params failed, err:%s. [txId:%s]",
 			err, txId))
 		return err
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		//metric subscribe total counter
 		s.metricSubscribeTotalCounter.WithLabelValues(chainId, senderAddr, subscribeType, contractName, topic).Inc()
 		//metric subscribe active counter
@@ -138,10 +145,10 @@ func (s *ApiService) dealContractEventSubscription(tx *commonPb.Transaction,
 	}
 
 	s.log.Infof(
-		"Recv contract event subscribe request: [start:%d]/[end:%d]/[contractName:%s]/[topic:%s]/[txId:%s]",
-		startBlock, endBlock, contractName, topic, txId)
+		"Recv contract event subscribe request: [start:%d]/[end:%d]/[contractName:%s]/[topic:%s]/[filter:%v]/[txId:%s]",
+		startBlock, endBlock, contractName, topic, filter != nil, txId)
 
-	return s.doSendContractEvent(tx, db, server, startBlock, endBlock, contractName, topic)
+	return s.doSendContractEvent(tx, db, server, startBlock, endBlock, contractName, topic, filter, cursor)
 }
 
 func (s *ApiService) checkSubscribeContractEventPayload(startBlockHeight, endBlockHeight int64) error {
@@ -157,10 +164,11 @@ func (s *ApiService) checkSubscribeContractEventPayload(startBlockHeight, endBlo
 
 func (s *ApiService) doSendContractEvent(tx *commonPb.Transaction, db protocol.BlockchainStore,
 	server apiPb.RpcNode_SubscribeServer, startBlock, endBlock int64,
-	contractName string, topic string) error {
+	contractName string, topic string, filter *contractEventFilter, after *subscribeCursor) error {
 
 	var (
 		alreadySendHistoryBlockHeight int64
+		resumeLive                    bool
 		err                           error
 		txId                          = tx.Payload.TxId
 	)
@@ -171,6 +179,26 @@ func (s *ApiService) doSendContractEvent(tx *commonPb.Transaction, db protocol.B
 		return err
 	}
 
+	if after != nil {
+		// resume right after the cursor, the start block of the request is ignored
+		startBlock = after.nextHeight()
+		if endBlock > 0 && startBlock > endBlock {
+			return status.Error(codes.OK, "OK")
+		}
+		if resumeLive, err = isBeyondLastBlock(db, startBlock); err != nil {
+			errMsg := s.getErrMsg(commonErr.ERR_CODE_GET_LAST_BLOCK, err)
+			s.log.Warnf(errMsg+" [txId:%s, addr:%s]", txId, senderAddr)
+			return status.Error(codes.Internal, errMsg)
+		}
+		s.log.Infof("resume contract event subscription from height %d[txId:%s, addr:%s]",
+			startBlock, txId, senderAddr)
+		// the cursor is ahead of the ledger of this node, the events after it are sent in the live phase
+		if resumeLive {
+			return s.sendNewContractEvent(db, tx, server, startBlock, endBlock, contractName, topic, filter,
+				startBlock-1, senderAddr)
+		}
+	}
+
 	if startBlock == -1 && endBlock == 0 {
 		s.log.Infof("send contract event: [sender:%s] [contractName:%s] [topic:%s] "+
 			"[startBlock:%d] [endBlock:%d] [txId:%s, addr:%s]",
@@ -182,12 +210,12 @@ func (s *ApiService) doSendContractEvent(tx *commonPb.Transaction, db protocol.B
 	// == 0 for compatibility
 	if (startBlock == -1 && endBlock == -1) || (startBlock == 0 && endBlock == 0) {
 		return s.sendNewContractEvent(db, tx, server, startBlock, endBlock,
-			contractName, topic, -1, senderAddr)
+			contractName, topic, filter, -1, senderAddr)
 	}
 
 	if startBlock != -1 {
 		if alreadySendHistoryBlockHeight, err = s.doSendHistoryContractEvent(db, server, startBlock, endBlock,
-			contractName, topic, txId, senderAddr); err != nil {
+			contractName, topic, filter, txId, senderAddr); err != nil {
 			s.log.Warnf(err.Error() + fmt.Sprintf("[txId:%s, addr:%s, contractName:%s, topic:%s]",
 				txId, senderAddr, contractName, topic))
 			return err
@@ -202,12 +230,13 @@ func (s *ApiService) doSendContractEvent(tx *commonPb.Transaction, db protocol.B
 		return status.Error(codes.OK, "OK")
 	}
 
-	return s.sendNewContractEvent(db, tx, server, startBlock, endBlock, contractName, topic,
+	return s.sendNewContractEvent(db, tx, server, startBlock, endBlock, contractName, topic, filter,
 		alreadySendHistoryBlockHeight, senderAddr)
 }
 
 func (s *ApiService) doSendHistoryContractEvent(db protocol.BlockchainStore, server apiPb.RpcNode_SubscribeServer,
-	startBlock, endBlock int64, contractName, topic, txId, senderAddr string) (int64, error) {
+	startBlock, endBlock int64, contractName, topic string, filter *contractEventFilter,
+	txId, senderAddr string) (int64, error) {
 
 	var (
 		err             error
@@ -234,7 +263,7 @@ func (s *ApiService) doSendHistoryContractEvent(db protocol.BlockchainStore, ser
 	// only send history contract event
 	if endBlock > 0 && endBlock <= lastBlockHeight {
 		_, err = s.sendHistoryContractEvent(db, server, startBlock, endBlock,
-			contractName, topic, txId, senderAddr)
+			contractName, topic, filter, txId, senderAddr)
 
 		if err != nil {
 			s.log.Warnf(
@@ -247,7 +276,7 @@ func (s *ApiService) doSendHistoryContractEvent(db protocol.BlockchainStore, ser
 	}
 
 	alreadySendHistoryBlockHeight, err := s.sendHistoryContractEvent(db, server, startBlock, endBlock,
-		contractName, topic, txId, senderAddr)
+		contractName, topic, filter, txId, senderAddr)
 
 	if err != nil {
 		s.log.Warnf("sendHistoryContractEvent failed:%s, [txId:%s, senderAddr:%s, contractName:%s, topic:%s]",
@@ -265,7 +294,7 @@ func (s *ApiService) doSendHistoryContractEvent(db protocol.BlockchainStore, ser
 func (s *ApiService) sendHistoryContractEvent(store protocol.BlockchainStore,
 	server apiPb.RpcNode_SubscribeServer,
 	startBlockHeight, endBlockHeight int64,
-	contractName, topic, txId, senderAddr string) (int64, error) {
+	contractName, topic string, filter *contractEventFilter, txId, senderAddr string) (int64, error) {
 
 	var (
 		err    error
@@ -314,7 +343,7 @@ func (s *ApiService) sendHistoryContractEvent(store protocol.BlockchainStore,
 			}
 
 			sendSubscribeContractEventStick := utils.CurrentTimeMillisSeconds()
-			if err = s.sendSubscribeContractEvent(server, block, contractName, topic); err != nil {
+			if err = s.sendSubscribeContractEvent(store, server, block, contractName, topic, filter); err != nil {
 				errMsg = fmt.Sprintf("send subscribe tx failed, %s", err)
 				s.log.Warnf(errMsg + fmt.Sprintf("[txId:%s, sender:%s, contractName:%s, topic:%s]",
 					txId, senderAddr, contractName, topic))
@@ -336,18 +365,29 @@ For example:
   - If both `contract_name` and `event_name` are provided, only events matching both will be returned.
   - If `contract_name` is provided and `event_name` is empty, all events for the specified contract will be returned.
   - If `contract_name` is empty, an error will be raised, as it is no longer supported.
+  - If a `filter` is provided, only events whose data and tx match it will be returned.
 */
-func (s *ApiService) sendSubscribeContractEvent(server apiPb.RpcNode_SubscribeServer,
-	block *commonPb.Block, contractName, topic string) error {
+func (s *ApiService) sendSubscribeContractEvent(store protocol.BlockchainStore, server apiPb.RpcNode_SubscribeServer,
+	block *commonPb.Block, contractName, topic string, filter *contractEventFilter) error {
 
 	var (
 		contractEvents []*commonPb.ContractEventInfo
 	)
 
 	for _, tx := range block.Txs {
+		// the tx conditions are checked once per tx, and only if some event of the tx matches
+		var txChecked, txMatched bool
+		senderAddr := func() (string, error) { return s.getTxSenderAddress(store, tx) }
 		for idx, event := range tx.Result.ContractResult.ContractEvent {
 			if contractName == event.ContractName {
-				if topic == "" || topic == event.Topic {
+				if (topic == "" || topic == event.Topic) && filter.matchEvent(event) {
+					if !txChecked {
+						txChecked, txMatched = true, filter.matchTx(tx, senderAddr)
+					}
+					if !txMatched {
+						break
+					}
+
 					eventInfo := commonPb.ContractEventInfo{
 						BlockHeight:     block.Header.BlockHeight,
 						ChainId:         block.Header.ChainId,
@@ -409,7 +449,8 @@ func (s *ApiService) doSendSubscribeContractEvent(server apiPb.RpcNode_Subscribe
 
 func (s *ApiService) sendNewContractEvent(store protocol.BlockchainStore, tx *commonPb.Transaction,
 	server apiPb.RpcNode_SubscribeServer, startBlock, endBlock int64,
-	contractName string, topic string, alreadySendHistoryBlockHeight int64, senderAddr string) error {
+	contractName string, topic string, filter *contractEventFilter, alreadySendHistoryBlockHeight int64,
+	senderAddr string) error {
 
 	var (
 		errCode         commonErr.ErrCode
@@ -450,7 +491,7 @@ func (s *ApiService) sendNewContractEvent(store protocol.BlockchainStore, tx *co
 
 			if alreadySendHistoryBlockHeight < atomic.LoadInt64(&lastBlockHeight) {
 				alreadySendHistoryBlockHeight, err = s.sendHistoryContractEvent(store, server,
-					alreadySendHistoryBlockHeight+1, endBlock, contractName, topic, txId, senderAddr)
+					alreadySendHistoryBlockHeight+1, endBlock, contractName, topic, filter, txId, senderAddr)
 				if err != nil {
 					s.log.Warnf("send history contract event failed:%s,[txId:%s, sender:%s, "+
 						"contractName:%s, topic:%s].", err.Error(), txId, senderAddr, contractName, topic)
@@ -483,6 +524,14 @@ func (s *ApiService) getContractEventSubscribeResult(contractEvents []*commonPb.
 		return nil, errors.New(errMsg)
 	}
 
+	// all the events of a block are sent in one result
+	cursor := newSubscribeCursor(syscontract.SubscribeFunction_SUBSCRIBE_CONTRACT_EVENT.String(),
+		contractEvents[0].BlockHeight, -1)
+	if eventBytes, err = appendSubscribeCursor(eventBytes, cursor); err != nil {
+		errMsg := fmt.Sprintf("marshal subscribe cursor failed:%s", err)
+		s.log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
 	result := &commonPb.SubscribeResult{
 		Data: eventBytes,
 	}
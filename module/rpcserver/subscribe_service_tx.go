@@ -13,10 +13,9 @@ import (
 	"strings"
 	"sync/atomic"
 
-	"chainmaker.org/chainmaker/localconf/v2"
-
 	pbac "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/subscriber/model"
 	"chainmaker.org/chainmaker/common/v2/bytehelper"
 	commonErr "chainmaker.org/chainmaker/common/v2/errors"
@@ -31,7 +30,8 @@ import (
 )
 
 func (s *ApiService) checkDealTxSubscriptionParams(tx *commonPb.Transaction) (startBlock int64, endBlock int64,
-	contractName string, txIds []string, preAlias string, preTxId string, preOrgId string, err error) {
+	contractName string, txIds []string, preAlias string, preTxId string, preOrgId string,
+	cursor *subscribeCursor, err error) {
 	for _, kv := range tx.Payload.Parameters {
 		if kv.Key == syscontract.SubscribeTx_START_BLOCK.String() {
 			startBlock, err = bytehelper.BytesToInt64(kv.Value)
@@ -49,6 +49,8 @@ func (s *ApiService) checkDealTxSubscriptionParams(tx *commonPb.Transaction) (st
 			preTxId = string(kv.Value)
 		} else if kv.Key == syscontract.SubscribeTx_PRE_ORG_ID.String() {
 			preOrgId = string(kv.Value)
+		} else if kv.Key == SubscribeCursorKey {
+			cursor, err = parseSubscribeCursor(string(kv.Value), syscontract.SubscribeFunction_SUBSCRIBE_TX.String())
 		}
 
 		if err != nil {
@@ -78,6 +80,7 @@ func (s *ApiService) dealTxSubscription(tx *commonPb.Transaction, server apiPb.R
 		preAlias      string
 		preTxId       string
 		preOrgId      string
+		cursor        *subscribeCursor
 		txId          = tx.Payload.TxId
 		chainId       = tx.Payload.ChainId
 		subscribeType string
@@ -85,7 +88,7 @@ func (s *ApiService) dealTxSubscription(tx *commonPb.Transaction, server apiPb.R
 	)
 
 	defer func() {
-		if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+		if confreload.Config().MonitorConfig.Enabled {
 			//metric subscribe active counter
 			s.metricSubscribeActiveCounter.WithLabelValues(chainId, senderAddr, subscribeType, contractName, "").Dec()
 			// if the function returns an error, count the number of subscription interruptions
@@ -112,13 +115,14 @@ func (s *ApiService) dealTxSubscription(tx *commonPb.Transaction, server apiPb.R
 		return err
 	}
 
-	startBlock, endBlock, contractName, txIds, preAlias, preTxId, preOrgId, err = s.checkDealTxSubscriptionParams(tx)
+	startBlock, endBlock, contractName, txIds, preAlias, preTxId, preOrgId, cursor, err =
+		s.checkDealTxSubscriptionParams(tx)
 	if err != nil {
 		s.log.Warnf(err.Error() + fmt.Sprintf("[reqTxId:%s]", txId))
 		return err
 	}
 
-	if localconf.ChainMakerConfig.MonitorConfig.Enabled {
+	if confreload.Config().MonitorConfig.Enabled {
 		//metric subscribe total counter
 		s.metricSubscribeTotalCounter.WithLabelValues(chainId, senderAddr, subscribeType, contractName, "").Inc()
 		//metric subscribe active counter
@@ -142,18 +146,19 @@ func (s *ApiService) dealTxSubscription(tx *commonPb.Transaction, server apiPb.R
 	}
 	reqSenderOrgId := tx.Sender.Signer.OrgId
 	return s.doSendTx(tx, db, server, startBlock, endBlock, contractName, txIds,
-		preAlias, preTxId, preOrgId,
+		preAlias, preTxId, preOrgId, cursor,
 		reqSender, reqSenderOrgId, senderAddr)
 }
 
 func (s *ApiService) doSendTx(tx *commonPb.Transaction, db protocol.BlockchainStore,
 	server apiPb.RpcNode_SubscribeServer, startBlock, endBlock int64, contractName string,
-	txIds []string, preAlias string, preTxId string, preOrgId string,
+	txIds []string, preAlias string, preTxId string, preOrgId string, after *subscribeCursor,
 	reqSender protocol.Role, reqSenderOrgId, senderAddr string) error {
 
 	var (
 		txIdsMap                      = make(map[string]struct{})
 		alreadySendHistoryBlockHeight int64
+		resumeLive                    bool
 		err                           error
 	)
 
@@ -161,17 +166,38 @@ func (s *ApiService) doSendTx(tx *commonPb.Transaction, db protocol.BlockchainSt
 		txIdsMap[txId] = struct{}{}
 	}
 
+	if after != nil {
+		// resume right after the cursor, the start block of the request is ignored
+		startBlock = after.nextHeight()
+		if endBlock != -1 && startBlock > endBlock {
+			return status.Error(codes.OK, "OK")
+		}
+		if resumeLive, err = isBeyondLastBlock(db, startBlock); err != nil {
+			errMsg := s.getErrMsg(commonErr.ERR_CODE_GET_LAST_BLOCK, err)
+			s.log.Warnf(errMsg+" [reqTxId:%s, sender:%s]", tx.Payload.TxId, senderAddr)
+			return status.Error(codes.Internal, errMsg)
+		}
+		s.log.Infof("resume tx subscription from height %d[reqTxId:%s, sender:%s]",
+			startBlock, tx.Payload.TxId, senderAddr)
+		// the cursor is ahead of the ledger of this node, the txs after it are sent in the live phase
+		if resumeLive {
+			return s.sendNewTx(db, tx, server, startBlock, endBlock, contractName, txIds,
+				preAlias, preTxId, preOrgId, txIdsMap, after,
+				startBlock-1, reqSender, reqSenderOrgId, senderAddr)
+		}
+	}
+
 	if startBlock == -1 && endBlock == -1 {
 		return s.sendNewTx(db, tx, server, startBlock, endBlock, contractName, txIds,
 			preAlias, preTxId, preOrgId,
-			txIdsMap, -1, reqSender, reqSenderOrgId, senderAddr)
+			txIdsMap, after, -1, reqSender, reqSenderOrgId, senderAddr)
 
 	}
 
 	if alreadySendHistoryBlockHeight, err = s.doSendHistoryTx(db, server, startBlock, endBlock,
 		contractName, txIds,
 		preAlias, preTxId, preOrgId,
-		txIdsMap, reqSender, reqSenderOrgId, tx.Payload.TxId, senderAddr); err != nil {
+		txIdsMap, after, reqSender, reqSenderOrgId, tx.Payload.TxId, senderAddr); err != nil {
 		return err
 	}
 
@@ -180,14 +206,15 @@ func (s *ApiService) doSendTx(tx *commonPb.Transaction, db protocol.BlockchainSt
 	}
 
 	return s.sendNewTx(db, tx, server, startBlock, endBlock, contractName, txIds,
-		preAlias, preTxId, preOrgId, txIdsMap,
+		preAlias, preTxId, preOrgId, txIdsMap, after,
 		alreadySendHistoryBlockHeight, reqSender, reqSenderOrgId, senderAddr)
 }
 
 func (s *ApiService) doSendHistoryTx(db protocol.BlockchainStore, server apiPb.RpcNode_SubscribeServer,
 	startBlock, endBlock int64, contractName string, txIds []string,
 	preAlias string, preTxId string, preOrgId string,
-	txIdsMap map[string]struct{}, reqSender protocol.Role, reqSenderOrgId, reqTxId, senderAddr string) (int64, error) {
+	txIdsMap map[string]struct{}, after *subscribeCursor,
+	reqSender protocol.Role, reqSenderOrgId, reqTxId, senderAddr string) (int64, error) {
 
 	var (
 		err             error
@@ -215,7 +242,7 @@ func (s *ApiService) doSendHistoryTx(db protocol.BlockchainStore, server apiPb.R
 	if endBlock != -1 && endBlock <= lastBlockHeight {
 		_, err = s.sendHistoryTx(db, server, startBlock, endBlock, contractName,
 			txIds, preAlias, preTxId, preOrgId,
-			txIdsMap, reqSender, reqSenderOrgId, reqTxId, senderAddr)
+			txIdsMap, after, reqSender, reqSenderOrgId, reqTxId, senderAddr)
 
 		if err != nil {
 			s.log.Warnf("sendHistoryTx failed, %s. [reqTxId:%s, sender:%s]",
@@ -233,7 +260,7 @@ func (s *ApiService) doSendHistoryTx(db protocol.BlockchainStore, server apiPb.R
 	}
 
 	alreadySendHistoryBlockHeight, err := s.sendHistoryTx(db, server, startBlock, endBlock, contractName,
-		txIds, preAlias, preTxId, preOrgId, txIdsMap, reqSender, reqSenderOrgId, reqTxId, senderAddr)
+		txIds, preAlias, preTxId, preOrgId, txIdsMap, after, reqSender, reqSenderOrgId, reqTxId, senderAddr)
 
 	if err != nil {
 		s.log.Warnf("sendHistoryTx failed, %s. [reqTxId:%s, sender:%s]", err, reqTxId, senderAddr)
@@ -256,7 +283,7 @@ func (s *ApiService) doSendHistoryTx(db protocol.BlockchainStore, server apiPb.R
 func (s *ApiService) sendNewTx(store protocol.BlockchainStore, tx *commonPb.Transaction,
 	server apiPb.RpcNode_SubscribeServer, startBlock, endBlock int64, contractName string,
 	txIds []string, preAlias string, preTxId string, preOrgId string,
-	txIdsMap map[string]struct{}, alreadySendHistoryBlockHeight int64,
+	txIdsMap map[string]struct{}, after *subscribeCursor, alreadySendHistoryBlockHeight int64,
 	reqSender protocol.Role, reqSenderOrgId, senderAddr string) error {
 
 	var (
@@ -299,7 +326,7 @@ func (s *ApiService) sendNewTx(store protocol.BlockchainStore, tx *commonPb.Tran
 				alreadySendHistoryBlockHeight, err = s.sendHistoryTx(store, server, alreadySendHistoryBlockHeight+1,
 					endBlock, contractName, txIds,
 					preAlias, preTxId, preOrgId,
-					txIdsMap, reqSender, reqSenderOrgId, txId, senderAddr)
+					txIdsMap, after, reqSender, reqSenderOrgId, txId, senderAddr)
 				if err != nil {
 					s.log.Warnf("send history block failed, err:%s,[txId:%s, sender:%s].",
 						err, txId, senderAddr)
@@ -330,13 +357,13 @@ func (s *ApiService) sendNewTx(store protocol.BlockchainStore, tx *commonPb.Tran
 //	return false
 //}
 
-// sendHistoryTx - send history tx to subscriber
+// sendHistoryTx - send history tx to subscriber, the txs delivered before the cursor after are skipped
 func (s *ApiService) sendHistoryTx(store protocol.BlockchainStore,
 	server apiPb.RpcNode_SubscribeServer,
 	startBlockHeight, endBlockHeight int64,
 	contractName string, txIds []string,
 	preAlias string, preTxId string, preOrgId string,
-	txIdsMap map[string]struct{},
+	txIdsMap map[string]struct{}, after *subscribeCursor,
 	reqSender protocol.Role, reqSenderOrgId, txId, senderAddr string) (int64, error) {
 
 	var (
@@ -391,9 +418,9 @@ func (s *ApiService) sendHistoryTx(store protocol.BlockchainStore,
 
 			s.log.Infof("get block[%d] finish.[txId:%s, sender:%s, contractName:%s]",
 				i, txId, senderAddr, contractName)
-			if err := s.sendSubscribeTx(server, block.Txs, contractName, txIds,
+			if err := s.sendSubscribeTx(server, block.Header.BlockHeight, block.Txs, contractName, txIds,
 				preAlias, preTxId, preOrgId,
-				txIdsMap,
+				txIdsMap, after,
 				reqSender, reqSenderOrgId); err != nil {
 				errMsg = fmt.Sprintf("send subscribe tx failed, %s", err)
 				s.log.Warnf(errMsg+" [txId:%s, sender:%s]", txId, senderAddr)
@@ -408,18 +435,27 @@ func (s *ApiService) sendHistoryTx(store protocol.BlockchainStore,
 }
 
 func (s *ApiService) sendSubscribeTx(server apiPb.RpcNode_SubscribeServer,
-	txs []*commonPb.Transaction, contractName string, txIds []string,
+	height uint64, txs []*commonPb.Transaction, contractName string, txIds []string,
 	preAlias string, preTxId string, preOrgId string,
-	txIdsMap map[string]struct{}, reqSender protocol.Role, reqSenderOrgId string) error {
+	txIdsMap map[string]struct{}, after *subscribeCursor, reqSender protocol.Role, reqSenderOrgId string) error {
 
 	var (
-		err error
+		err    error
+		cursor *subscribeCursor
 	)
 
-	for _, tx := range txs {
+	for idx, tx := range txs {
+		if after.delivered(height, idx) {
+			continue
+		}
+		cursor = newSubscribeCursor(syscontract.SubscribeFunction_SUBSCRIBE_TX.String(), height, int64(idx))
+		if idx == len(txs)-1 {
+			cursor.index = -1
+		}
+
 		if contractName == "" && len(txIds) == 0 &&
 			preAlias == "" && preTxId == "" && preOrgId == "" {
-			if err = s.doSendSubscribeTx(server, tx, reqSender, reqSenderOrgId); err != nil {
+			if err = s.doSendSubscribeTx(server, tx, cursor, reqSender, reqSenderOrgId); err != nil {
 				return err
 			}
 			continue
@@ -427,21 +463,21 @@ func (s *ApiService) sendSubscribeTx(server apiPb.RpcNode_SubscribeServer,
 
 		//preAlias
 		if len(preAlias) > 0 {
-			if err = s.handlePreAlias(server, tx, reqSender, reqSenderOrgId, preAlias); err != nil {
+			if err = s.handlePreAlias(server, tx, cursor, reqSender, reqSenderOrgId, preAlias); err != nil {
 				return err
 			}
 			continue
 		}
 		//preTxId
 		if len(preTxId) > 0 {
-			if err = s.handlePreTxId(server, tx, reqSender, reqSenderOrgId, preTxId); err != nil {
+			if err = s.handlePreTxId(server, tx, cursor, reqSender, reqSenderOrgId, preTxId); err != nil {
 				return err
 			}
 			continue
 		}
 		//preOrgId
 		if len(preOrgId) > 0 {
-			if err = s.handlePreOrgId(server, tx, reqSender, reqSenderOrgId, preOrgId); err != nil {
+			if err = s.handlePreOrgId(server, tx, cursor, reqSender, reqSenderOrgId, preOrgId); err != nil {
 				return err
 			}
 			continue
@@ -450,7 +486,7 @@ func (s *ApiService) sendSubscribeTx(server apiPb.RpcNode_SubscribeServer,
 			continue
 		}
 
-		if err = s.doSendSubscribeTx(server, tx, reqSender, reqSenderOrgId); err != nil {
+		if err = s.doSendSubscribeTx(server, tx, cursor, reqSender, reqSenderOrgId); err != nil {
 			return err
 		}
 	}
@@ -459,7 +495,7 @@ func (s *ApiService) sendSubscribeTx(server apiPb.RpcNode_SubscribeServer,
 }
 
 func (s *ApiService) handlePreAlias(server apiPb.RpcNode_SubscribeServer, tx *commonPb.Transaction,
-	reqSender protocol.Role, reqSenderOrgId string, preAlias string) error {
+	cursor *subscribeCursor, reqSender protocol.Role, reqSenderOrgId string, preAlias string) error {
 	//创世区块中的配置交易，sender为空
 	if tx.Sender == nil || tx.Sender.Signer == nil || len(tx.Sender.Signer.OrgId) <= 0 {
 		s.log.Debugf("Alias matching failed," +
@@ -477,7 +513,7 @@ func (s *ApiService) handlePreAlias(server apiPb.RpcNode_SubscribeServer, tx *co
 		return nil
 	}
 	if strings.HasPrefix(string(tx.Sender.Signer.MemberInfo), preAlias) {
-		if err := s.doSendSubscribeTx(server, tx, reqSender, reqSenderOrgId); err != nil {
+		if err := s.doSendSubscribeTx(server, tx, cursor, reqSender, reqSenderOrgId); err != nil {
 			return err
 		}
 	} else {
@@ -490,9 +526,9 @@ func (s *ApiService) handlePreAlias(server apiPb.RpcNode_SubscribeServer, tx *co
 }
 
 func (s *ApiService) handlePreTxId(server apiPb.RpcNode_SubscribeServer, tx *commonPb.Transaction,
-	reqSender protocol.Role, reqSenderOrgId string, preTxId string) error {
+	cursor *subscribeCursor, reqSender protocol.Role, reqSenderOrgId string, preTxId string) error {
 	if strings.HasPrefix(tx.Payload.TxId, preTxId) {
-		if err := s.doSendSubscribeTx(server, tx, reqSender, reqSenderOrgId); err != nil {
+		if err := s.doSendSubscribeTx(server, tx, cursor, reqSender, reqSenderOrgId); err != nil {
 			return err
 		}
 	} else {
@@ -504,7 +540,7 @@ func (s *ApiService) handlePreTxId(server apiPb.RpcNode_SubscribeServer, tx *com
 }
 
 func (s *ApiService) handlePreOrgId(server apiPb.RpcNode_SubscribeServer, tx *commonPb.Transaction,
-	reqSender protocol.Role, reqSenderOrgId string, preOrgId string) error {
+	cursor *subscribeCursor, reqSender protocol.Role, reqSenderOrgId string, preOrgId string) error {
 	//创世区块中的配置交易，sender为空
 	if tx.Sender == nil || tx.Sender.Signer == nil || len(tx.Sender.Signer.OrgId) <= 0 {
 		s.log.Debugf("OrgId matching failed," +
@@ -512,7 +548,7 @@ func (s *ApiService) handlePreOrgId(server apiPb.RpcNode_SubscribeServer, tx *co
 		return nil
 	}
 	if strings.HasPrefix(tx.Sender.Signer.OrgId, preOrgId) {
-		if err := s.doSendSubscribeTx(server, tx, reqSender, reqSenderOrgId); err != nil {
+		if err := s.doSendSubscribeTx(server, tx, cursor, reqSender, reqSenderOrgId); err != nil {
 			return err
 		}
 	} else {
@@ -524,7 +560,7 @@ func (s *ApiService) handlePreOrgId(server apiPb.RpcNode_SubscribeServer, tx *co
 }
 
 func (s *ApiService) doSendSubscribeTx(server apiPb.RpcNode_SubscribeServer, tx *commonPb.Transaction,
-	reqSender protocol.Role, reqSenderOrgId string) error {
+	cursor *subscribeCursor, reqSender protocol.Role, reqSenderOrgId string) error {
 
 	var (
 		err    error
@@ -536,7 +572,7 @@ func (s *ApiService) doSendSubscribeTx(server apiPb.RpcNode_SubscribeServer, tx
 	isReqSenderLightNode := reqSender == protocol.RoleLight
 	isTxRelatedToSender := (tx.Sender != nil) && reqSenderOrgId == tx.Sender.Signer.OrgId
 
-	if result, err = s.getTxSubscribeResult(txNew); err != nil {
+	if result, err = s.getTxSubscribeResult(txNew, cursor); err != nil {
 		errMsg = fmt.Sprintf("get tx subscribe result failed, %s", err)
 		s.log.Warnf(errMsg)
 		return errors.New(errMsg)
@@ -561,7 +597,8 @@ func (s *ApiService) doSendSubscribeTx(server apiPb.RpcNode_SubscribeServer, tx
 	return nil
 }
 
-func (s *ApiService) getTxSubscribeResult(tx *commonPb.Transaction) (*commonPb.SubscribeResult, error) {
+func (s *ApiService) getTxSubscribeResult(tx *commonPb.Transaction,
+	cursor *subscribeCursor) (*commonPb.SubscribeResult, error) {
 	txBytes, err := proto.Marshal(tx)
 	if err != nil {
 		errMsg := fmt.Sprintf("marshal tx info failed, %s", err)
@@ -569,6 +606,12 @@ func (s *ApiService) getTxSubscribeResult(tx *commonPb.Transaction) (*commonPb.S
 		return nil, errors.New(errMsg)
 	}
 
+	if txBytes, err = appendSubscribeCursor(txBytes, cursor); err != nil {
+		errMsg := fmt.Sprintf("marshal subscribe cursor failed, %s", err)
+		s.log.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+
 	result := &commonPb.SubscribeResult{
 		Data: txBytes,
 	}
@@ -0,0 +1,68 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"context"
+
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
+)
+
+// GetSyncPeerScores - get the peer select strategy in use by the sync of the chain, and the score of each peer
+// the blocks are requested from with the observations of its responses, see blockSync.PeerScores
+func (s *ApiService) GetSyncPeerScores(ctx context.Context, req *ChainAdminRequest) (*SyncPeerScores, error) {
+	var resp *SyncPeerScores
+	err := s.dealChainAdminResult(ctx, "GetSyncPeerScores", req.ChainId, func() error {
+		scores, err := s.chainMakerServer.GetSyncPeerScores(req.ChainId)
+		if err != nil {
+			return err
+		}
+		resp = newSyncPeerScores(scores)
+		return nil
+	})
+	return resp, err
+}
+
+// GetChainSyncState - get the sync state of the chain like GetSyncState, along with the peer select strategy in use
+// and the score of each peer, see GetSyncPeerScores
+func (s *ApiService) GetChainSyncState(ctx context.Context, req *GetChainSyncStateRequest) (*ChainSyncState, error) {
+	var resp *ChainSyncState
+	err := s.dealChainAdminResult(ctx, "GetChainSyncState", req.ChainId, func() error {
+		// the scores are got first, which fails if the chain has no sync service keeping them
+		scores, err := s.chainMakerServer.GetSyncPeerScores(req.ChainId)
+		if err != nil {
+			return err
+		}
+		syncService, err := s.chainMakerServer.GetSync(req.ChainId)
+		if err != nil {
+			return err
+		}
+		state, err := syncService.GetState(req.WithPeers)
+		if err != nil {
+			return err
+		}
+		resp = &ChainSyncState{State: state, PeerScores: newSyncPeerScores(scores)}
+		return nil
+	})
+	return resp, err
+}
+
+func newSyncPeerScores(scores *blockSync.PeerScores) *SyncPeerScores {
+	resp := &SyncPeerScores{Strategy: scores.Strategy}
+	for _, peer := range scores.Peers {
+		resp.Peers = append(resp.Peers, &SyncPeerScore{
+			NodeId:        peer.NodeId,
+			Score:         peer.Score,
+			LatencyMs:     peer.LatencyMs,
+			Responses:     peer.Responses,
+			Timeouts:      peer.Timeouts,
+			InvalidBlocks: peer.InvalidBlocks,
+			Penalty:       peer.Penalty,
+		})
+	}
+	return resp
+}
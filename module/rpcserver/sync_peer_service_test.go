@@ -0,0 +1,52 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	"chainmaker.org/chainmaker-go/module/blockchain"
+	blockSync "chainmaker.org/chainmaker-go/module/sync"
+	"chainmaker.org/chainmaker/logger/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetSyncPeerScores(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	_, err := s.GetSyncPeerScores(peerContext("10.1.2.3:12301", nil), &ChainAdminRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.GetSyncPeerScores(peerContext("127.0.0.1:12301", nil), &ChainAdminRequest{ChainId: "chain1"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), "chain1")
+}
+
+func TestGetChainSyncState(t *testing.T) {
+	allowLoopbackAdmins(t)
+	s := &ApiService{log: logger.GetLogger(logger.MODULE_RPC), chainMakerServer: blockchain.NewChainMakerServer()}
+
+	_, err := s.GetChainSyncState(peerContext("10.1.2.3:12301", nil), &GetChainSyncStateRequest{ChainId: "chain1"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.GetChainSyncState(peerContext("127.0.0.1:12301", nil),
+		&GetChainSyncStateRequest{ChainId: "chain1", WithPeers: true})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), "chain1")
+}
+
+func TestNewSyncPeerScores(t *testing.T) {
+	scores := newSyncPeerScores(&blockSync.PeerScores{
+		Strategy: "score",
+		Peers:    []*blockSync.PeerScore{{NodeId: "node2", Score: 0.5, LatencyMs: 20, Timeouts: 1}},
+	})
+	require.Equal(t, "score", scores.Strategy)
+	require.Equal(t, []*SyncPeerScore{{NodeId: "node2", Score: 0.5, LatencyMs: 20, Timeouts: 1}}, scores.Peers)
+}
@@ -8,7 +8,6 @@ SPDX-License-Identifier: Apache-2.0
 package rpcserver
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -16,9 +15,9 @@ import (
 	"time"
 
 	"chainmaker.org/chainmaker-go/module/blockchain"
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker-go/module/subscriber"
 	"chainmaker.org/chainmaker-go/module/subscriber/model"
-	"chainmaker.org/chainmaker/localconf/v2"
 	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
 )
 
@@ -56,7 +55,7 @@ type RootDispatcher struct {
 // NewRootDispatcher returns a new RootDispatcher
 func NewRootDispatcher(chainMakerServer *blockchain.ChainMakerServer) (*RootDispatcher, error) {
 	childs := make(childDispatchers)
-	for _, chainConfig := range localconf.ChainMakerConfig.BlockChainConfig {
+	for _, chainConfig := range confreload.Config().BlockChainConfig {
 		eventSubscriber, err := chainMakerServer.GetEventSubscribe(chainConfig.ChainId)
 		if err != nil {
 			return nil, err
@@ -147,7 +146,7 @@ func (root *RootDispatcher) CheckAndUpdate() error {
 	// start and add child dispatchers based on the newest chainconfig
 	oldChilds := root.loadChilds()
 	currentChainIds := make(map[string]struct{})
-	for _, chainConfig := range localconf.ChainMakerConfig.BlockChainConfig {
+	for _, chainConfig := range confreload.Config().BlockChainConfig {
 		chainId := chainConfig.ChainId
 		currentChainIds[chainId] = struct{}{}
 		if _, exists := oldChilds[chainId]; !exists {
@@ -175,7 +174,7 @@ func (root *RootDispatcher) CheckAndUpdate() error {
 // Register for transaction result events.
 // Note that Unregister must be called when the registration is no longer needed.
 // chainId is the chain ID for which events are to be received
-// txId is the transaction ID for which events are to be received
+// txId is the transaction ID for which events are to be received, several waiters may register the same txId
 // Returns the channel that is used to receive result. The channel
 // is closed when Unregister is called.
 func (root *RootDispatcher) Register(chainId, txId string) (chan *TxResultExt, error) {
@@ -189,14 +188,25 @@ func (root *RootDispatcher) Register(chainId, txId string) (chan *TxResultExt, e
 }
 
 // Unregister removes the given registration and closes the event channel.
-func (root *RootDispatcher) Unregister(chainId, txId string) {
+func (root *RootDispatcher) Unregister(chainId, txId string, txResultC chan *TxResultExt) {
 	child, ok := root.loadChild(chainId)
 	if !ok {
 		log.Warnf("Unregister tx [%s] failed, child dispatcher [%s] not exists", txId, chainId)
 		return
 	}
 
-	child.unregister(txId)
+	child.unregister(txId, txResultC)
+}
+
+// Drain send the result to all the waiters of the chain, such as the chain is left, their registrations are
+// kept until Unregister.
+func (root *RootDispatcher) Drain(chainId string, result *commonPb.Result) {
+	child, ok := root.loadChild(chainId)
+	if !ok {
+		return
+	}
+
+	child.drain(result)
 }
 
 // loggingStatistics logging statistics
@@ -234,7 +244,7 @@ type childDispatcher struct {
 	eventSubscriber *subscriber.EventSubscriber
 	// mu protect txRegs
 	mu sync.RWMutex
-	// txRegs key: txId value: chan *TxResultExt
+	// txRegs key: txId value: []chan *TxResultExt
 	txRegs txRegistrations
 	// count of transactions of this chain are waiting for results
 	txCount int64
@@ -246,9 +256,9 @@ type childDispatcher struct {
 // childDispatchers key: chainId value: *childDispatcher
 type childDispatchers map[string]*childDispatcher
 
-// txRegistrations key: txId value: chan *TxResultExt, store txIds that registered
-// for each txId, there is only one result channel
-type txRegistrations map[string]chan *TxResultExt
+// txRegistrations key: txId value: []chan *TxResultExt, store txIds that registered
+// for each txId, there is one result channel per waiter, such as the retries of a client
+type txRegistrations map[string][]chan *TxResultExt
 
 // newChildDispatcher returns a new childDispatcher
 func newChildDispatcher(chainId string, eventSubscriber *subscriber.EventSubscriber) *childDispatcher {
@@ -264,7 +274,9 @@ func newChildDispatcher(chainId string, eventSubscriber *subscriber.EventSubscri
 func (child *childDispatcher) start() {
 	blockEventC := make(chan model.NewBlockEvent, 1)
 	sub := child.eventSubscriber.SubscribeBlockEvent(blockEventC)
-	defer sub.Unsubscribe()
+	defer func() {
+		sub.Unsubscribe()
+	}()
 
 	for {
 		select {
@@ -274,6 +286,11 @@ func (child *childDispatcher) start() {
 			for _, tx := range ev.BlockInfo.Block.Txs {
 				child.trySendTxResult(tx, ev.BlockInfo.Block.Header.BlockHeight)
 			}
+		case err := <-sub.Err():
+			// dropped by the subscriber as a slow consumer, subscribe again to keep serving tx results
+			log.Warnf("child dispatcher [%s] block event subscription closed, %v, subscribe again",
+				child.chainId, err)
+			sub = child.eventSubscriber.SubscribeBlockEvent(blockEventC)
 		case <-child.stopC:
 			log.Debugf("child dispatcher [%s] stopped", child.chainId)
 			return
@@ -294,24 +311,32 @@ func (child *childDispatcher) register(txId string) (chan *TxResultExt, error) {
 	child.mu.Lock()
 	defer child.mu.Unlock()
 
-	if _, exists := child.txRegs[txId]; exists {
-		return nil, errors.New("txId duplicated")
+	if _, exists := child.txRegs[txId]; !exists {
+		atomic.AddInt64(&child.txCount, 1)
 	}
-	atomic.AddInt64(&child.txCount, 1)
 	txResultC := make(chan *TxResultExt, 1)
-	child.txRegs[txId] = txResultC
+	child.txRegs[txId] = append(child.txRegs[txId], txResultC)
 	return txResultC, nil
 }
 
 // unregister removes the given registration and closes the event channel.
-func (child *childDispatcher) unregister(txId string) {
+func (child *childDispatcher) unregister(txId string, txResultC chan *TxResultExt) {
 	child.mu.Lock()
 	defer child.mu.Unlock()
 
-	if txResultC, exists := child.txRegs[txId]; exists {
-		atomic.AddInt64(&child.txCount, -1)
-		delete(child.txRegs, txId)
+	txResultCs := child.txRegs[txId]
+	for i, c := range txResultCs {
+		if c != txResultC {
+			continue
+		}
 		close(txResultC)
+		if len(txResultCs) == 1 {
+			atomic.AddInt64(&child.txCount, -1)
+			delete(child.txRegs, txId)
+			return
+		}
+		child.txRegs[txId] = append(txResultCs[:i:i], txResultCs[i+1:]...)
+		return
 	}
 }
 
@@ -321,17 +346,35 @@ func (child *childDispatcher) trySendTxResult(tx *commonPb.Transaction, blockHei
 	child.mu.RLock()
 	defer child.mu.RUnlock()
 
-	if txResultC, exists := child.txRegs[tx.Payload.TxId]; exists {
+	if txResultCs, exists := child.txRegs[tx.Payload.TxId]; exists {
 		result := &TxResultExt{
 			Result:        tx.Result,
 			TxTimestamp:   tx.Payload.Timestamp,
 			TxBlockHeight: blockHeight,
 		}
-		// non-blocking write to channel to ignore txResultC buffer is full in extreme cases
-		select {
-		case txResultC <- result:
-		default:
-			log.Warnf("tx [%s] result channel is full, result dropped", tx.Payload.TxId)
+		for _, txResultC := range txResultCs {
+			// non-blocking write to channel to ignore txResultC buffer is full in extreme cases
+			select {
+			case txResultC <- result:
+			default:
+				log.Warnf("tx [%s] result channel is full, result dropped", tx.Payload.TxId)
+			}
+		}
+	}
+}
+
+// drain send the result to all the registered waiters
+func (child *childDispatcher) drain(result *commonPb.Result) {
+	child.mu.RLock()
+	defer child.mu.RUnlock()
+
+	for txId, txResultCs := range child.txRegs {
+		for _, txResultC := range txResultCs {
+			select {
+			case txResultC <- &TxResultExt{Result: result}:
+			default:
+				log.Warnf("tx [%s] result channel is full, drain result dropped", txId)
+			}
 		}
 	}
 }
@@ -0,0 +1,50 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package rpcserver
+
+import (
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChildDispatcher_SharedRegistration(t *testing.T) {
+	child := newChildDispatcher("chain1", nil)
+
+	txResultC1, err := child.register("tx1")
+	require.NoError(t, err)
+	txResultC2, err := child.register("tx1")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), child.txCount)
+
+	tx := &commonPb.Transaction{
+		Payload: &commonPb.Payload{TxId: "tx1", Timestamp: 1},
+		Result:  &commonPb.Result{Code: commonPb.TxStatusCode_SUCCESS},
+	}
+	child.trySendTxResult(tx, 10)
+	for _, txResultC := range []chan *TxResultExt{txResultC1, txResultC2} {
+		r := <-txResultC
+		require.Equal(t, uint64(10), r.TxBlockHeight)
+		require.Equal(t, tx.Result, r.Result)
+	}
+
+	child.unregister("tx1", txResultC1)
+	_, ok := <-txResultC1
+	require.False(t, ok)
+	require.Len(t, child.txRegs["tx1"], 1)
+	require.Equal(t, int64(1), child.txCount)
+
+	child.unregister("tx1", txResultC2)
+	_, ok = <-txResultC2
+	require.False(t, ok)
+	require.Empty(t, child.txRegs)
+	require.Equal(t, int64(0), child.txCount)
+
+	// unknown registrations are ignored
+	child.unregister("tx1", txResultC2)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	tbf "chainmaker.org/chainmaker/store/v2/types/blockfile"
+)
+
+// ErrHistoryRangeQuery range queries can not be answered from the history store
+var ErrHistoryRangeQuery = errors.New("range query is not supported by the query of a history block height")
+
+// NewHistoryQuerySnapshot create a snapshot for query tx which reads the state as of the given block height from
+// the history store. It fails with tbf.ErrArchivedBlock if the block of the height has been archived.
+func NewHistoryQuerySnapshot(store protocol.BlockchainStore, height uint64, log protocol.Logger) (
+	*SnapshotImpl, error) {
+	if archivedHeight := store.GetArchivedPivot(); archivedHeight > 0 && height <= archivedHeight {
+		return nil, tbf.ErrArchivedBlock
+	}
+	block, err := store.GetBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Header == nil {
+		return nil, fmt.Errorf("block of height %d not found", height)
+	}
+	return newQuerySnapshot(&historyStore{BlockchainStore: store, height: height}, block, log)
+}
+
+// historyStore the blockchain store whose state reads return the values as of a block height, the other methods
+// are those of the store
+type historyStore struct {
+	protocol.BlockchainStore
+	height uint64
+}
+
+// ReadObject returns the value written by the last tx of the key up to the height, nil if there is none or it was
+// deleted
+func (h *historyStore) ReadObject(contractName string, key []byte) ([]byte, error) {
+	iter, err := h.BlockchainStore.GetHistoryForKey(contractName, key)
+	if err != nil {
+		return nil, err
+	}
+	if iter == nil {
+		return nil, fmt.Errorf("history of key %s is not found, is the history db disabled", key)
+	}
+	defer iter.Release()
+
+	var (
+		value  []byte
+		found  bool
+		height uint64
+	)
+	// the modifications are not sorted by height, the last one of the same height wins
+	for iter.Next() {
+		modification, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		if modification.BlockHeight > h.height || (found && modification.BlockHeight < height) {
+			continue
+		}
+		found, height = true, modification.BlockHeight
+		value = modification.Value
+		if modification.IsDelete {
+			value = nil
+		}
+	}
+	return value, nil
+}
+
+// ReadObjects returns the values of the keys as of the height
+func (h *historyStore) ReadObjects(contractName string, keys [][]byte) ([][]byte, error) {
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, err := h.ReadObject(contractName, key)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// SelectObject range queries are rejected, the history store is indexed by key only
+func (h *historyStore) SelectObject(_ string, _ []byte, _ []byte) (protocol.StateIterator, error) {
+	return nil, ErrHistoryRangeQuery
+}
+
+// GetLastBlock returns the block of the height, the block the state is read as of
+func (h *historyStore) GetLastBlock() (*commonPb.Block, error) {
+	return h.BlockchainStore.GetBlock(h.height)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	configPb "chainmaker.org/chainmaker/pb-go/v2/config"
+	storePb "chainmaker.org/chainmaker/pb-go/v2/store"
+	vmPb "chainmaker.org/chainmaker/pb-go/v2/vm"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"chainmaker.org/chainmaker/protocol/v2/test"
+	tbf "chainmaker.org/chainmaker/store/v2/types/blockfile"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testKeyHistoryIterator struct {
+	modifications []*storePb.KeyModification
+	index         int
+}
+
+func (it *testKeyHistoryIterator) Next() bool {
+	it.index++
+	return it.index <= len(it.modifications)
+}
+
+func (it *testKeyHistoryIterator) Value() (*storePb.KeyModification, error) {
+	return it.modifications[it.index-1], nil
+}
+
+func (it *testKeyHistoryIterator) Release() {}
+
+func newHistoryTestStore(t *testing.T, archivedHeight uint64) *mock.MockBlockchainStore {
+	store := mock.NewMockBlockchainStore(gomock.NewController(t))
+	store.EXPECT().GetArchivedPivot().Return(archivedHeight).AnyTimes()
+	store.EXPECT().GetBlock(gomock.Any()).DoAndReturn(func(height uint64) (*commonPb.Block, error) {
+		return &commonPb.Block{Header: &commonPb.BlockHeader{ChainId: "chain1", BlockHeight: height}}, nil
+	}).AnyTimes()
+	store.EXPECT().GetLastChainConfig().Return(&configPb.ChainConfig{}, nil).AnyTimes()
+	store.EXPECT().GetHistoryForKey("contract1", gomock.Any()).DoAndReturn(
+		func(_ string, key []byte) (protocol.KeyHistoryIterator, error) {
+			modifications := []*storePb.KeyModification{
+				{BlockHeight: 2, Value: []byte(string(key) + "@2")},
+				{BlockHeight: 9, Value: []byte(string(key) + "@9")},
+				{BlockHeight: 5, Value: []byte(string(key) + "@5")},
+				{BlockHeight: 7, IsDelete: true},
+			}
+			return &testKeyHistoryIterator{modifications: modifications}, nil
+		}).AnyTimes()
+	return store
+}
+
+func TestNewHistoryQuerySnapshot_GetKey(t *testing.T) {
+	store := newHistoryTestStore(t, 0)
+	for height, expected := range map[uint64][]byte{1: nil, 2: []byte("k@2"), 6: []byte("k@5"), 8: nil,
+		10: []byte("k@9")} {
+		snap, err := NewHistoryQuerySnapshot(store, height, &test.GoLogger{})
+		require.NoError(t, err)
+		require.Equal(t, height, snap.GetBlockHeight())
+
+		value, err := snap.GetKey(-1, "contract1", []byte("k"))
+		require.NoError(t, err)
+		require.Equal(t, expected, value, "height %d", height)
+	}
+}
+
+func TestNewHistoryQuerySnapshot_GetKeys(t *testing.T) {
+	snap, err := NewHistoryQuerySnapshot(newHistoryTestStore(t, 0), 6, &test.GoLogger{})
+	require.NoError(t, err)
+
+	keys, err := snap.GetKeys(-1, []*vmPb.BatchKey{
+		{ContractName: "contract1", Key: "k1"},
+		{ContractName: "contract1", Key: "k2"},
+	})
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		require.Equal(t, []byte(key.Key+"@5"), key.Value)
+	}
+
+	_, err = snap.GetBlockchainStore().SelectObject("contract1", []byte("a"), []byte("z"))
+	require.Equal(t, ErrHistoryRangeQuery, err)
+}
+
+func TestNewHistoryQuerySnapshot_Archived(t *testing.T) {
+	store := newHistoryTestStore(t, 10)
+	_, err := NewHistoryQuerySnapshot(store, 10, &test.GoLogger{})
+	require.Equal(t, tbf.ErrArchivedBlock, err)
+
+	_, err = NewHistoryQuerySnapshot(store, 11, &test.GoLogger{})
+	require.NoError(t, err)
+}
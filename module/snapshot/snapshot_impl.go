@@ -8,7 +8,9 @@ SPDX-License-Identifier: Apache-2.0
 package snapshot
 
 import (
+	"encoding/binary"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +26,11 @@ import (
 	"chainmaker.org/chainmaker/utils/v2"
 )
 
+// blockVersion2380 from this block version on, the keys of the read/write tables and of the DAG are namespaced
+// by contract without collisions, see constructNamespacedKey. The block versions up to 2030799 are stamped by the
+// releases built on protocol v2.3.7 with the legacy keys, see TestNamespacedKeyBlockVersion
+const blockVersion2380 = uint32(2030800)
+
 // The record value is written by the SEQ corresponding to TX
 type sv struct {
 	seq   int
@@ -71,11 +78,16 @@ type SnapshotImpl struct {
 
 // NewQuerySnapshot create a snapshot for query tx
 func NewQuerySnapshot(store protocol.BlockchainStore, log protocol.Logger) (*SnapshotImpl, error) {
-	txCount := 1
 	lastBlock, err := store.GetLastBlock()
 	if err != nil {
 		return nil, err
 	}
+	return newQuerySnapshot(store, lastBlock, log)
+}
+
+func newQuerySnapshot(store protocol.BlockchainStore, block *commonPb.Block, log protocol.Logger) (
+	*SnapshotImpl, error) {
+	txCount := 1
 	lastChainConfig, err := store.GetLastChainConfig()
 	if err != nil || lastChainConfig == nil {
 		return nil, fmt.Errorf("failed to get last chain config, %v", err)
@@ -87,12 +99,12 @@ func NewQuerySnapshot(store protocol.BlockchainStore, log protocol.Logger) (*Sna
 		log:             log,
 		txResultMap:     make(map[string]*commonPb.Result, txCount),
 
-		chainId:         lastBlock.Header.ChainId,
-		blockHeight:     lastBlock.Header.BlockHeight,
-		blockVersion:    lastBlock.Header.BlockVersion,
-		blockTimestamp:  lastBlock.Header.BlockTimestamp,
-		blockProposer:   lastBlock.Header.Proposer,
-		preBlockHash:    lastBlock.Header.PreBlockHash,
+		chainId:         block.Header.ChainId,
+		blockHeight:     block.Header.BlockHeight,
+		blockVersion:    block.Header.BlockVersion,
+		blockTimestamp:  block.Header.BlockTimestamp,
+		blockProposer:   block.Header.Proposer,
+		preBlockHash:    block.Header.PreBlockHash,
 		lastChainConfig: lastChainConfig,
 
 		txTable:      make([]*commonPb.Transaction, 0, txCount),
@@ -105,9 +117,9 @@ func NewQuerySnapshot(store protocol.BlockchainStore, log protocol.Logger) (*Sna
 		applyAddReadTime:  atomic.NewInt64(0),
 		applyAddWriteTime: atomic.NewInt64(0),
 
-		txRoot:    lastBlock.Header.TxRoot,
-		dagHash:   lastBlock.Header.DagHash,
-		rwSetHash: lastBlock.Header.RwSetRoot,
+		txRoot:    block.Header.TxRoot,
+		dagHash:   block.Header.DagHash,
+		rwSetHash: block.Header.RwSetRoot,
 	}
 
 	return querySnapshot, nil
@@ -202,7 +214,7 @@ func (s *SnapshotImpl) GetKey(txExecSeq int, contractName string, key []byte) ([
 	//if txExecSeq > snapshotSize || txExecSeq < 0 {
 	//	txExecSeq = snapshotSize //nolint: ineffassign, staticcheck
 	//}
-	finalKey := constructKey(contractName, key)
+	finalKey := s.constructKey(contractName, key)
 	if sv, ok := s.writeTable.getByLock(finalKey); ok {
 		return sv.value, nil
 	}
@@ -299,7 +311,7 @@ func (s *SnapshotImpl) getBatchFromWriteSet(keys []*vmPb.BatchKey) ([]*vmPb.Batc
 	txWrites := make([]*vmPb.BatchKey, 0, len(keys))
 	emptyTxWrite := make([]*vmPb.BatchKey, 0, len(keys))
 	for _, key := range keys {
-		finalKey := constructKey(key.ContractName, protocol.GetKeyStr(key.Key, key.Field))
+		finalKey := s.constructKey(key.ContractName, protocol.GetKeyStr(key.Key, key.Field))
 		if sv, ok := s.writeTable.getByLock(finalKey); ok {
 			key.Value = sv.value
 			txWrites = append(txWrites, key)
@@ -320,7 +332,7 @@ func (s *SnapshotImpl) getBatchFromReadSet(keys []*vmPb.BatchKey) ([]*vmPb.Batch
 	txReads := make([]*vmPb.BatchKey, 0, len(keys))
 	emptyTxReadsKeys := make([]*vmPb.BatchKey, 0, len(keys))
 	for _, key := range keys {
-		finalKey := constructKey(key.ContractName, protocol.GetKeyStr(key.Key, key.Field))
+		finalKey := s.constructKey(key.ContractName, protocol.GetKeyStr(key.Key, key.Field))
 		if sv, ok := s.readTable.getByLock(finalKey); ok {
 			key.Value = sv.value
 			txReads = append(txReads, key)
@@ -360,7 +372,7 @@ func (s *SnapshotImpl) ApplyTxSimContext(txSimContext protocol.TxSimContext, spe
 	// 实现准备好要处理的数据
 	finalReadKvs := make(map[string]*sv, len(txRWSet.TxReads))
 	for _, txRead := range txRWSet.TxReads {
-		finalKey := constructKey(txRead.ContractName, txRead.Key)
+		finalKey := s.constructKey(txRead.ContractName, txRead.Key)
 		// 乐观检查，便于提前发现冲突
 		if sv, ok := s.writeTable.getByLock(finalKey); ok {
 			if sv.seq >= txExecSeq {
@@ -375,7 +387,7 @@ func (s *SnapshotImpl) ApplyTxSimContext(txSimContext protocol.TxSimContext, spe
 	finalWriteKvs := make(map[string]*sv, len(txRWSet.TxWrites))
 	// Append to write table
 	for _, txWrite := range txRWSet.TxWrites {
-		finalKey := constructKey(txWrite.ContractName, txWrite.Key)
+		finalKey := s.constructKey(txWrite.ContractName, txWrite.Key)
 		finalWriteKvs[finalKey] = &sv{
 			value: txWrite.Value,
 		}
@@ -437,7 +449,7 @@ func (s *SnapshotImpl) apply(tx *commonPb.Transaction, txRWSet *commonPb.TxRWSet
 	// by read, write and then read again the same value.
 	if s.blockVersion < 2201 || runVmSuccess {
 		for _, txRead := range txRWSet.TxReads {
-			finalKey := constructKey(txRead.ContractName, txRead.Key)
+			finalKey := s.constructKey(txRead.ContractName, txRead.Key)
 			s.readTable.putByLock(finalKey, &sv{
 				seq:   applySeq,
 				value: txRead.Value,
@@ -447,7 +459,7 @@ func (s *SnapshotImpl) apply(tx *commonPb.Transaction, txRWSet *commonPb.TxRWSet
 
 	// Append to write table
 	for _, txWrite := range txRWSet.TxWrites {
-		finalKey := constructKey(txWrite.ContractName, txWrite.Key)
+		finalKey := s.constructKey(txWrite.ContractName, txWrite.Key)
 		s.writeTable.putByLock(finalKey, &sv{
 			seq:   applySeq,
 			value: txWrite.Value,
@@ -623,14 +635,14 @@ func (s *SnapshotImpl) buildDictAndPos(txRWSetTable []*commonPb.TxRWSet) (map[st
 		writePos[i] = make(map[string]uint32, len(writeTableItemForI))
 		// put all read key in to readKeyDict and set their pos into readPos and writePos
 		for _, keyForI := range readTableItemForI {
-			key := string(keyForI.Key)
+			key := s.dagKey(keyForI.ContractName, keyForI.Key)
 			readPos[i][key] = uint32(len(readKeyDict[key]))
 			writePos[i][key] = uint32(len(writeKeyDict[key]))
 			readKeyDict[key] = append(readKeyDict[key], i)
 		}
 		// put all write key in to writeKeyDict and set their pos into readPos and writePos
 		for _, keyForI := range writeTableItemForI {
-			key := string(keyForI.Key)
+			key := s.dagKey(keyForI.ContractName, keyForI.Key)
 			writePos[i][key] = uint32(len(writeKeyDict[key]))
 			_, ok := readPos[i][key]
 			if !ok {
@@ -652,7 +664,7 @@ func (s *SnapshotImpl) buildReachMap(i uint32, txRWSet *commonPb.TxRWSet, readKe
 
 	//ReadSet && WriteSet conflict
 	for _, keyForI := range readTableItemForI {
-		readKey := string(keyForI.Key)
+		readKey := s.dagKey(keyForI.ContractName, keyForI.Key)
 		writeKeyTxs := writeKeyDict[readKey]
 		if len(writeKeyTxs) == 0 {
 			continue
@@ -666,7 +678,7 @@ func (s *SnapshotImpl) buildReachMap(i uint32, txRWSet *commonPb.TxRWSet, readKe
 	}
 	//WriteSet and (all ReadSet, WriteSet) conflict
 	for _, keyForI := range writeTableItemForI {
-		writeKey := string(keyForI.Key)
+		writeKey := s.dagKey(keyForI.ContractName, keyForI.Key)
 		readKeyTxs := readKeyDict[writeKey]
 		if len(readKeyTxs) > 0 {
 			// we should check all readKeyTxs because read keys has no conflict
@@ -693,7 +705,37 @@ func (s *SnapshotImpl) buildReachMap(i uint32, txRWSet *commonPb.TxRWSet, readKe
 	return directReachForI
 }
 
-// constructKey construct keys: contractName#key
+// constructKey construct the key of the read/write tables for the block version of the snapshot
+func (s *SnapshotImpl) constructKey(contractName string, key []byte) string {
+	if s.blockVersion < blockVersion2380 {
+		return constructKey(contractName, key)
+	}
+	return constructNamespacedKey(contractName, key)
+}
+
+// dagKey construct the key the DAG detects conflicts on, older block versions only use the key itself
+func (s *SnapshotImpl) dagKey(contractName string, key []byte) string {
+	if s.blockVersion < blockVersion2380 {
+		return string(key)
+	}
+	return constructNamespacedKey(contractName, key)
+}
+
+// constructNamespacedKey construct keys: uvarint(len(contractName))#contractName#key, the length prefix keeps
+// contract "ab" with key "c" apart from contract "a" with key "bc"
+func constructNamespacedKey(contractName string, key []byte) string {
+	var lenBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(lenBuf[:], uint64(len(contractName)))
+
+	var builder strings.Builder
+	builder.Grow(n + len(contractName) + len(key))
+	builder.Write(lenBuf[:n])
+	builder.WriteString(contractName)
+	builder.Write(key)
+	return builder.String()
+}
+
+// constructKey construct keys: contractName#key, used by block versions before blockVersion2380
 func constructKey(contractName string, key []byte) string {
 	// with higher performance
 	return contractName + string(key)
@@ -0,0 +1,122 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package snapshot
+
+import (
+	"fmt"
+	"testing"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"chainmaker.org/chainmaker/protocol/v2/test"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	uatomic "go.uber.org/atomic"
+)
+
+// adversarialKeyPairs pairs of (contract, key) which are different entries but share the legacy key
+var adversarialKeyPairs = [][2][2]string{
+	{{"ab", "c"}, {"a", "bc"}},
+	{{"", "abc"}, {"abc", ""}},
+	{{"a", "\x01bc"}, {"a\x01", "bc"}},
+	{{"\x01", "ab"}, {"", "\x01ab"}},
+	{{"contract1", "key#1"}, {"contract1key", "#1"}},
+	{{"c", "\x02c\x00"}, {"c\x02c", "\x00"}},
+}
+
+func newKeyTestSnapshot(blockVersion uint32, store protocol.BlockchainStore) *SnapshotImpl {
+	return &SnapshotImpl{
+		blockchainStore:   store,
+		sealed:            uatomic.NewBool(false),
+		blockHeight:       100,
+		blockVersion:      blockVersion,
+		txTable:           make([]*commonPb.Transaction, 0, 16),
+		txResultMap:       make(map[string]*commonPb.Result, 16),
+		readTable:         newShardSet(),
+		writeTable:        newShardSet(),
+		applyConflictTime: uatomic.NewInt64(0),
+		applyAddReadTime:  uatomic.NewInt64(0),
+		applyAddWriteTime: uatomic.NewInt64(0),
+		log:               &test.GoLogger{},
+	}
+}
+
+func TestConstructNamespacedKey_AdversarialPairs(t *testing.T) {
+	keys := make(map[string]string)
+	for _, pair := range adversarialKeyPairs {
+		require.Equal(t, constructKey(pair[0][0], []byte(pair[0][1])), constructKey(pair[1][0], []byte(pair[1][1])))
+
+		for _, entry := range pair {
+			key := constructNamespacedKey(entry[0], []byte(entry[1]))
+			name := fmt.Sprintf("%q/%q", entry[0], entry[1])
+			if other, ok := keys[key]; ok {
+				require.Equal(t, other, name, "%s and %s share a key", other, name)
+			}
+			keys[key] = name
+		}
+	}
+	require.Len(t, keys, 2*len(adversarialKeyPairs))
+}
+
+func TestSnapshotImpl_GetKeyNamespaced(t *testing.T) {
+	for _, pair := range adversarialKeyPairs {
+		written, read := pair[0], pair[1]
+
+		store := mock.NewMockBlockchainStore(gomock.NewController(t))
+		store.EXPECT().ReadObject(read[0], []byte(read[1])).Return([]byte("from store"), nil)
+		snapshot := newKeyTestSnapshot(blockVersion2380, store)
+
+		txSimContext := &MockSimContextImpl{
+			tx:       &commonPb.Transaction{Payload: &commonPb.Payload{TxId: "tx0"}},
+			txResult: &commonPb.Result{},
+			txRwSet: &commonPb.TxRWSet{TxId: "tx0", TxWrites: []*commonPb.TxWrite{
+				{ContractName: written[0], Key: []byte(written[1]), Value: []byte("written")},
+			}},
+		}
+		applied, _ := snapshot.ApplyTxSimContext(txSimContext, protocol.ExecOrderTxTypeNormal, true, false)
+		require.True(t, applied)
+
+		value, err := snapshot.GetKey(-1, written[0], []byte(written[1]))
+		require.NoError(t, err)
+		require.Equal(t, []byte("written"), value)
+		value, err = snapshot.GetKey(-1, read[0], []byte(read[1]))
+		require.NoError(t, err)
+		require.Equal(t, []byte("from store"), value, "%q/%q read the write of %q/%q",
+			read[0], read[1], written[0], written[1])
+	}
+}
+
+func TestSnapshotImpl_BuildDAGNamespaced(t *testing.T) {
+	rwSets := func(written, read [2]string) []*commonPb.TxRWSet {
+		return []*commonPb.TxRWSet{
+			{TxId: "tx0", TxWrites: []*commonPb.TxWrite{{ContractName: written[0], Key: []byte(written[1])}}},
+			{TxId: "tx1", TxReads: []*commonPb.TxRead{{ContractName: read[0], Key: []byte(read[1])}}},
+		}
+	}
+
+	for _, pair := range adversarialKeyPairs {
+		dag := newKeyTestSnapshot(blockVersion2380, nil).BuildDAG(false, rwSets(pair[0], pair[1]))
+		require.Empty(t, dag.Vertexes[1].Neighbors, "%q/%q depends on %q/%q", pair[1][0], pair[1][1],
+			pair[0][0], pair[0][1])
+
+		dag = newKeyTestSnapshot(blockVersion2380, nil).BuildDAG(false, rwSets(pair[0], pair[0]))
+		require.Equal(t, []uint32{0}, dag.Vertexes[1].Neighbors)
+	}
+
+	// the same key of different contracts only conflicts for older block versions, which must still verify
+	sameKey := rwSets([2]string{"contract1", "key"}, [2]string{"contract2", "key"})
+	dag := newKeyTestSnapshot(blockVersion2380, nil).BuildDAG(false, sameKey)
+	require.Empty(t, dag.Vertexes[1].Neighbors)
+	dag = newKeyTestSnapshot(blockVersion2380-1, nil).BuildDAG(false, sameKey)
+	require.Equal(t, []uint32{0}, dag.Vertexes[1].Neighbors)
+}
+
+func TestNamespacedKeyBlockVersion(t *testing.T) {
+	// the chains created by the releases with the legacy keys never reach the block version
+	require.Less(t, protocol.DefaultBlockVersion, blockVersion2380)
+}
@@ -0,0 +1,228 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subscriber
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/confreload"
+	"chainmaker.org/chainmaker/common/v2/monitor"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// OverflowPolicy decides what a dispatch queue does when it is full
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock the publisher waits until the queue has room, no event is lost, but a slow subscriber
+	// holds the commit of the blocks
+	OverflowPolicyBlock OverflowPolicy = "block"
+	// OverflowPolicyDropOldest the oldest queued event is dropped to make room for the new one
+	OverflowPolicyDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowPolicyDisconnect the subscribers which do not receive an event within
+	// SlowSubscriberTimeout are unsubscribed with ErrSlowSubscriber, so the queue keeps draining
+	OverflowPolicyDisconnect OverflowPolicy = "disconnect"
+)
+
+const (
+	// DefaultQueueSize the default capacity of each dispatch queue
+	DefaultQueueSize = 1024
+	// DefaultSlowSubscriberTimeout the default time a subscriber may take to receive one event
+	DefaultSlowSubscriberTimeout = 10 * time.Second
+
+	topicBlock         = "block"
+	topicContractEvent = "contract_event"
+
+	dropReasonOverflow     = "overflow"
+	dropReasonOutOfOrder   = "out_of_order"
+	dropReasonSlowConsumer = "slow_subscriber"
+
+	subsystemSubscriber    = "subscriber"
+	metricQueueDepth       = "metric_dispatch_queue_depth"
+	metricDroppedEvents    = "metric_dispatch_dropped_events"
+	helpQueueDepthMetric   = "events waiting in the dispatch queue"
+	helpDroppedEventMetric = "events dropped or subscribers disconnected by the dispatch queue"
+)
+
+// ConfigKey the section of the dispatch settings in chainmaker.yml, for example:
+//
+//	subscriber:
+//	  queue_size: 1024
+//	  overflow_policy: drop_oldest
+//	  slow_subscriber_timeout: 10s
+const ConfigKey = "subscriber"
+
+// Config the dispatch settings of EventSubscriber
+type Config struct {
+	// QueueSize the capacity of the queue of each event topic
+	QueueSize int `mapstructure:"queue_size"`
+	// OverflowPolicy what to do when a queue is full
+	OverflowPolicy OverflowPolicy `mapstructure:"overflow_policy"`
+	// SlowSubscriberTimeout only used by OverflowPolicyDisconnect
+	SlowSubscriberTimeout time.Duration `mapstructure:"slow_subscriber_timeout"`
+}
+
+// DefaultConfig returns the dispatch settings used when none is given. The events are published on the path of
+// committing the blocks, so a full queue drops its oldest event rather than holding the commit.
+func DefaultConfig() *Config {
+	return &Config{
+		QueueSize:             DefaultQueueSize,
+		OverflowPolicy:        OverflowPolicyDropOldest,
+		SlowSubscriberTimeout: DefaultSlowSubscriberTimeout,
+	}
+}
+
+func (c *Config) validate() error {
+	if c.QueueSize <= 0 {
+		return fmt.Errorf("invalid dispatch queue size %d", c.QueueSize)
+	}
+	switch c.OverflowPolicy {
+	case OverflowPolicyBlock, OverflowPolicyDropOldest:
+	case OverflowPolicyDisconnect:
+		if c.SlowSubscriberTimeout <= 0 {
+			return fmt.Errorf("invalid slow subscriber timeout %s", c.SlowSubscriberTimeout)
+		}
+	default:
+		return fmt.Errorf("unknown dispatch overflow policy %q", c.OverflowPolicy)
+	}
+	return nil
+}
+
+// queuedEvent an event waiting for dispatch, height is the block height the event belongs to
+type queuedEvent struct {
+	height uint64
+	value  interface{}
+}
+
+// dispatchQueue delivers the events of one topic to a feed from a single goroutine, so subscribers
+// receive them in the order they were queued. Events must be pushed with strictly increasing heights,
+// others are dropped, which gives subscribers strictly increasing heights even if the publisher repeats.
+type dispatchQueue struct {
+	chainId string
+	topic   string
+	feed    *Feed
+	config  *Config
+	log     protocol.Logger
+
+	// mu serializes push, so that the order of the queue is the order of the heights
+	mu         sync.Mutex
+	lastHeight uint64
+	pushed     bool
+
+	eventC chan *queuedEvent
+	stopC  chan struct{}
+
+	metricQueueDepth    *prometheus.GaugeVec
+	metricDroppedEvents *prometheus.CounterVec
+}
+
+func newDispatchQueue(chainId, topic string, feed *Feed, config *Config, log protocol.Logger) *dispatchQueue {
+	q := &dispatchQueue{
+		chainId: chainId,
+		topic:   topic,
+		feed:    feed,
+		config:  config,
+		log:     log,
+		eventC:  make(chan *queuedEvent, config.QueueSize),
+		stopC:   make(chan struct{}),
+	}
+	if confreload.Config().MonitorConfig.Enabled {
+		q.metricQueueDepth = monitor.NewGaugeVec(subsystemSubscriber, metricQueueDepth,
+			helpQueueDepthMetric, "chainId", "topic")
+		q.metricDroppedEvents = monitor.NewCounterVec(subsystemSubscriber, metricDroppedEvents,
+			helpDroppedEventMetric, "chainId", "topic", "reason")
+	}
+	go q.run()
+	return q
+}
+
+// push queue the event of the given height, according to the overflow policy when the queue is full
+func (q *dispatchQueue) push(height uint64, value interface{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.pushed && height <= q.lastHeight {
+		q.log.Warnf("%s event of height %d is out of order, last queued height is %d, dropped",
+			q.topic, height, q.lastHeight)
+		q.dropped(dropReasonOutOfOrder, 1)
+		return
+	}
+	q.pushed = true
+	q.lastHeight = height
+
+	event := &queuedEvent{height: height, value: value}
+	if q.config.OverflowPolicy == OverflowPolicyDropOldest {
+		for {
+			select {
+			case q.eventC <- event:
+				q.updateDepth()
+				return
+			case <-q.stopC:
+				return
+			default:
+			}
+			select {
+			case old := <-q.eventC:
+				q.log.Warnf("%s dispatch queue is full, event of height %d dropped", q.topic, old.height)
+				q.dropped(dropReasonOverflow, 1)
+			default:
+			}
+		}
+	}
+
+	select {
+	case q.eventC <- event:
+		q.updateDepth()
+	case <-q.stopC:
+	}
+}
+
+// run send the queued events one by one until stop
+func (q *dispatchQueue) run() {
+	for {
+		select {
+		case event := <-q.eventC:
+			q.updateDepth()
+			q.send(event)
+		case <-q.stopC:
+			return
+		}
+	}
+}
+
+func (q *dispatchQueue) send(event *queuedEvent) {
+	if q.config.OverflowPolicy != OverflowPolicyDisconnect {
+		q.feed.Send(event.value)
+		return
+	}
+	_, slow := q.feed.SendTimeout(event.value, q.config.SlowSubscriberTimeout)
+	if slow > 0 {
+		q.log.Warnf("%d %s subscribers did not receive the event of height %d in %s, disconnected",
+			slow, q.topic, event.height, q.config.SlowSubscriberTimeout)
+		q.dropped(dropReasonSlowConsumer, slow)
+	}
+}
+
+// stop the dispatch goroutine after the event being sent, the events still queued are discarded
+func (q *dispatchQueue) stop() {
+	close(q.stopC)
+}
+
+func (q *dispatchQueue) updateDepth() {
+	if q.metricQueueDepth != nil {
+		q.metricQueueDepth.WithLabelValues(q.chainId, q.topic).Set(float64(len(q.eventC)))
+	}
+}
+
+func (q *dispatchQueue) dropped(reason string, count int) {
+	if q.metricDroppedEvents != nil {
+		q.metricDroppedEvents.WithLabelValues(q.chainId, q.topic, reason).Add(float64(count))
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package subscriber
+
+import (
+	"testing"
+	"time"
+
+	"chainmaker.org/chainmaker/protocol/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDispatchQueue(t *testing.T, feed *Feed, config *Config) *dispatchQueue {
+	require.NoError(t, config.validate())
+	q := newDispatchQueue("chain1", topicBlock, feed, config, &test.GoLogger{})
+	t.Cleanup(q.stop)
+	return q
+}
+
+func receiveHeights(t *testing.T, ch <-chan uint64, count int) []uint64 {
+	heights := make([]uint64, 0, count)
+	for i := 0; i < count; i++ {
+		select {
+		case height := <-ch:
+			heights = append(heights, height)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("received %d events, want %d", len(heights), count)
+		}
+	}
+	return heights
+}
+
+func TestDispatchQueue_Ordered(t *testing.T) {
+	var feed Feed
+	ch := make(chan uint64)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	q := newTestDispatchQueue(t, &feed, &Config{QueueSize: 4, OverflowPolicy: OverflowPolicyBlock})
+
+	go func() {
+		for height := uint64(1); height <= 100; height++ {
+			q.push(height, height)
+		}
+		// repeated and older heights never reach subscribers
+		q.push(100, uint64(100))
+		q.push(50, uint64(50))
+		q.push(101, uint64(101))
+	}()
+
+	heights := receiveHeights(t, ch, 101)
+	for i, height := range heights {
+		require.Equal(t, uint64(i+1), height)
+	}
+}
+
+func TestDispatchQueue_DropOldest(t *testing.T) {
+	var feed Feed
+	ch := make(chan uint64)
+	sub := feed.Subscribe(ch)
+	defer sub.Unsubscribe()
+	q := newTestDispatchQueue(t, &feed, &Config{QueueSize: 2, OverflowPolicy: OverflowPolicyDropOldest})
+
+	// the subscriber does not receive, so the dispatcher holds the first event and the queue fills up
+	q.push(1, uint64(1))
+	require.Eventually(t, func() bool { return len(q.eventC) == 0 }, time.Second, time.Millisecond)
+	for height := uint64(2); height <= 10; height++ {
+		q.push(height, height)
+	}
+
+	heights := receiveHeights(t, ch, 3)
+	require.Equal(t, uint64(1), heights[0])
+	require.Equal(t, []uint64{9, 10}, heights[1:])
+}
+
+func TestDispatchQueue_DisconnectSlowSubscriber(t *testing.T) {
+	var feed Feed
+	slowC := make(chan uint64)
+	slowSub := feed.Subscribe(slowC)
+	fastC := make(chan uint64, 10)
+	fastSub := feed.Subscribe(fastC)
+	defer fastSub.Unsubscribe()
+	q := newTestDispatchQueue(t, &feed, &Config{QueueSize: 2, OverflowPolicy: OverflowPolicyDisconnect,
+		SlowSubscriberTimeout: 50 * time.Millisecond})
+
+	for height := uint64(1); height <= 5; height++ {
+		q.push(height, height)
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, receiveHeights(t, fastC, 5))
+	require.Equal(t, ErrSlowSubscriber, <-slowSub.Err())
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, DefaultConfig().validate())
+	require.Error(t, (&Config{QueueSize: 0, OverflowPolicy: OverflowPolicyBlock}).validate())
+	require.Error(t, (&Config{QueueSize: 1, OverflowPolicy: "unknown"}).validate())
+	require.Error(t, (&Config{QueueSize: 1, OverflowPolicy: OverflowPolicyDisconnect}).validate())
+}
@@ -10,10 +10,14 @@ import (
 	"errors"
 	"reflect"
 	"sync"
+	"time"
 )
 
 var errBadChannel = errors.New("event: Subscribe argument does not have sendable channel type")
 
+// ErrSlowSubscriber is sent on the Err channel of a subscription which is dropped by SendTimeout
+var ErrSlowSubscriber = errors.New("event: subscriber did not receive in time and was unsubscribed")
+
 type Subscription interface {
 	Err() <-chan error // returns the error channel
 	Unsubscribe()
@@ -37,6 +41,7 @@ type Feed struct {
 	mu    sync.Mutex
 	inbox caseList
 	etype reflect.Type
+	subs  map[*feedSub]struct{} // all active subscriptions, used to find the slow ones
 }
 
 // This is the index of the first actual subscription channel in sendCases.
@@ -57,6 +62,7 @@ func (f *Feed) init() {
 	f.sendLock = make(chan struct{}, 1)
 	f.sendLock <- struct{}{}
 	f.sendCases = caseList{{Chan: reflect.ValueOf(f.removeSub), Dir: reflect.SelectRecv}}
+	f.subs = make(map[*feedSub]struct{})
 }
 
 // Subscribe adds a channel to the feed. Future sends will be delivered on the channel
@@ -83,6 +89,7 @@ func (f *Feed) Subscribe(channel interface{}) Subscription {
 	// The next Send will add it to f.sendCases.
 	cas := reflect.SelectCase{Dir: reflect.SelectSend, Chan: chanval}
 	f.inbox = append(f.inbox, cas)
+	f.subs[sub] = struct{}{}
 	return sub
 }
 
@@ -100,6 +107,7 @@ func (f *Feed) remove(sub *feedSub) {
 	// that have not been added to f.sendCases yet.
 	ch := sub.channel.Interface()
 	f.mu.Lock()
+	delete(f.subs, sub)
 	index := f.inbox.find(ch)
 	if index != -1 {
 		f.inbox = f.inbox.delete(index)
@@ -113,7 +121,10 @@ func (f *Feed) remove(sub *feedSub) {
 		// Send will remove the channel from f.sendCases.
 	case <-f.sendLock:
 		// No Send is in progress, delete the channel now that we have the send lock.
-		f.sendCases = f.sendCases.delete(f.sendCases.find(ch))
+		// The channel may be gone already if SendTimeout dropped it as a slow subscriber.
+		if index = f.sendCases.find(ch); index != -1 {
+			f.sendCases = f.sendCases.delete(index)
+		}
 		f.sendLock <- struct{}{}
 	}
 }
@@ -121,6 +132,28 @@ func (f *Feed) remove(sub *feedSub) {
 // Send delivers to all subscribed channels simultaneously.
 // It returns the number of subscribers that the value was sent to.
 func (f *Feed) Send(value interface{}) (nsent int) {
+	nsent, _ = f.send(value, nil)
+	return nsent
+}
+
+// SendTimeout delivers like Send, but gives up on the subscribers which have not received the value
+// within timeout. Those subscriptions are removed from the feed and ErrSlowSubscriber is sent on their
+// Err channel. It returns the number of subscribers that the value was sent to and the number dropped.
+func (f *Feed) SendTimeout(value interface{}, timeout time.Duration) (nsent, ndropped int) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	nsent, slow := f.send(value, timer.C)
+	// the subscriptions are failed after the send lock is released, so that an Unsubscribe
+	// waiting for the lock can not dead lock with them
+	for _, sub := range slow {
+		sub.fail(ErrSlowSubscriber)
+	}
+	return nsent, len(slow)
+}
+
+// send delivers the value until all subscribers received it or timeoutC fires,
+// a nil timeoutC waits forever. The subscriptions dropped on timeout are returned.
+func (f *Feed) send(value interface{}, timeoutC <-chan time.Time) (nsent int, slow []*feedSub) {
 	rvalue := reflect.ValueOf(value)
 
 	f.once.Do(f.init)
@@ -162,7 +195,17 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 			break
 		}
 		// Select on all the receivers, waiting for them to unblock.
-		chosen, recv, _ := reflect.Select(cases)
+		selectCases := cases
+		if timeoutC != nil {
+			// the timeout case is appended to a copy, cases must stay a prefix of f.sendCases
+			selectCases = append(cases[:len(cases):len(cases)],
+				reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timeoutC)})
+		}
+		chosen, recv, _ := reflect.Select(selectCases)
+		if chosen == len(cases) /* <-timeoutC */ {
+			slow = f.dropSlow(cases[firstSubSendCase:])
+			break
+		}
 		if chosen == 0 /* <-f.removeSub */ {
 			index := f.sendCases.find(recv.Interface())
 			f.sendCases = f.sendCases.delete(index)
@@ -181,7 +224,36 @@ func (f *Feed) Send(value interface{}) (nsent int) {
 		f.sendCases[i].Send = reflect.Value{}
 	}
 	f.sendLock <- struct{}{}
-	return nsent
+	return nsent, slow
+}
+
+// dropSlow removes the channels of the given cases from f.sendCases and returns their subscriptions.
+// note: callers must hold f.sendLock
+func (f *Feed) dropSlow(cases caseList) []*feedSub {
+	channels := make([]interface{}, 0, len(cases))
+	for _, cas := range cases {
+		channels = append(channels, cas.Chan.Interface())
+	}
+	for _, ch := range channels {
+		if index := f.sendCases.find(ch); index != -1 {
+			f.sendCases[index].Send = reflect.Value{}
+			f.sendCases = f.sendCases.delete(index)
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	slow := make([]*feedSub, 0, len(channels))
+	for sub := range f.subs {
+		for _, ch := range channels {
+			if sub.channel.Interface() == ch {
+				delete(f.subs, sub)
+				slow = append(slow, sub)
+				break
+			}
+		}
+	}
+	return slow
 }
 
 type feedSub struct {
@@ -198,6 +270,14 @@ func (sub *feedSub) Unsubscribe() {
 	})
 }
 
+// fail closes the subscription with err, the channel is already removed from the feed.
+func (sub *feedSub) fail(err error) {
+	sub.errOnce.Do(func() {
+		sub.err <- err
+		close(sub.err)
+	})
+}
+
 func (sub *feedSub) Err() <-chan error {
 	return sub.err
 }
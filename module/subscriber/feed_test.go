@@ -322,3 +322,29 @@ func BenchmarkFeedSend1000(b *testing.B) {
 	b.StopTimer()
 	done.Wait()
 }
+
+func TestFeedSendTimeout(t *testing.T) {
+	var feed Feed
+	fastC := make(chan int, 1)
+	slowC := make(chan int)
+	fastSub := feed.Subscribe(fastC)
+	slowSub := feed.Subscribe(slowC)
+	defer fastSub.Unsubscribe()
+
+	nsent, ndropped := feed.SendTimeout(1, 50*time.Millisecond)
+	if nsent != 1 || ndropped != 1 {
+		t.Fatalf("SendTimeout returned (%d, %d), want (1, 1)", nsent, ndropped)
+	}
+	if v := <-fastC; v != 1 {
+		t.Errorf("received %d, want 1", v)
+	}
+	if err := <-slowSub.Err(); err != ErrSlowSubscriber {
+		t.Errorf("slow subscription err is %v, want %v", err, ErrSlowSubscriber)
+	}
+	// unsubscribing a dropped subscription is a no-op
+	slowSub.Unsubscribe()
+
+	if nsent = feed.Send(2); nsent != 1 {
+		t.Errorf("Send delivered to %d subscribers, want 1", nsent)
+	}
+}
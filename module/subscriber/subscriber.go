@@ -8,36 +8,62 @@ SPDX-License-Identifier: Apache-2.0
 package subscriber
 
 import (
+	"sync"
+
 	"chainmaker.org/chainmaker-go/module/subscriber/model"
 	"chainmaker.org/chainmaker/common/v2/msgbus"
 	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2"
 )
 
 // EventSubscriber - new EventSubscriber struct
+// Events of each topic are delivered in order of block height by a bounded dispatch queue,
+// the zero value delivers synchronously from OnMessage.
 type EventSubscriber struct {
 	blockFeed         Feed
 	contractEventFeed Feed
+
+	blockQueue         *dispatchQueue
+	contractEventQueue *dispatchQueue
+	quitOnce           sync.Once
 }
 
 // OnMessage - deal msgbus.BlockInfo message
 func (s *EventSubscriber) OnMessage(msg *msgbus.Message) {
 	if blockInfo, ok := msg.Payload.(*commonPb.BlockInfo); ok {
-		go s.blockFeed.Send(model.NewBlockEvent{BlockInfo: blockInfo})
+		s.dispatch(s.blockQueue, &s.blockFeed, blockInfo.Block.Header.BlockHeight,
+			model.NewBlockEvent{BlockInfo: blockInfo})
 	}
 	if conEventMessageInfo, ok := msg.Payload.(*commonPb.ContractEventMessageInfo); ok {
-		go s.contractEventFeed.Send(model.NewContractEvent{
-			BlockHeight:           conEventMessageInfo.BlockHeight,
-			ChainId:               conEventMessageInfo.ChainId,
-			ContractEventInfoList: conEventMessageInfo.ContractEventList})
+		s.dispatch(s.contractEventQueue, &s.contractEventFeed, conEventMessageInfo.BlockHeight,
+			model.NewContractEvent{
+				BlockHeight:           conEventMessageInfo.BlockHeight,
+				ChainId:               conEventMessageInfo.ChainId,
+				ContractEventInfoList: conEventMessageInfo.ContractEventList})
 	}
 }
 
-// OnQuit - deal msgbus OnQuit message
+func (s *EventSubscriber) dispatch(queue *dispatchQueue, feed *Feed, height uint64, value interface{}) {
+	if queue == nil {
+		feed.Send(value)
+		return
+	}
+	queue.push(height, value)
+}
+
+// OnQuit - deal msgbus OnQuit message, stop the dispatch queues
 func (s *EventSubscriber) OnQuit() {
-	// do nothing
+	s.quitOnce.Do(func() {
+		if s.blockQueue != nil {
+			s.blockQueue.stop()
+		}
+		if s.contractEventQueue != nil {
+			s.contractEventQueue.stop()
+		}
+	})
 }
 
-// NewSubscriber - new and register msgbus.BlockInfo object
+// NewSubscriber - new and register msgbus.BlockInfo object, events are delivered synchronously
 func NewSubscriber(msgBus msgbus.MessageBus) *EventSubscriber {
 	subscriber := &EventSubscriber{}
 	msgBus.Register(msgbus.BlockInfo, subscriber)
@@ -46,12 +72,36 @@ func NewSubscriber(msgBus msgbus.MessageBus) *EventSubscriber {
 	return subscriber
 }
 
-// SubscribeBlockEvent - subscribe block event
+// NewSubscriberWithConfig - new and register msgbus.BlockInfo object,
+// events are delivered through dispatch queues built from config
+func NewSubscriberWithConfig(chainId string, msgBus msgbus.MessageBus, config *Config,
+	log protocol.Logger) (*EventSubscriber, error) {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	subscriber := &EventSubscriber{}
+	subscriber.blockQueue = newDispatchQueue(chainId, topicBlock, &subscriber.blockFeed, config, log)
+	subscriber.contractEventQueue = newDispatchQueue(chainId, topicContractEvent,
+		&subscriber.contractEventFeed, config, log)
+	msgBus.Register(msgbus.BlockInfo, subscriber)
+
+	msgBus.Register(msgbus.ContractEventInfo, subscriber)
+	return subscriber, nil
+}
+
+// SubscribeBlockEvent - subscribe block event, the heights received are strictly increasing.
+// The subscription is closed with ErrSlowSubscriber if the channel is not drained fast enough
+// under OverflowPolicyDisconnect.
 func (s *EventSubscriber) SubscribeBlockEvent(ch chan<- model.NewBlockEvent) Subscription {
 	return s.blockFeed.Subscribe(ch)
 }
 
-// SubscribeContractEvent - subscribe contract event
+// SubscribeContractEvent - subscribe contract event, the heights received are strictly increasing.
+// The subscription is closed with ErrSlowSubscriber if the channel is not drained fast enough
+// under OverflowPolicyDisconnect.
 func (s *EventSubscriber) SubscribeContractEvent(ch chan<- model.NewContractEvent) Subscription {
 	return s.contractEventFeed.Subscribe(ch)
 }
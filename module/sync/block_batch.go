@@ -0,0 +1,68 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sync
+
+//go:generate protoc -I=$CHAINMAKER_PB_PATH -I=. --gogofaster_out=paths=source_relative:. block_sync_budget.proto
+
+import (
+	syncPb "chainmaker.org/chainmaker/pb-go/v2/sync"
+	"github.com/gogo/protobuf/proto"
+)
+
+// defaultMaxBatchBytes the default byte budget of a block sync response
+const defaultMaxBatchBytes = 4 * 1024 * 1024
+
+// encodeBlockSyncReq marshal the request, wrapped in a BlockSyncReqWithBudget along with the byte budget of the
+// responses if it is not zero. The peers not knowing the wrapper fail to decode it and never respond, see
+// scheduler.blockSyncReqBudget.
+func encodeBlockSyncReq(req *syncPb.BlockSyncReq, maxBytes uint64) ([]byte, error) {
+	if maxBytes == 0 {
+		return proto.Marshal(req)
+	}
+	return proto.Marshal(&BlockSyncReqWithBudget{Req: req, MaxBatchBytes: maxBytes})
+}
+
+// decodeBlockSyncReq returns the request in the payload and the byte budget of its responses, 0 if the requester
+// did not ask for batched responses. A bare BlockSyncReq never decodes as a BlockSyncReqWithBudget with the
+// request, its first field is a varint rather than a message.
+func decodeBlockSyncReq(payload []byte) (*syncPb.BlockSyncReq, uint64, error) {
+	wrapped := &BlockSyncReqWithBudget{}
+	if err := proto.Unmarshal(payload, wrapped); err == nil && wrapped.Req != nil {
+		return wrapped.Req, wrapped.MaxBatchBytes, nil
+	}
+	req := &syncPb.BlockSyncReq{}
+	if err := proto.Unmarshal(payload, req); err != nil {
+		return nil, 0, err
+	}
+	return req, 0, nil
+}
+
+// blockSyncReqBudget returns the byte budget asked for in the requests to the peer. A peer is asked with the budget
+// until a request with it times out before the peer responds to any, the peer is taken as not knowing the budget
+// then and asked for a block per response from then on.
+func (sch *scheduler) blockSyncReqBudget(peer string) uint64 {
+	if known, exist := sch.budgetPeers[peer]; exist && !known {
+		return 0
+	}
+	return sch.maxBatchBytes
+}
+
+// observeBudgetResponse the peer responds to the requests with the byte budget
+func (sch *scheduler) observeBudgetResponse(peer string) {
+	if _, exist := sch.budgetPeers[peer]; !exist && sch.maxBatchBytes > 0 {
+		sch.budgetPeers[peer] = true
+	}
+}
+
+// observeBudgetTimeout a request to the peer times out
+func (sch *scheduler) observeBudgetTimeout(peer string) {
+	if _, exist := sch.budgetPeers[peer]; !exist && sch.maxBatchBytes > 0 {
+		sch.log.Infof("node [%s] never responded to the requests with the byte budget, asks it for a block per "+
+			"response", peer)
+		sch.budgetPeers[peer] = false
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
+	netPb "chainmaker.org/chainmaker/pb-go/v2/net"
+	syncPb "chainmaker.org/chainmaker/pb-go/v2/sync"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"chainmaker.org/chainmaker/protocol/v2/test"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeBlockSyncReq(t *testing.T) {
+	req := &syncPb.BlockSyncReq{BlockHeight: 10, BatchSize: 8, WithRwset: true}
+
+	// 1. the request without the byte budget is the same as before
+	bz, err := encodeBlockSyncReq(req, 0)
+	require.NoError(t, err)
+	legacy, err := proto.Marshal(req)
+	require.NoError(t, err)
+	require.Equal(t, legacy, bz)
+	decoded, maxBytes, err := decodeBlockSyncReq(bz)
+	require.NoError(t, err)
+	require.Equal(t, req, decoded)
+	require.Zero(t, maxBytes)
+
+	// 2. the request is wrapped along with the byte budget
+	bz, err = encodeBlockSyncReq(req, 1024)
+	require.NoError(t, err)
+	decoded, maxBytes, err = decodeBlockSyncReq(bz)
+	require.NoError(t, err)
+	require.Equal(t, req, decoded)
+	require.EqualValues(t, 1024, maxBytes)
+
+	// 3. the bare request of the height 0 is not taken as wrapped
+	decoded, maxBytes, err = decodeBlockSyncReq(legacyReq(t, &syncPb.BlockSyncReq{BatchSize: 8}))
+	require.NoError(t, err)
+	require.EqualValues(t, 8, decoded.BatchSize)
+	require.Zero(t, maxBytes)
+
+	// 4. the malformed payload
+	_, _, err = decodeBlockSyncReq(bz[:len(bz)-1])
+	require.Error(t, err)
+}
+
+func legacyReq(t *testing.T, req *syncPb.BlockSyncReq) []byte {
+	bz, err := proto.Marshal(req)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestSchedulerBlockSyncReqBudget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLedger := newMockLedgerCache(ctrl, &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 5}})
+	sch := newScheduler(NewMockSender(), mockLedger, 100, time.Second, time.Second*3,
+		2, &test.GoLogger{}, make(chan struct{}), 10, 10, nil)
+	sch.maxBatchBytes = 1024
+
+	// 1. the peers are asked with the budget until they time out without responding
+	require.EqualValues(t, 1024, sch.blockSyncReqBudget("node1"))
+	sch.observeBudgetTimeout("node1")
+	require.Zero(t, sch.blockSyncReqBudget("node1"))
+	sch.observeBudgetResponse("node1")
+	require.Zero(t, sch.blockSyncReqBudget("node1"))
+
+	// 2. a peer having responded keeps being asked with the budget after a timeout
+	sch.observeBudgetResponse("node2")
+	sch.observeBudgetTimeout("node2")
+	require.EqualValues(t, 1024, sch.blockSyncReqBudget("node2"))
+
+	// 3. nothing is learned without the budget
+	sch.maxBatchBytes = 0
+	sch.observeBudgetTimeout("node3")
+	sch.maxBatchBytes = 1024
+	require.EqualValues(t, 1024, sch.blockSyncReqBudget("node3"))
+}
+
+func TestSendInfosBatched(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	var batches [][]uint64
+	mockNet := mock.NewMockNetService(ctrl)
+	mockNet.EXPECT().SendMsg(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(msg []byte, msgType netPb.NetMsg_MsgType, to ...string) error {
+			syncMsg := syncPb.SyncMsg{}
+			require.NoError(t, proto.Unmarshal(msg, &syncMsg))
+			blkBatch := syncPb.SyncBlockBatch{}
+			require.NoError(t, proto.Unmarshal(syncMsg.Payload, &blkBatch))
+			var heights []uint64
+			for _, info := range blkBatch.GetBlockinfoBatch().GetBatch() {
+				heights = append(heights, info.Block.Header.BlockHeight)
+			}
+			batches = append(batches, heights)
+			return nil
+		}).AnyTimes()
+	mockStore := newMockBlockChainStore(ctrl)
+	for i := uint64(1); i <= 5; i++ {
+		require.NoError(t, mockStore.PutBlock(&commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: i}}, nil))
+	}
+	sync := &BlockChainSyncServer{net: mockNet, blockChainStore: mockStore, log: &test.GoLogger{}}
+	req := &syncPb.BlockSyncReq{BlockHeight: 1, BatchSize: 5}
+
+	// 1. a block per message without the byte budget
+	require.NoError(t, sync.sendInfos(req, 0, "node1"))
+	require.Equal(t, [][]uint64{{1}, {2}, {3}, {4}, {5}}, batches)
+
+	// 2. the blocks are packed within the byte budget
+	batches = nil
+	size := (&commonPb.BlockInfo{Block: &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 1}}}).Size()
+	require.NoError(t, sync.sendInfos(req, uint64(2*size), "node1"))
+	require.Equal(t, [][]uint64{{1, 2}, {3, 4}, {5}}, batches)
+
+	// 3. a block larger than the budget is sent alone
+	batches = nil
+	require.NoError(t, sync.sendInfos(req, 1, "node1"))
+	require.Len(t, batches, 5)
+}
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: block_sync_budget.proto
+
+package sync
+
+import (
+	sync "chainmaker.org/chainmaker/pb-go/v2/sync"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockSyncReqWithBudget a block sync request along with the byte budget of its responses, sent as the payload of
+// a BLOCK_SYNC_REQ message instead of the bare BlockSyncReq if the budget is not zero
+type BlockSyncReqWithBudget struct {
+	Req *sync.BlockSyncReq `protobuf:"bytes,1,opt,name=req,proto3" json:"req,omitempty"`
+	// the responder packs consecutive blocks into a response of at most this many bytes, 0 for a block per response
+	MaxBatchBytes uint64 `protobuf:"varint,2,opt,name=max_batch_bytes,json=maxBatchBytes,proto3" json:"max_batch_bytes,omitempty"`
+}
+
+func (m *BlockSyncReqWithBudget) Reset()         { *m = BlockSyncReqWithBudget{} }
+func (m *BlockSyncReqWithBudget) String() string { return proto.CompactTextString(m) }
+func (*BlockSyncReqWithBudget) ProtoMessage()    {}
+func (*BlockSyncReqWithBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c9db6cc1e780ebe, []int{0}
+}
+func (m *BlockSyncReqWithBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockSyncReqWithBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockSyncReqWithBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockSyncReqWithBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSyncReqWithBudget.Merge(m, src)
+}
+func (m *BlockSyncReqWithBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockSyncReqWithBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSyncReqWithBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSyncReqWithBudget proto.InternalMessageInfo
+
+func (m *BlockSyncReqWithBudget) GetReq() *sync.BlockSyncReq {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (m *BlockSyncReqWithBudget) GetMaxBatchBytes() uint64 {
+	if m != nil {
+		return m.MaxBatchBytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BlockSyncReqWithBudget)(nil), "sync.BlockSyncReqWithBudget")
+}
+
+func init() { proto.RegisterFile("block_sync_budget.proto", fileDescriptor_3c9db6cc1e780ebe) }
+
+var fileDescriptor_3c9db6cc1e780ebe = []byte{
+	// 201 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xca, 0xc9, 0x4f,
+	0xce, 0x8e, 0x2f, 0xae, 0xcc, 0x4b, 0x8e, 0x4f, 0x2a, 0x4d, 0x49, 0x4f, 0x2d, 0xd1, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x62, 0x01, 0x09, 0x49, 0xf1, 0x83, 0x48, 0x7d, 0x10, 0x01, 0x11, 0x56,
+	0x4a, 0xe3, 0x12, 0x73, 0x02, 0xe9, 0x08, 0xae, 0xcc, 0x4b, 0x0e, 0x4a, 0x2d, 0x0c, 0xcf, 0x2c,
+	0xc9, 0x70, 0x02, 0x6b, 0x13, 0x52, 0xe1, 0x62, 0x2e, 0x4a, 0x2d, 0x94, 0x60, 0x54, 0x60, 0xd4,
+	0xe0, 0x36, 0x12, 0xd2, 0x03, 0xeb, 0x41, 0x56, 0x1a, 0x04, 0x92, 0x16, 0x52, 0xe3, 0xe2, 0xcf,
+	0x4d, 0xac, 0x88, 0x4f, 0x4a, 0x2c, 0x49, 0xce, 0x88, 0x4f, 0xaa, 0x2c, 0x49, 0x2d, 0x96, 0x60,
+	0x52, 0x60, 0xd4, 0x60, 0x09, 0xe2, 0xcd, 0x4d, 0xac, 0x70, 0x02, 0x89, 0x3a, 0x81, 0x04, 0x9d,
+	0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
+	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23, 0x39, 0x23, 0x31,
+	0x33, 0x2f, 0x37, 0x31, 0x3b, 0xb5, 0x48, 0x2f, 0xbf, 0x28, 0x5d, 0x1f, 0xc1, 0xd5, 0x4d, 0xcf,
+	0xd7, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x05, 0xbb, 0x38, 0x89, 0x0d, 0xec, 0x64, 0x63, 0xc0,
+	0x00, 0x29, 0xbc, 0xcd, 0x57, 0xe4, 0x00, 0x00, 0x00,
+}
+
+func (m *BlockSyncReqWithBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockSyncReqWithBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockSyncReqWithBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchBytes != 0 {
+		i = encodeVarintBlockSyncBudget(dAtA, i, uint64(m.MaxBatchBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlockSyncBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlockSyncBudget(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlockSyncBudget(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockSyncReqWithBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovBlockSyncBudget(uint64(l))
+	}
+	if m.MaxBatchBytes != 0 {
+		n += 1 + sovBlockSyncBudget(uint64(m.MaxBatchBytes))
+	}
+	return n
+}
+
+func sovBlockSyncBudget(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlockSyncBudget(x uint64) (n int) {
+	return sovBlockSyncBudget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockSyncReqWithBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockSyncBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockSyncReqWithBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockSyncReqWithBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockSyncBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlockSyncBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockSyncBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &sync.BlockSyncReq{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchBytes", wireType)
+			}
+			m.MaxBatchBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockSyncBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockSyncBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockSyncBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlockSyncBudget(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlockSyncBudget
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockSyncBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockSyncBudget
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlockSyncBudget
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlockSyncBudget
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlockSyncBudget
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlockSyncBudget        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlockSyncBudget          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlockSyncBudget = fmt.Errorf("proto: unexpected end of group")
+)
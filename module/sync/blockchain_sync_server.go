@@ -14,12 +14,11 @@ import (
 	"sync/atomic"
 	"time"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	commonErrors "chainmaker.org/chainmaker/common/v2/errors"
 	"chainmaker.org/chainmaker/common/v2/msgbus"
-	"chainmaker.org/chainmaker/localconf/v2"
 	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
 	netPb "chainmaker.org/chainmaker/pb-go/v2/net"
-	storePb "chainmaker.org/chainmaker/pb-go/v2/store"
 	syncPb "chainmaker.org/chainmaker/pb-go/v2/sync"
 	"chainmaker.org/chainmaker/protocol/v2"
 	"chainmaker.org/chainmaker/utils/v2"
@@ -66,6 +65,13 @@ type BlockChainSyncServer struct {
 	nodeList *NodeList
 	//getStateFn used to get some running state
 	getStateFn getStateFn
+	// the conf with the tickers reloaded from the local config, for the loop and the block request entrance
+	reloadTickersC     chan *BlockSyncServerConf
+	reloadRequestTimeC chan time.Duration
+	// score the peers and select the peer which the blocks are requested from, shared with the scheduler
+	peerSelector *peerSelector
+	// the blocks failed to be verified and the peers banned for them, shared with the scheduler and the processor
+	quarantine *Quarantine
 }
 
 // NewBlockChainSyncServer Create a new BlockChainSyncServer instance
@@ -93,6 +99,15 @@ func NewBlockChainSyncServer(
 		minLagReachC:    make(chan struct{}),
 		commitBlockC:    make(chan struct{}),
 		nodeList:        NewNodeList(),
+
+		reloadTickersC:     make(chan *BlockSyncServerConf),
+		reloadRequestTimeC: make(chan time.Duration),
+		peerSelector:       newPeerSelector(),
+		quarantine:         NewQuarantine(),
+	}
+	if blockchainStore != nil {
+		// the bans of the peers survive a restart
+		syncServer.quarantine = newPersistentQuarantine(blockchainStore.GetDBHandle(""), chainId, log)
 	}
 	return syncServer
 }
@@ -115,6 +130,16 @@ func (sync *BlockChainSyncServer) Start() error {
 	if scheduler == nil {
 		return fmt.Errorf("init scheduler failed")
 	}
+	if len(sync.conf.peerSelectStrategy) > 0 {
+		if err := sync.peerSelector.setStrategy(sync.conf.peerSelectStrategy); err != nil {
+			return err
+		}
+	}
+	scheduler.selector = sync.peerSelector
+	scheduler.quarantine = sync.quarantine
+	processor.quarantine = sync.quarantine
+	scheduler.maxBatchBytes = sync.conf.maxBatchBytes
+	scheduler.windowsPerPeer = sync.conf.pipelineWindowsPerPeer
 	sync.scheduler = NewRoutine("scheduler", scheduler.handler, scheduler.getServiceState, sync.log)
 	sync.processor = NewRoutine("processor", processor.handler, processor.getServiceState, sync.log)
 	sync.getStateFn = func() state {
@@ -163,44 +188,57 @@ func (sync *BlockChainSyncServer) initSyncConfIfRequire() {
 	if sync.conf != nil {
 		return
 	}
-	sync.conf = NewBlockSyncServerConf()
-	if localconf.ChainMakerConfig.SyncConfig.BlockPoolSize > 0 {
-		sync.conf.SetBlockPoolSize(uint64(localconf.ChainMakerConfig.SyncConfig.BlockPoolSize))
+	sync.conf = newSyncConfFromLocal()
+	ext := sync.conf.extensionConfig()
+	if err := confreload.UnmarshalExtension(ExtensionConfigKey, ext); err != nil {
+		sync.log.Warnf("load the sync extension config failed, use the defaults, %s", err)
+		return
+	}
+	sync.conf.SetExtensionConfig(ext)
+}
+
+// newSyncConfFromLocal create the conf of the sync service from the local config published by confreload
+func newSyncConfFromLocal() *BlockSyncServerConf {
+	conf := NewBlockSyncServerConf()
+	local := confreload.Config().SyncConfig
+	if local.BlockPoolSize > 0 {
+		conf.SetBlockPoolSize(uint64(local.BlockPoolSize))
 	}
-	if localconf.ChainMakerConfig.SyncConfig.WaitTimeOfBlockRequestMsg > 0 {
-		sync.conf.SetWaitTimeOfBlockRequestMsg(int64(localconf.ChainMakerConfig.SyncConfig.WaitTimeOfBlockRequestMsg))
+	if local.WaitTimeOfBlockRequestMsg > 0 {
+		conf.SetWaitTimeOfBlockRequestMsg(int64(local.WaitTimeOfBlockRequestMsg))
 	}
-	if localconf.ChainMakerConfig.SyncConfig.BatchSizeFromOneNode > 0 {
-		sync.conf.SetBatchSizeFromOneNode(uint64(localconf.ChainMakerConfig.SyncConfig.BatchSizeFromOneNode))
+	if local.BatchSizeFromOneNode > 0 {
+		conf.SetBatchSizeFromOneNode(uint64(local.BatchSizeFromOneNode))
 	}
-	if localconf.ChainMakerConfig.SyncConfig.LivenessTick > 0 {
-		sync.conf.SetLivenessTicker(localconf.ChainMakerConfig.SyncConfig.LivenessTick)
+	if local.LivenessTick > 0 {
+		conf.SetLivenessTicker(local.LivenessTick)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.NodeStatusTick > 0 {
-		sync.conf.SetNodeStatusTicker(localconf.ChainMakerConfig.SyncConfig.NodeStatusTick)
+	if local.NodeStatusTick > 0 {
+		conf.SetNodeStatusTicker(local.NodeStatusTick)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.DataDetectionTick > 0 {
-		sync.conf.SetDataDetectionTicker(localconf.ChainMakerConfig.SyncConfig.DataDetectionTick)
+	if local.DataDetectionTick > 0 {
+		conf.SetDataDetectionTicker(local.DataDetectionTick)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.ProcessBlockTick > 0 {
-		sync.conf.SetProcessBlockTicker(localconf.ChainMakerConfig.SyncConfig.ProcessBlockTick)
+	if local.ProcessBlockTick > 0 {
+		conf.SetProcessBlockTicker(local.ProcessBlockTick)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.SchedulerTick > 0 {
-		sync.conf.SetSchedulerTicker(localconf.ChainMakerConfig.SyncConfig.SchedulerTick)
+	if local.SchedulerTick > 0 {
+		conf.SetSchedulerTicker(local.SchedulerTick)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.ReqTimeThreshold > 0 {
-		sync.conf.SetReqTimeThreshold(localconf.ChainMakerConfig.SyncConfig.ReqTimeThreshold)
+	if local.ReqTimeThreshold > 0 {
+		conf.SetReqTimeThreshold(local.ReqTimeThreshold)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.BlockRequestTime > 0 {
-		sync.conf.SetBlockRequestTime(localconf.ChainMakerConfig.SyncConfig.BlockRequestTime)
+	if local.BlockRequestTime > 0 {
+		conf.SetBlockRequestTime(local.BlockRequestTime)
 	}
-	if len(localconf.ChainMakerConfig.SyncConfig.FromNodes) > 0 {
-		sync.conf.SetPreferenceNodesNodes(localconf.ChainMakerConfig.SyncConfig.FromNodes)
+	if len(local.FromNodes) > 0 {
+		conf.SetPreferenceNodesNodes(local.FromNodes)
 	}
-	if localconf.ChainMakerConfig.SyncConfig.BroadcastStatusPerBlocksCommitted != 0 {
-		sync.conf.SetBroadcastStatusPerBlocksCommitted(
-			localconf.ChainMakerConfig.SyncConfig.BroadcastStatusPerBlocksCommitted)
+	if local.BroadcastStatusPerBlocksCommitted != 0 {
+		conf.SetBroadcastStatusPerBlocksCommitted(
+			local.BroadcastStatusPerBlocksCommitted)
 	}
+	return conf
 }
 
 // handle messages received from the network that care about
@@ -279,12 +317,8 @@ func (sync *BlockChainSyncServer) handleNodeStatusResp(syncMsg *syncPb.SyncMsg,
 // firstly check if the request already exists in the cache，if yes, reject
 // otherwise, get the corresponding block data from the local ledger and send it back
 func (sync *BlockChainSyncServer) handleBlockReq(syncMsg *syncPb.SyncMsg, from string) error {
-	var (
-		err error
-		req syncPb.BlockSyncReq
-	)
-
-	if err = proto.Unmarshal(syncMsg.Payload, &req); err != nil {
+	req, maxBytes, err := decodeBlockSyncReq(syncMsg.Payload)
+	if err != nil {
 		sync.log.Errorf("fail to proto.Unmarshal the syncPb.SyncMsg:%s", err.Error())
 		return err
 	}
@@ -297,9 +331,13 @@ func (sync *BlockChainSyncServer) handleBlockReq(syncMsg *syncPb.SyncMsg, from s
 		return nil
 	}
 
-	sync.log.Infof("receive request to get block [height: %d, batch_size: %d] from "+
-		"node [%s] WithRwset [%v]", req.BlockHeight, req.BatchSize, from, req.WithRwset)
-	return sync.sendInfos(&req, from)
+	// the byte budget asked by the requester, no more than the one of the local conf
+	if sync.conf.maxBatchBytes > 0 && maxBytes > sync.conf.maxBatchBytes {
+		maxBytes = sync.conf.maxBatchBytes
+	}
+	sync.log.Infof("receive request to get block [height: %d, batch_size: %d, max_bytes: %d] from "+
+		"node [%s] WithRwset [%v]", req.BlockHeight, req.BatchSize, maxBytes, from, req.WithRwset)
+	return sync.sendInfos(req, maxBytes, from)
 }
 
 // sendInfos send block data to 'from'.
@@ -313,51 +351,77 @@ func (sync *BlockChainSyncServer) handleBlockReq(syncMsg *syncPb.SyncMsg, from s
 // if so, blockChainStore will return a nil block without a error, need to skip it instead of sending it
 // since a block data will be large, we send it one by one instead of all at once
 // to reduce errors during network transmission.
-func (sync *BlockChainSyncServer) sendInfos(req *syncPb.BlockSyncReq, from string) error {
+// if the requester gives a byte budget 'maxBytes', consecutive blocks are packed into a message as long as it
+// stays within the budget, a block larger than the budget is sent alone.
+func (sync *BlockChainSyncServer) sendInfos(req *syncPb.BlockSyncReq, maxBytes uint64, from string) error {
 	var (
-		bz        []byte
-		err       error
-		blk       *commonPb.Block
-		blkRwInfo *storePb.BlockWithRWSet
+		batch      []*commonPb.BlockInfo
+		batchBytes uint64
 	)
 	for i := uint64(0); i < req.BatchSize; i++ {
-		if req.WithRwset {
-			if blkRwInfo, err = sync.blockChainStore.GetBlockWithRWSets(req.BlockHeight + i); err != nil {
-				sync.log.Errorf("[SyncMsg_BLOCK_SYNC_RESP] get block[%d] with reset with err: %s",
-					req.BlockHeight+i, err.Error())
-				return err
-			}
-			if blkRwInfo == nil {
-				sync.log.Warnf("GetBlockWithRWSets get block height: [%d] is nil", req.BlockHeight+i)
-				continue
-			}
-		} else {
-			if blk, err = sync.blockChainStore.GetBlock(req.BlockHeight + i); err != nil {
-				sync.log.Errorf("[SyncMsg_BLOCK_SYNC_RESP] get block[%d] without reset with err: %s",
-					req.BlockHeight+i, err.Error())
+		info, err := sync.getBlockInfo(req.BlockHeight+i, req.WithRwset)
+		if err != nil {
+			return err
+		}
+		if info == nil {
+			continue
+		}
+		size := uint64(info.Size())
+		if len(batch) > 0 && (maxBytes == 0 || batchBytes+size > maxBytes) {
+			if err = sync.sendBlockInfos(batch, req.WithRwset, from); err != nil {
 				return err
 			}
-			if blk == nil {
-				sync.log.Warnf("GetBlock get block height: [%d] is nil", req.BlockHeight+i)
-				continue
-			}
-			blkRwInfo = &storePb.BlockWithRWSet{
-				Block:    blk,
-				TxRWSets: nil,
-			}
+			batch, batchBytes = nil, 0
 		}
-		info := &commonPb.BlockInfo{Block: blkRwInfo.Block, RwsetList: blkRwInfo.TxRWSets}
-		if bz, err = proto.Marshal(&syncPb.SyncBlockBatch{
-			Data: &syncPb.SyncBlockBatch_BlockinfoBatch{BlockinfoBatch: &syncPb.BlockInfoBatch{
-				Batch: []*commonPb.BlockInfo{info}}}, WithRwset: req.WithRwset,
-		}); err != nil {
-			sync.log.Errorf("fail to proto.Marshal the syncPb.SyncBlockBatch:%s", err.Error())
-			return err
+		batch = append(batch, info)
+		batchBytes += size
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	return sync.sendBlockInfos(batch, req.WithRwset, from)
+}
+
+// getBlockInfo get the block of the height, with its read-write sets if required, from the local ledger
+// returns nil if the block does not exist
+func (sync *BlockChainSyncServer) getBlockInfo(height uint64, withRwset bool) (*commonPb.BlockInfo, error) {
+	if withRwset {
+		blkRwInfo, err := sync.blockChainStore.GetBlockWithRWSets(height)
+		if err != nil {
+			sync.log.Errorf("[SyncMsg_BLOCK_SYNC_RESP] get block[%d] with reset with err: %s", height, err.Error())
+			return nil, err
 		}
-		if err := sync.sendMsg(syncPb.SyncMsg_BLOCK_SYNC_RESP, bz, from); err != nil {
-			sync.log.Errorf("fail to send message to [%s] error: %s", from, err.Error())
-			return err
+		if blkRwInfo == nil {
+			sync.log.Warnf("GetBlockWithRWSets get block height: [%d] is nil", height)
+			return nil, nil
 		}
+		return &commonPb.BlockInfo{Block: blkRwInfo.Block, RwsetList: blkRwInfo.TxRWSets}, nil
+	}
+	blk, err := sync.blockChainStore.GetBlock(height)
+	if err != nil {
+		sync.log.Errorf("[SyncMsg_BLOCK_SYNC_RESP] get block[%d] without reset with err: %s", height, err.Error())
+		return nil, err
+	}
+	if blk == nil {
+		sync.log.Warnf("GetBlock get block height: [%d] is nil", height)
+		return nil, nil
+	}
+	return &commonPb.BlockInfo{Block: blk}, nil
+}
+
+// sendBlockInfos send the blocks to 'from' in a message
+func (sync *BlockChainSyncServer) sendBlockInfos(infos []*commonPb.BlockInfo, withRwset bool, from string) error {
+	bz, err := proto.Marshal(&syncPb.SyncBlockBatch{
+		Data: &syncPb.SyncBlockBatch_BlockinfoBatch{BlockinfoBatch: &syncPb.BlockInfoBatch{
+			Batch: infos}}, WithRwset: withRwset,
+	})
+	if err != nil {
+		sync.log.Errorf("fail to proto.Marshal the syncPb.SyncBlockBatch:%s", err.Error())
+		return err
+	}
+	if err = sync.sendMsg(syncPb.SyncMsg_BLOCK_SYNC_RESP, bz, from); err != nil {
+		sync.log.Errorf("fail to send message to [%s] error: %s", from, err.Error())
+		return err
 	}
 	return nil
 }
@@ -461,6 +525,14 @@ func (sync *BlockChainSyncServer) loop() {
 			if err := sync.scheduler.addTask(&DataDetection{}); err != nil {
 				sync.log.Errorf("add data detection task to scheduler failed, reason: %s", err)
 			}
+			// the tickers are reset when the local config is reloaded
+		case conf := <-sync.reloadTickersC:
+			doProcessBlockTk.Reset(conf.processBlockTick)
+			doScheduleTk.Reset(conf.schedulerTick)
+			doNodeStatusTk.Reset(conf.nodeStatusTick)
+			doLivenessTk.Reset(conf.livenessTick)
+			doDataDetect.Reset(conf.dataDetectionTick)
+			sync.log.Infof("sync tickers reloaded, %s", conf.print())
 
 		// State processing results in state machine
 		//send the result obtained from scheduler to processor for processing
@@ -483,13 +555,14 @@ func (sync *BlockChainSyncServer) loop() {
 // regularly check whether the cached request information has expired
 // if it expires, remove it from the cache
 func (sync *BlockChainSyncServer) blockRequestEntrance() {
-	ticker := time.NewTicker(sync.conf.blockRequestTime)
+	requestTime := sync.conf.blockRequestTime
+	ticker := time.NewTicker(requestTime)
 	dealFunc := func(key, value interface{}) bool {
 		if value == nil {
 			return true
 		}
 		if t, ok := value.(time.Time); ok {
-			if time.Since(t) > sync.conf.blockRequestTime {
+			if time.Since(t) > requestTime {
 				sync.requestCache.Delete(key)
 			}
 			return true
@@ -501,12 +574,68 @@ func (sync *BlockChainSyncServer) blockRequestEntrance() {
 		case <-sync.close:
 			return
 
+		case requestTime = <-sync.reloadRequestTimeC:
+			ticker.Reset(requestTime)
+
 		case <-ticker.C:
 			sync.requestCache.Range(dealFunc)
 		}
 	}
 }
 
+// ReloadTickers reset the tickers of the running service to the sync config of the local config, the other
+// items of the sync config take effect after a restart
+func (sync *BlockChainSyncServer) ReloadTickers() error {
+	if atomic.LoadInt32(&sync.start) != 1 {
+		return commonErrors.ErrSyncServiceHasStoped
+	}
+	conf := newSyncConfFromLocal()
+	select {
+	case sync.reloadTickersC <- conf:
+	case <-sync.close:
+		return commonErrors.ErrSyncServiceHasStoped
+	}
+	select {
+	case sync.reloadRequestTimeC <- conf.blockRequestTime:
+	case <-sync.close:
+		return commonErrors.ErrSyncServiceHasStoped
+	}
+	return nil
+}
+
+// SetPeerSelectStrategy change the strategy to select the peer which the blocks are requested from, the scores of
+// the peers are kept. It takes effect until the service restarts, which uses the strategy of the conf again.
+func (sync *BlockChainSyncServer) SetPeerSelectStrategy(strategy string) error {
+	if atomic.LoadInt32(&sync.start) != 1 {
+		return commonErrors.ErrSyncServiceHasStoped
+	}
+	if err := sync.peerSelector.setStrategy(strategy); err != nil {
+		return err
+	}
+	sync.log.Infof("peer select strategy changed to %s", strategy)
+	return nil
+}
+
+// GetPeerScores returns the peer select strategy in use and the scores of the peers which the blocks are
+// requested from
+func (sync *BlockChainSyncServer) GetPeerScores() *PeerScores {
+	return sync.peerSelector.peerScores()
+}
+
+// GetQuarantine returns the blocks failed to be verified during sync and the peers banned for serving them
+func (sync *BlockChainSyncServer) GetQuarantine() *Quarantine {
+	return sync.quarantine
+}
+
+// InheritState continue with the peer scores and the quarantine of the previous sync service of the chain, which
+// is replaced when the core engine is, it must be called before Start
+func (sync *BlockChainSyncServer) InheritState(previous protocol.SyncService) {
+	if p, ok := previous.(*BlockChainSyncServer); ok && p != nil {
+		sync.peerSelector = p.peerSelector
+		sync.quarantine = p.quarantine
+	}
+}
+
 // ListenSyncToIdealHeight listen local block height has synced to ideal height
 func (sync *BlockChainSyncServer) ListenSyncToIdealHeight() <-chan struct{} {
 	return sync.minLagReachC
@@ -517,55 +646,55 @@ func (sync *BlockChainSyncServer) ListenSyncToIdealHeight() <-chan struct{} {
 // verify failed then return validateFailed status
 // commit failed if block has been committed return hasProcessed, if not return addErr
 // all succeeded return ok
-func (sync *BlockChainSyncServer) validateAndCommitBlock(block *commonPb.Block) processedBlockStatus {
+func (sync *BlockChainSyncServer) validateAndCommitBlock(block *commonPb.Block) (processedBlockStatus, error) {
 	if blk := sync.ledgerCache.GetLastCommittedBlock(); blk != nil && blk.Header.BlockHeight >= block.Header.BlockHeight {
 		sync.log.Infof("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-		return hasProcessed
+		return hasProcessed, nil
 	}
 	startTick := utils.CurrentTimeMillisSeconds()
 	sync.log.Debugf("VerifyBlock start, height is: %d ....", block.Header.BlockHeight)
 	if err := sync.blockVerifier.VerifyBlock(block, protocol.SYNC_VERIFY); err != nil {
 		if err == commonErrors.ErrBlockHadBeenCommited {
 			sync.log.Warnf("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-			return hasProcessed
+			return hasProcessed, nil
 		}
 		sync.log.Warnf("fail to verify the block whose height is %d, err: %s", block.Header.BlockHeight, err)
-		return validateFailed
+		return validateFailed, err
 	}
 	lastTime := utils.CurrentTimeMillisSeconds() - startTick
 	sync.log.Infof("block [%d] VerifyBlock spend %d", block.Header.BlockHeight, lastTime)
 	if err := sync.blockCommitter.AddBlock(block); err != nil {
 		if err == commonErrors.ErrBlockHadBeenCommited {
 			sync.log.Warnf("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-			return hasProcessed
+			return hasProcessed, nil
 		}
 		sync.log.Warnf("fail to commit the block whose height is %d, err: %s", block.Header.BlockHeight, err)
-		return addErr
+		return addErr, err
 	}
-	return ok
+	return ok, nil
 }
 
 // verify and submit the block data carries the read-write set
 // different types of status are returned depending on the stage in which the error occurred
 // verify failed then return validateFailed status
-// commit failed if block has been committed return hasProcessed, if not return addErr
-// all succeeded return ok
+// commit failed if block has been committed return hasProcessed, if not return addErr, err
+// all succeeded return ok, nil
 func (sync *BlockChainSyncServer) validateAndCommitBlockWithRwSets(block *commonPb.Block,
-	rwsets []*commonPb.TxRWSet) processedBlockStatus {
+	rwsets []*commonPb.TxRWSet) (processedBlockStatus, error) {
 	//if the height of the local ledger is not lower than this block height
 	//indicates that the block has been processed
 	if blk := sync.ledgerCache.GetLastCommittedBlock(); blk != nil && blk.Header.BlockHeight >= block.Header.BlockHeight {
 		sync.log.Infof("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-		return hasProcessed
+		return hasProcessed, nil
 	}
 	startTick := utils.CurrentTimeMillisSeconds()
 	if err := sync.blockVerifier.VerifyBlockWithRwSets(block, rwsets, protocol.SYNC_VERIFY); err != nil {
 		if err == commonErrors.ErrBlockHadBeenCommited {
 			sync.log.Warnf("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-			return hasProcessed
+			return hasProcessed, nil
 		}
 		sync.log.Warnf("fail to verify the block with Rwset whose height is %d, err: %s", block.Header.BlockHeight, err)
-		return validateFailed
+		return validateFailed, err
 	}
 	lastTime := utils.CurrentTimeMillisSeconds() - startTick
 	sync.log.Infof("block [%d] VerifyBlockWithRwSets spend %d", block.Header.BlockHeight, lastTime)
@@ -575,13 +704,13 @@ func (sync *BlockChainSyncServer) validateAndCommitBlockWithRwSets(block *common
 	if err := sync.blockCommitter.AddBlock(block); err != nil {
 		if err == commonErrors.ErrBlockHadBeenCommited {
 			sync.log.Warnf("the block: %d has been committed in the blockChainStore ", block.Header.BlockHeight)
-			return hasProcessed
+			return hasProcessed, nil
 		}
 		sync.log.Warnf("fail to commit the block whose height is %d, err: %s", block.Header.BlockHeight, err)
-		return addErr
+		return addErr, err
 	}
 	sync.log.Debugf("AddBlock end, height is: %d ....", block.Header.BlockHeight)
-	return ok
+	return ok, nil
 }
 
 // StopBlockSync make sync service stop sending sync block requests to other peer nodes
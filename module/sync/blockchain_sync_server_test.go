@@ -364,3 +364,32 @@ func TestBlockChainSyncServer_OnMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestReloadTickers(t *testing.T) {
+	service, fn := initTestSync(t)
+	implSync := service.(*BlockChainSyncServer)
+
+	tick := localconf.ChainMakerConfig.SyncConfig.ProcessBlockTick
+	defer func() {
+		localconf.ChainMakerConfig.SyncConfig.ProcessBlockTick = tick
+	}()
+	localconf.ChainMakerConfig.SyncConfig.ProcessBlockTick = 50
+	require.Equal(t, 50*time.Millisecond, newSyncConfFromLocal().processBlockTick)
+	require.NoError(t, implSync.ReloadTickers())
+
+	fn()
+	require.Error(t, implSync.ReloadTickers())
+}
+
+func TestInheritState(t *testing.T) {
+	previous := NewBlockChainSyncServer("chain1", nil, nil, nil, nil, nil, nil, nil).(*BlockChainSyncServer)
+	previous.peerSelector.observeTimeout("node1")
+	_, banned := previous.quarantine.ban("node2", 3)
+	require.True(t, banned)
+
+	service := NewBlockChainSyncServer("chain1", nil, nil, nil, nil, nil, nil, nil).(*BlockChainSyncServer)
+	service.InheritState(previous)
+	require.Same(t, previous.peerSelector, service.peerSelector)
+	require.True(t, service.quarantine.isBanned("node2"))
+	require.Len(t, service.GetPeerScores().Peers, 1)
+}
@@ -12,6 +12,25 @@ import (
 	"time"
 )
 
+const (
+	// ExtensionConfigKey the section of chainmaker.yml holding the sync options besides localconf.SyncConfig
+	ExtensionConfigKey = "sync"
+
+	// defaultPipelineWindowsPerPeer the default number of the outstanding requests to each peer
+	defaultPipelineWindowsPerPeer = 2
+)
+
+// ExtensionConfig the sync options of chainmaker.yml which localconf.SyncConfig does not know
+type ExtensionConfig struct {
+	// MaxBatchBytes the byte budget of a block sync response, see SetMaxBatchBytes
+	MaxBatchBytes uint64 `mapstructure:"max_batch_bytes"`
+	// PipelineWindowsPerPeer the number of the outstanding requests to each peer, see SetPipelineWindowsPerPeer
+	PipelineWindowsPerPeer int `mapstructure:"pipeline_windows_per_peer"`
+	// PeerSelectStrategy the strategy to select the peer which the blocks are requested from, see
+	// SetPeerSelectStrategy
+	PeerSelectStrategy string `mapstructure:"peer_select_strategy"`
+}
+
 // BlockSyncServerConf sync service configurable options
 type BlockSyncServerConf struct {
 	timeOut time.Duration // Timeout of request, unit nanosecond
@@ -43,6 +62,14 @@ type BlockSyncServerConf struct {
 	preferenceNodes []string
 	// a node status broadcast is triggered every 'broadcastStatusPerBlocksCommitted' blocks committed
 	broadcastStatusPerBlocksCommitted int
+	// The strategy to select the peer which the blocks are requested from
+	peerSelectStrategy string
+	// The byte budget of a block sync response, the responder packs consecutive blocks into a message within it,
+	// 0 means a block per message
+	maxBatchBytes uint64
+	// The number of outstanding requests to each peer, the scheduler sends requests until every peer has that many,
+	// 0 means a request per scheduling
+	pipelineWindowsPerPeer int
 }
 
 // NewBlockSyncServerConf create a new BlockSyncServerConf instance with default values
@@ -61,6 +88,9 @@ func NewBlockSyncServerConf() *BlockSyncServerConf {
 		minLagThreshold:                   5,
 		minLagThresholdTime:               3 * time.Second,
 		broadcastStatusPerBlocksCommitted: 3,
+		peerSelectStrategy:                PeerSelectLeastLoaded,
+		maxBatchBytes:                     defaultMaxBatchBytes,
+		pipelineWindowsPerPeer:            defaultPipelineWindowsPerPeer,
 	}
 }
 
@@ -153,11 +183,47 @@ func (c *BlockSyncServerConf) SetBroadcastStatusPerBlocksCommitted(n int) *Block
 	return c
 }
 
+// SetPeerSelectStrategy set the strategy to select the peer which the blocks are requested from, one of
+// least_loaded, latency_weighted, random and preference_first
+func (c *BlockSyncServerConf) SetPeerSelectStrategy(strategy string) *BlockSyncServerConf {
+	c.peerSelectStrategy = strategy
+	return c
+}
+
+// SetMaxBatchBytes set the byte budget of a block sync response, the requester asks for it and the responder packs
+// as many consecutive blocks into a message as fit, 0 disables the packing.
+// the peers not supporting it respond a block per message.
+func (c *BlockSyncServerConf) SetMaxBatchBytes(n uint64) *BlockSyncServerConf {
+	c.maxBatchBytes = n
+	return c
+}
+
+// SetPipelineWindowsPerPeer set the number of block requests that can be outstanding to each peer at once,
+// each one requests up to batchSizeFromOneNode blocks, 0 sends only one request per scheduling
+func (c *BlockSyncServerConf) SetPipelineWindowsPerPeer(n int) *BlockSyncServerConf {
+	c.pipelineWindowsPerPeer = n
+	return c
+}
+
+// SetExtensionConfig set the options of the extension config
+func (c *BlockSyncServerConf) SetExtensionConfig(ext *ExtensionConfig) *BlockSyncServerConf {
+	return c.SetMaxBatchBytes(ext.MaxBatchBytes).SetPipelineWindowsPerPeer(ext.PipelineWindowsPerPeer).
+		SetPeerSelectStrategy(ext.PeerSelectStrategy)
+}
+
+// extensionConfig the extension config with the current options, so that the absent ones are kept
+func (c *BlockSyncServerConf) extensionConfig() *ExtensionConfig {
+	return &ExtensionConfig{MaxBatchBytes: c.maxBatchBytes, PipelineWindowsPerPeer: c.pipelineWindowsPerPeer,
+		PeerSelectStrategy: c.peerSelectStrategy}
+}
+
 func (c *BlockSyncServerConf) print() string {
 	return fmt.Sprintf("blockPoolSize: %d, request timeout: %dms, batchSizeFromOneNode: %d"+
 		", processBlockTick: %dms, schedulerTick: %dms, livenessTick: %dms, nodeStatusTick: %dms,"+
-		"broadcastStatusPerBlocksCommitted: %d, preferenceNodes %+v\n",
+		"broadcastStatusPerBlocksCommitted: %d, preferenceNodes %+v, peerSelectStrategy: %s, maxBatchBytes: %d, "+
+		"pipelineWindowsPerPeer: %d\n",
 		c.blockPoolSize, c.timeOut.Milliseconds(), c.batchSizeFromOneNode, c.processBlockTick.Milliseconds(),
 		c.schedulerTick.Milliseconds(), c.livenessTick.Milliseconds(),
-		c.nodeStatusTick.Milliseconds(), c.broadcastStatusPerBlocksCommitted, c.preferenceNodes)
+		c.nodeStatusTick.Milliseconds(), c.broadcastStatusPerBlocksCommitted, c.preferenceNodes,
+		c.peerSelectStrategy, c.maxBatchBytes, c.pipelineWindowsPerPeer)
 }
@@ -38,5 +38,28 @@ func TestBlockSyncServerConf(t *testing.T) {
 	require.Equal(t, conf.blockRequestTime, 10*time.Second)
 	conf.SetBroadcastStatusPerBlocksCommitted(10)
 	conf.SetPreferenceNodesNodes([]string{"node1", "node2"})
+	require.Equal(t, conf.peerSelectStrategy, PeerSelectLeastLoaded)
+	conf.SetPeerSelectStrategy(PeerSelectRandom)
+	require.Equal(t, conf.peerSelectStrategy, PeerSelectRandom)
+	require.Equal(t, conf.maxBatchBytes, uint64(defaultMaxBatchBytes))
+	conf.SetMaxBatchBytes(1024)
+	require.Equal(t, conf.maxBatchBytes, uint64(1024))
+	require.Equal(t, conf.pipelineWindowsPerPeer, defaultPipelineWindowsPerPeer)
+	conf.SetPipelineWindowsPerPeer(4)
+	require.Equal(t, conf.pipelineWindowsPerPeer, 4)
 	t.Log(conf.print())
 }
+
+func TestBlockSyncServerConf_ExtensionConfig(t *testing.T) {
+	conf := NewBlockSyncServerConf()
+	ext := conf.extensionConfig()
+	require.Equal(t, &ExtensionConfig{MaxBatchBytes: defaultMaxBatchBytes,
+		PipelineWindowsPerPeer: defaultPipelineWindowsPerPeer, PeerSelectStrategy: PeerSelectLeastLoaded}, ext)
+
+	ext.PipelineWindowsPerPeer = 0
+	ext.PeerSelectStrategy = PeerSelectRandom
+	conf.SetExtensionConfig(ext)
+	require.Equal(t, conf.maxBatchBytes, uint64(defaultMaxBatchBytes))
+	require.Zero(t, conf.pipelineWindowsPerPeer)
+	require.Equal(t, PeerSelectRandom, conf.peerSelectStrategy)
+}
@@ -51,6 +51,8 @@ func (m *MockSender) sendMsg(msgType syncPb.SyncMsg_MsgType, msg []byte, to stri
 type MockVerifyAndCommit struct {
 	cache       protocol.LedgerCache
 	receiveItem []*commonPb.Block
+	// the blocks fail to be verified with the error if it is not nil
+	invalid func(block *commonPb.Block) error
 }
 
 // NewMockVerifyAndCommit create a MockVerifyAndCommit instance
@@ -59,14 +61,19 @@ func NewMockVerifyAndCommit(cache protocol.LedgerCache) *MockVerifyAndCommit {
 }
 
 func (m *MockVerifyAndCommit) validateAndCommitBlockWithRwSets(block *commonPb.Block,
-	rwsets []*commonPb.TxRWSet) processedBlockStatus {
+	rwsets []*commonPb.TxRWSet) (processedBlockStatus, error) {
 	panic("implement me")
 }
 
-func (m *MockVerifyAndCommit) validateAndCommitBlock(block *commonPb.Block) processedBlockStatus {
+func (m *MockVerifyAndCommit) validateAndCommitBlock(block *commonPb.Block) (processedBlockStatus, error) {
+	if m.invalid != nil {
+		if err := m.invalid(block); err != nil {
+			return validateFailed, err
+		}
+	}
 	m.receiveItem = append(m.receiveItem, block)
 	m.cache.SetLastCommittedBlock(block)
-	return ok
+	return ok, nil
 }
 
 func newMockLedgerCache(ctrl *gomock.Controller, blk *commonPb.Block) protocol.LedgerCache {
@@ -142,5 +149,7 @@ func newMockBlockChainStore(ctrl *gomock.Controller) protocol.BlockchainStore {
 		return nil, fmt.Errorf("block not find")
 	}).AnyTimes()
 	mockStore.EXPECT().GetArchivedPivot().AnyTimes()
+	// the quarantine is kept in memory only
+	mockStore.EXPECT().GetDBHandle(gomock.Any()).Return(nil).AnyTimes()
 	return mockStore
 }
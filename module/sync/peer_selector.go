@@ -0,0 +1,295 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sync
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// the strategies to select the peer which the blocks are requested from
+const (
+	// PeerSelectLeastLoaded select the peer with the fewest pending requests, ties are broken by the score
+	PeerSelectLeastLoaded = "least_loaded"
+	// PeerSelectLatencyWeighted select a peer at random, weighted by its score and its pending requests
+	PeerSelectLatencyWeighted = "latency_weighted"
+	// PeerSelectRandom select a peer at random
+	PeerSelectRandom = "random"
+	// PeerSelectPreferenceFirst select the least loaded of the preference nodes, the other peers are only selected
+	// when none of the preference nodes has the block
+	PeerSelectPreferenceFirst = "preference_first"
+)
+
+const (
+	// the weight of the latest response in the average latency
+	latencyEWMAWeight = 0.3
+	// the latency in which the score of a peer is halved
+	scoreLatencyUnit = 100 * time.Millisecond
+	// the time in which the penalty of a peer is halved
+	penaltyHalfLife = time.Minute
+	timeoutPenalty  = 1.0
+	invalidPenalty  = 5.0
+	// the score of a peer not observed for this long is dropped, its penalty has decayed to nothing by then
+	scoreIdleTimeout = 10 * penaltyHalfLife
+	// how often the idle scores are looked for
+	scorePruneInterval = time.Minute
+)
+
+// IsValidPeerSelectStrategy check whether the strategy is supported
+func IsValidPeerSelectStrategy(strategy string) bool {
+	switch strategy {
+	case PeerSelectLeastLoaded, PeerSelectLatencyWeighted, PeerSelectRandom, PeerSelectPreferenceFirst:
+		return true
+	}
+	return false
+}
+
+// peerScore the observations of the responses of a peer
+type peerScore struct {
+	latency       time.Duration // the moving average of the response latency
+	responses     uint64
+	timeouts      uint64
+	invalidBlocks uint64
+	// the penalty of the timeouts and the invalid blocks, decays over time
+	penalty       float64
+	penaltyUpdate time.Time
+	lastObserved  time.Time
+}
+
+// decayedPenalty the penalty at the time
+func (ps *peerScore) decayedPenalty(now time.Time) float64 {
+	if ps.penalty == 0 {
+		return 0
+	}
+	elapsed := now.Sub(ps.penaltyUpdate)
+	return ps.penalty * math.Pow(0.5, float64(elapsed)/float64(penaltyHalfLife))
+}
+
+func (ps *peerScore) addPenalty(penalty float64, now time.Time) {
+	ps.penalty = ps.decayedPenalty(now) + penalty
+	ps.penaltyUpdate = now
+}
+
+// score in (0, 1], the peer without any observations scores 1
+func (ps *peerScore) score(now time.Time) float64 {
+	return 1 / ((1 + float64(ps.latency)/float64(scoreLatencyUnit)) * (1 + ps.decayedPenalty(now)))
+}
+
+// PeerScore the score of a peer which the blocks are requested from, and the observations of its responses
+type PeerScore struct {
+	NodeId        string  `json:"node_id"`
+	Score         float64 `json:"score"`
+	LatencyMs     int64   `json:"latency_ms"`
+	Responses     uint64  `json:"responses"`
+	Timeouts      uint64  `json:"timeouts"`
+	InvalidBlocks uint64  `json:"invalid_blocks"`
+	Penalty       float64 `json:"penalty"`
+}
+
+// PeerScores the peer select strategy in use and the scores of the peers, sorted by node id
+type PeerScores struct {
+	Strategy string       `json:"strategy"`
+	Peers    []*PeerScore `json:"peers"`
+}
+
+// peerSelector score the peers by the observations of their responses, and select the peer which the blocks are
+// requested from by the strategy. The strategy can be changed while the scheduler is running. The scores of the
+// peers not observed for scoreIdleTimeout are dropped, so that those of the peers which are gone do not pile up.
+type peerSelector struct {
+	mutex     sync.Mutex
+	strategy  string
+	scores    map[string]*peerScore
+	lastPrune time.Time
+	rand      *rand.Rand
+}
+
+func newPeerSelector() *peerSelector {
+	return &peerSelector{
+		strategy: PeerSelectLeastLoaded,
+		scores:   make(map[string]*peerScore),
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (s *peerSelector) setStrategy(strategy string) error {
+	if !IsValidPeerSelectStrategy(strategy) {
+		return fmt.Errorf("unknown peer select strategy: %s", strategy)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.strategy = strategy
+	return nil
+}
+
+func (s *peerSelector) getStrategy() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.strategy
+}
+
+// getScore the score of the peer to record an observation at the time
+func (s *peerSelector) getScore(id string, now time.Time) *peerScore {
+	s.prune(now)
+	ps, exist := s.scores[id]
+	if !exist {
+		ps = &peerScore{}
+		s.scores[id] = ps
+	}
+	ps.lastObserved = now
+	return ps
+}
+
+// prune drop the scores of the peers not observed for scoreIdleTimeout, at most once per scorePruneInterval
+func (s *peerSelector) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < scorePruneInterval {
+		return
+	}
+	s.lastPrune = now
+	for id, ps := range s.scores {
+		if now.Sub(ps.lastObserved) > scoreIdleTimeout {
+			delete(s.scores, id)
+		}
+	}
+}
+
+// observeResponse record the latency of a response of the peer
+func (s *peerSelector) observeResponse(id string, latency time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ps := s.getScore(id, time.Now())
+	if ps.responses == 0 {
+		ps.latency = latency
+	} else {
+		ps.latency = time.Duration(latencyEWMAWeight*float64(latency) + (1-latencyEWMAWeight)*float64(ps.latency))
+	}
+	ps.responses++
+}
+
+// observeTimeout record a request to the peer timed out
+func (s *peerSelector) observeTimeout(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := time.Now()
+	ps := s.getScore(id, now)
+	ps.timeouts++
+	ps.addPenalty(timeoutPenalty, now)
+}
+
+// observeInvalidBlock record the peer sent a block failed to be verified
+func (s *peerSelector) observeInvalidBlock(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := time.Now()
+	ps := s.getScore(id, now)
+	ps.invalidBlocks++
+	ps.addPenalty(invalidPenalty, now)
+}
+
+// selectPeer select one of the candidates by the strategy, pendingReqs is the number of the blocks being requested
+// from each candidate
+func (s *peerSelector) selectPeer(candidates []string, pendingReqs map[string]int,
+	preferenceNodes map[string]struct{}) string {
+	if len(candidates) == 0 {
+		return ""
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// sort the candidates, so that the selection only depends on the random source
+	sort.Strings(candidates)
+	now := time.Now()
+	switch s.strategy {
+	case PeerSelectRandom:
+		return candidates[s.rand.Intn(len(candidates))]
+	case PeerSelectLatencyWeighted:
+		return s.selectWeighted(candidates, pendingReqs, now)
+	case PeerSelectPreferenceFirst:
+		var preferred []string
+		for _, id := range candidates {
+			if _, exist := preferenceNodes[id]; exist {
+				preferred = append(preferred, id)
+			}
+		}
+		if len(preferred) > 0 {
+			candidates = preferred
+		}
+	}
+	return s.selectLeastLoaded(candidates, pendingReqs, now)
+}
+
+// selectLeastLoaded select the candidate with the fewest pending requests, then the highest score, the remaining
+// ties are broken at random so that the requests are not always sent to the same peer
+func (s *peerSelector) selectLeastLoaded(candidates []string, pendingReqs map[string]int, now time.Time) string {
+	var (
+		selected []string
+		minReqs  = math.MaxInt64
+		maxScore float64
+	)
+	for _, id := range candidates {
+		score := 1.0
+		if ps, exist := s.scores[id]; exist {
+			score = ps.score(now)
+		}
+		reqs := pendingReqs[id]
+		switch {
+		case reqs < minReqs || (reqs == minReqs && score > maxScore):
+			selected = append(selected[:0], id)
+			minReqs, maxScore = reqs, score
+		case reqs == minReqs && score == maxScore:
+			selected = append(selected, id)
+		}
+	}
+	return selected[s.rand.Intn(len(selected))]
+}
+
+// selectWeighted select a candidate at random, the probability is proportional to score/(1+pending requests)
+func (s *peerSelector) selectWeighted(candidates []string, pendingReqs map[string]int, now time.Time) string {
+	weights := make([]float64, len(candidates))
+	var total float64
+	for i, id := range candidates {
+		weight := 1.0
+		if ps, exist := s.scores[id]; exist {
+			weight = ps.score(now)
+		}
+		weights[i] = weight / float64(1+pendingReqs[id])
+		total += weights[i]
+	}
+	r := s.rand.Float64() * total
+	for i, weight := range weights {
+		if r < weight {
+			return candidates[i]
+		}
+		r -= weight
+	}
+	return candidates[len(candidates)-1]
+}
+
+// peerScores the strategy in use and the scores of the peers
+func (s *peerSelector) peerScores() *PeerScores {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	now := time.Now()
+	s.prune(now)
+	scores := &PeerScores{Strategy: s.strategy, Peers: make([]*PeerScore, 0, len(s.scores))}
+	for id, ps := range s.scores {
+		scores.Peers = append(scores.Peers, &PeerScore{
+			NodeId:        id,
+			Score:         ps.score(now),
+			LatencyMs:     ps.latency.Milliseconds(),
+			Responses:     ps.responses,
+			Timeouts:      ps.timeouts,
+			InvalidBlocks: ps.invalidBlocks,
+			Penalty:       ps.decayedPenalty(now),
+		})
+	}
+	sort.Slice(scores.Peers, func(i, j int) bool { return scores.Peers[i].NodeId < scores.Peers[j].NodeId })
+	return scores
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sync
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerScore(t *testing.T) {
+	now := time.Now()
+	ps := &peerScore{}
+	require.EqualValues(t, 1, ps.score(now))
+
+	ps.latency = scoreLatencyUnit
+	require.InDelta(t, 0.5, ps.score(now), 1e-9)
+
+	ps.addPenalty(timeoutPenalty, now)
+	require.InDelta(t, 0.25, ps.score(now), 1e-9)
+	// the penalty is halved after a half life
+	require.InDelta(t, 0.5, ps.decayedPenalty(now.Add(penaltyHalfLife)), 1e-9)
+}
+
+func TestPeerSelectorObserve(t *testing.T) {
+	selector := newPeerSelector()
+	selector.observeResponse("node1", 100*time.Millisecond)
+	require.EqualValues(t, 100*time.Millisecond, selector.scores["node1"].latency)
+	selector.observeResponse("node1", 200*time.Millisecond)
+	require.InDelta(t, float64(130*time.Millisecond), float64(selector.scores["node1"].latency), 1e3)
+	require.EqualValues(t, 2, selector.scores["node1"].responses)
+
+	selector.observeTimeout("node2")
+	selector.observeInvalidBlock("node3")
+	require.EqualValues(t, 1, selector.scores["node2"].timeouts)
+	require.EqualValues(t, 1, selector.scores["node3"].invalidBlocks)
+	now := time.Now()
+	require.Greater(t, selector.scores["node2"].score(now), selector.scores["node3"].score(now))
+
+	scores := selector.peerScores()
+	require.Equal(t, PeerSelectLeastLoaded, scores.Strategy)
+	require.Len(t, scores.Peers, 3)
+	require.Equal(t, "node3", scores.Peers[2].NodeId)
+	require.EqualValues(t, 1, scores.Peers[2].InvalidBlocks)
+	require.InDelta(t, invalidPenalty, scores.Peers[2].Penalty, 1e-3)
+}
+
+func TestPeerSelectorPrune(t *testing.T) {
+	selector := newPeerSelector()
+	now := time.Now()
+	selector.getScore("node1", now.Add(-scoreIdleTimeout-time.Second))
+	selector.getScore("node2", now.Add(-scoreIdleTimeout+time.Minute))
+	require.Len(t, selector.scores, 2)
+
+	// the idle score is dropped at the next observation after the prune interval
+	selector.lastPrune = now.Add(-scorePruneInterval)
+	selector.getScore("node3", now)
+	require.Len(t, selector.scores, 2)
+	require.NotContains(t, selector.scores, "node1")
+
+	// not again until the interval passes
+	selector.getScore("node1", now.Add(-scoreIdleTimeout-time.Second))
+	selector.getScore("node4", now)
+	require.Contains(t, selector.scores, "node1")
+}
+
+func TestPeerSelectorStrategies(t *testing.T) {
+	selector := newPeerSelector()
+	selector.rand = rand.New(rand.NewSource(1))
+	require.Error(t, selector.setStrategy("unknown"))
+	require.Empty(t, selector.selectPeer(nil, nil, nil))
+
+	// 1. least loaded, the peer with the fewest pending requests, then the highest score
+	pendingReqs := map[string]int{"node1": 2, "node2": 0, "node3": 0}
+	selector.observeTimeout("node2")
+	for i := 0; i < 10; i++ {
+		require.Equal(t, "node3", selector.selectPeer([]string{"node1", "node2", "node3"}, pendingReqs, nil))
+	}
+
+	// 2. the ties of least loaded are broken at random
+	selected := make(map[string]int)
+	for i := 0; i < 100; i++ {
+		selected[selector.selectPeer([]string{"node4", "node5"}, nil, nil)]++
+	}
+	require.Len(t, selected, 2)
+
+	// 3. latency weighted, the peer with the higher score is selected more often
+	require.NoError(t, selector.setStrategy(PeerSelectLatencyWeighted))
+	selector.observeResponse("node4", 900*time.Millisecond)
+	selected = make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		selected[selector.selectPeer([]string{"node4", "node5"}, nil, nil)]++
+	}
+	require.Greater(t, selected["node5"], selected["node4"])
+	require.Greater(t, selected["node4"], 0)
+
+	// 4. random
+	require.NoError(t, selector.setStrategy(PeerSelectRandom))
+	selected = make(map[string]int)
+	for i := 0; i < 100; i++ {
+		selected[selector.selectPeer([]string{"node1", "node2", "node3"}, pendingReqs, nil)]++
+	}
+	require.Len(t, selected, 3)
+
+	// 5. preference first, falls back to the other peers if no preference node has the block
+	require.NoError(t, selector.setStrategy(PeerSelectPreferenceFirst))
+	preferenceNodes := map[string]struct{}{"node1": {}}
+	require.Equal(t, "node1", selector.selectPeer([]string{"node1", "node2", "node3"}, pendingReqs, preferenceNodes))
+	require.Equal(t, "node3", selector.selectPeer([]string{"node2", "node3"}, pendingReqs, preferenceNodes))
+}
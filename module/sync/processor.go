@@ -19,9 +19,10 @@ import (
 	"github.com/Workiva/go-datastructures/queue"
 )
 
+// verifyAndAddBlock verify and commit a block, the error tells why the block is not committed
 type verifyAndAddBlock interface {
-	validateAndCommitBlock(block *commonPb.Block) processedBlockStatus
-	validateAndCommitBlockWithRwSets(block *commonPb.Block, rwsets []*commonPb.TxRWSet) processedBlockStatus
+	validateAndCommitBlock(block *commonPb.Block) (processedBlockStatus, error)
+	validateAndCommitBlockWithRwSets(block *commonPb.Block, rwsets []*commonPb.TxRWSet) (processedBlockStatus, error)
 }
 
 type blockWithPeerInfo struct {
@@ -43,6 +44,8 @@ type processor struct {
 	verifyAndAddBlock
 	// The max height of blocks that wait to be processed
 	maxHeightInQueue uint64
+	// The blocks failed to be verified, kept for the forensic analysis
+	quarantine *Quarantine
 }
 
 func newProcessor(verify verifyAndAddBlock, ledgerCache protocol.LedgerCache, log protocol.Logger) *processor {
@@ -51,6 +54,7 @@ func newProcessor(verify verifyAndAddBlock, ledgerCache protocol.LedgerCache, lo
 		verifyAndAddBlock: verify,
 		queue:             make(map[uint64]blockWithPeerInfo),
 		log:               log,
+		quarantine:        NewQuarantine(),
 	}
 }
 
@@ -124,11 +128,13 @@ func (pro *processor) handleStopSyncMsg() {
 
 // handleProcessBlockMsg validate and commit block data
 // spit out the result of the verification for the next step
+// the block failed to be verified is put into the quarantine
 func (pro *processor) handleProcessBlockMsg() (queue.Item, error) {
 	var (
 		exist  bool
 		info   blockWithPeerInfo
 		status processedBlockStatus
+		err    error
 	)
 	pendingBlockHeight := pro.lastCommitBlockHeight() + 1
 	if info, exist = pro.queue[pendingBlockHeight]; !exist {
@@ -137,13 +143,15 @@ func (pro *processor) handleProcessBlockMsg() (queue.Item, error) {
 	}
 	pro.log.Debugf("process block [height: %d] start, status [%d]", info.blk.Header.BlockHeight, status)
 	if info.withRWSets && localconf.ChainMakerConfig.NodeConfig.FastSyncConfig.Enable {
-		if status = pro.validateAndCommitBlockWithRwSets(info.blk, info.rwsets); status == ok || status == hasProcessed {
-			pro.hasCommitBlock++
-		}
+		status, err = pro.validateAndCommitBlockWithRwSets(info.blk, info.rwsets)
 	} else {
-		if status = pro.validateAndCommitBlock(info.blk); status == ok || status == hasProcessed {
-			pro.hasCommitBlock++
-		}
+		status, err = pro.validateAndCommitBlock(info.blk)
+	}
+	if status == ok || status == hasProcessed {
+		pro.hasCommitBlock++
+	}
+	if status == validateFailed && err != nil {
+		pro.quarantineBlock(info, err)
 	}
 	//Clear processed data
 	delete(pro.queue, pendingBlockHeight)
@@ -156,6 +164,16 @@ func (pro *processor) handleProcessBlockMsg() (queue.Item, error) {
 	}, nil
 }
 
+// quarantineBlock keep the block failed to be verified in the quarantine
+func (pro *processor) quarantineBlock(info blockWithPeerInfo, reason error) {
+	raw, err := (&commonPb.BlockInfo{Block: info.blk, RwsetList: info.rwsets}).Marshal()
+	if err != nil {
+		pro.log.Warnf("marshal the quarantined block [height: %d] failed, %s", info.blk.Header.BlockHeight, err)
+		return
+	}
+	pro.quarantine.add(info.blk.Header.BlockHeight, info.blk.Header.BlockHash, info.id, reason.Error(), raw)
+}
+
 // handleDataDetection eliminate invalid data from the maintained data list
 func (pro *processor) handleDataDetection() {
 	pendingBlockHeight := pro.lastCommitBlockHeight() + 1
@@ -8,10 +8,12 @@ SPDX-License-Identifier: Apache-2.0
 package sync
 
 import (
+	"errors"
 	"testing"
 
 	syncPb "chainmaker.org/chainmaker/pb-go/v2/sync"
 
+	acPb "chainmaker.org/chainmaker/pb-go/v2/accesscontrol"
 	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
 	"chainmaker.org/chainmaker/protocol/v2/test"
 
@@ -213,3 +215,65 @@ func TestDataDetection(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, 0, len(processor.queue))
 }
+
+func TestProcessorQuarantine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ledger := newMockLedgerCache(ctrl, &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 100}})
+	mockVerifier := NewMockVerifyAndCommit(ledger)
+	// the tampered block carries the hash of the genuine one, but is proposed by another node
+	mockVerifier.invalid = func(block *commonPb.Block) error {
+		if string(block.Header.Proposer.GetMemberInfo()) == "tampered" {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	processor := newProcessor(mockVerifier, ledger, &test.GoLogger{})
+	receive := func(from, proposer string) {
+		_, _ = processor.handler(&ReceivedBlockInfos{
+			SyncBlockBatch: &syncPb.SyncBlockBatch{
+				Data: &syncPb.SyncBlockBatch_BlockinfoBatch{
+					BlockinfoBatch: &syncPb.BlockInfoBatch{
+						Batch: []*commonPb.BlockInfo{
+							{Block: &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 101,
+								BlockHash: []byte{1, 2}, Proposer: &acPb.Member{MemberInfo: []byte(proposer)}}}},
+						},
+					},
+				},
+			},
+			from: from,
+		})
+	}
+
+	// 1. the block failed to be verified is quarantined
+	receive("node1", "tampered")
+	ret, err := processor.handler(&ProcessBlockMsg{})
+	require.NoError(t, err)
+	require.EqualValues(t, validateFailed, ret.(*ProcessedBlockResp).status)
+	blocks := processor.quarantine.Blocks(0, true)
+	require.Len(t, blocks, 1)
+	require.EqualValues(t, 101, blocks[0].Height)
+	require.Equal(t, "0102", blocks[0].Hash)
+	require.NotEmpty(t, blocks[0].Digest)
+	require.Equal(t, "node1", blocks[0].From)
+	require.Equal(t, "invalid signature", blocks[0].Reason)
+	info := &commonPb.BlockInfo{}
+	require.NoError(t, info.Unmarshal(blocks[0].Raw))
+	require.EqualValues(t, 101, info.Block.Header.BlockHeight)
+
+	// 2. the same tampered block is verified again, and counted
+	receive("node1", "tampered")
+	ret, err = processor.handler(&ProcessBlockMsg{})
+	require.NoError(t, err)
+	require.EqualValues(t, validateFailed, ret.(*ProcessedBlockResp).status)
+	require.EqualValues(t, 2, processor.quarantine.Blocks(101, false)[0].Rejected)
+
+	// 3. the genuine block with the same hash is not shadowed by the quarantined one
+	receive("node2", "genuine")
+	ret, err = processor.handler(&ProcessBlockMsg{})
+	require.NoError(t, err)
+	require.EqualValues(t, ok, ret.(*ProcessedBlockResp).status)
+	require.Equal(t, "node2", ret.(*ProcessedBlockResp).from)
+	require.Len(t, mockVerifier.receiveItem, 1)
+	require.Len(t, processor.quarantine.Blocks(101, false), 1)
+}
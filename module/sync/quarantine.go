@@ -0,0 +1,265 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+const (
+	// the most blocks kept in the quarantine, the oldest one is evicted when it is full
+	defaultQuarantineSize = 128
+	// a peer serving an invalid block is banned for this long, doubled for each further offense
+	peerBanBaseDuration = time.Minute
+	peerBanMaxDuration  = time.Hour
+	// at most one in this many peers is banned at the same time, so the last peers are never banned
+	maxBannedPeerRatio = 3
+	// the key of the bans of the peers in the local db, followed by the chain id
+	dbKeyQuarantineBansPrefix = "sync_quarantine_bans_"
+)
+
+// QuarantinedBlock a block rejected during sync, kept for forensic analysis
+type QuarantinedBlock struct {
+	Height uint64 `json:"height"`
+	// the hash claimed by the header, a tampered block may carry the hash of the genuine one
+	Hash string `json:"hash"`
+	// the sha256 of Raw, which tells the quarantined blocks apart
+	Digest string `json:"digest"`
+	// the peer the block is received from
+	From   string `json:"from"`
+	Reason string `json:"reason"`
+	// the unix time of the first rejection, and how many times the block is rejected
+	Time     int64 `json:"time"`
+	Rejected int   `json:"rejected"`
+	// the marshaled commonPb.BlockInfo, with the rw sets if the block is received with them
+	Raw []byte `json:"raw,omitempty"`
+}
+
+// BannedPeer a peer from which no blocks are synced until the ban expires
+type BannedPeer struct {
+	NodeId   string `json:"node_id"`
+	Until    int64  `json:"until"`
+	Offenses int    `json:"offenses"`
+}
+
+type peerBan struct {
+	until    time.Time
+	offenses int
+}
+
+// quarantineDB the local db the bans of the peers are kept in, see protocol.DBHandle
+type quarantineDB interface {
+	Get(key []byte) ([]byte, error)
+	Put(key []byte, value []byte) error
+}
+
+// Quarantine the blocks rejected by the verification during sync and the peers banned for serving them.
+// The blocks are kept for the forensic analysis only, a block is verified every time it is received, so neither a
+// tampered block carrying the hash of the genuine one nor a transient verification error rejects the genuine block.
+// The blocks are kept in memory only, while the bans are kept in the local db if there is one, so a restart does
+// not lift them.
+type Quarantine struct {
+	mutex   sync.Mutex
+	maxSize int
+	blocks  []*QuarantinedBlock
+	bans    map[string]*peerBan
+
+	db    quarantineDB
+	dbKey []byte
+	log   protocol.Logger
+}
+
+// NewQuarantine create an empty Quarantine kept in memory only
+func NewQuarantine() *Quarantine {
+	return &Quarantine{
+		maxSize: defaultQuarantineSize,
+		bans:    make(map[string]*peerBan),
+	}
+}
+
+// newPersistentQuarantine create a Quarantine keeping the bans of the peers of the chain in the local db, and load
+// the bans kept before, it is kept in memory only if db is nil
+func newPersistentQuarantine(db quarantineDB, chainId string, log protocol.Logger) *Quarantine {
+	q := NewQuarantine()
+	if db == nil {
+		return q
+	}
+	q.db = db
+	q.dbKey = []byte(dbKeyQuarantineBansPrefix + chainId)
+	q.log = log
+	bz, err := db.Get(q.dbKey)
+	if err != nil {
+		log.Errorf("load the bans of the peers failed, %s", err)
+		return q
+	}
+	// brand new node
+	if bz == nil {
+		return q
+	}
+	var peers []*BannedPeer
+	if err = json.Unmarshal(bz, &peers); err != nil {
+		log.Errorf("unmarshal the bans of the peers failed, %s", err)
+		return q
+	}
+	for _, peer := range peers {
+		q.bans[peer.NodeId] = &peerBan{until: time.Unix(peer.Until, 0), offenses: peer.Offenses}
+	}
+	return q
+}
+
+// saveBans keep the bans of the peers in the local db, including the expired ones for their offenses, the caller
+// must hold the lock
+func (q *Quarantine) saveBans() {
+	if q.db == nil {
+		return
+	}
+	peers := make([]*BannedPeer, 0, len(q.bans))
+	for id, ban := range q.bans {
+		peers = append(peers, &BannedPeer{NodeId: id, Until: ban.until.Unix(), Offenses: ban.offenses})
+	}
+	bz, err := json.Marshal(peers)
+	if err != nil {
+		q.log.Errorf("marshal the bans of the peers failed, %s", err)
+		return
+	}
+	if err = q.db.Put(q.dbKey, bz); err != nil {
+		q.log.Errorf("save the bans of the peers failed, %s", err)
+	}
+}
+
+// add the rejected block of the marshaled commonPb.BlockInfo raw, or count the rejection if the same content is
+// already in the quarantine
+func (q *Quarantine) add(height uint64, hash []byte, from, reason string, raw []byte) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	sum := sha256.Sum256(raw)
+	digest := hex.EncodeToString(sum[:])
+	for _, blk := range q.blocks {
+		if blk.Height == height && blk.Digest == digest {
+			blk.Rejected++
+			return
+		}
+	}
+	if len(q.blocks) >= q.maxSize {
+		q.blocks = q.blocks[1:]
+	}
+	q.blocks = append(q.blocks, &QuarantinedBlock{
+		Height:   height,
+		Hash:     hex.EncodeToString(hash),
+		Digest:   digest,
+		From:     from,
+		Reason:   reason,
+		Time:     time.Now().Unix(),
+		Rejected: 1,
+		Raw:      raw,
+	})
+}
+
+// ban the peer, the ban lasts twice as long as the previous one, until the max duration. peers is the number of
+// the peers not banned, the peer included, the ban is refused if more than one in maxBannedPeerRatio peers would be
+// banned, and false is returned
+func (q *Quarantine) ban(id string, peers int) (time.Duration, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	now := time.Now()
+	banned := 0
+	for other, ban := range q.bans {
+		if other != id && now.Before(ban.until) {
+			banned++
+		}
+	}
+	if (banned+1)*maxBannedPeerRatio > banned+peers {
+		return 0, false
+	}
+	ban, exist := q.bans[id]
+	if !exist {
+		ban = &peerBan{}
+		q.bans[id] = ban
+	}
+	duration := peerBanBaseDuration << ban.offenses
+	if duration > peerBanMaxDuration || duration <= 0 {
+		duration = peerBanMaxDuration
+	}
+	ban.offenses++
+	ban.until = now.Add(duration)
+	q.saveBans()
+	return duration, true
+}
+
+// isBanned check whether the peer is banned now, the offenses of an expired ban are kept
+func (q *Quarantine) isBanned(id string) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	ban, exist := q.bans[id]
+	return exist && time.Now().Before(ban.until)
+}
+
+// Blocks list the quarantined blocks at the height, or all of them if height is 0, without the raw bytes unless
+// withRaw
+func (q *Quarantine) Blocks(height uint64, withRaw bool) []*QuarantinedBlock {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	blocks := make([]*QuarantinedBlock, 0, len(q.blocks))
+	for _, blk := range q.blocks {
+		if height != 0 && blk.Height != height {
+			continue
+		}
+		copied := *blk
+		if !withRaw {
+			copied.Raw = nil
+		}
+		blocks = append(blocks, &copied)
+	}
+	return blocks
+}
+
+// BannedPeers list the peers banned now, sorted by node id
+func (q *Quarantine) BannedPeers() []*BannedPeer {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	now := time.Now()
+	peers := make([]*BannedPeer, 0, len(q.bans))
+	for id, ban := range q.bans {
+		if now.Before(ban.until) {
+			peers = append(peers, &BannedPeer{NodeId: id, Until: ban.until.Unix(), Offenses: ban.offenses})
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].NodeId < peers[j].NodeId
+	})
+	return peers
+}
+
+// Clear remove the quarantined blocks at the height, or all of them and the bans of the peers if height is 0,
+// and returns the number of the blocks removed
+func (q *Quarantine) Clear(height uint64) int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if height == 0 {
+		removed := len(q.blocks)
+		q.blocks = nil
+		q.bans = make(map[string]*peerBan)
+		q.saveBans()
+		return removed
+	}
+	kept := q.blocks[:0]
+	for _, blk := range q.blocks {
+		if blk.Height != height {
+			kept = append(kept, blk)
+		}
+	}
+	removed := len(q.blocks) - len(kept)
+	q.blocks = kept
+	return removed
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"chainmaker.org/chainmaker/protocol/v2/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuarantineBlocks(t *testing.T) {
+	q := NewQuarantine()
+	q.maxSize = 2
+	q.add(10, []byte{1}, "node1", "invalid signature", []byte("raw10"))
+	q.add(10, []byte{1}, "node2", "invalid signature", []byte("raw10"))
+
+	blocks := q.Blocks(0, false)
+	require.Len(t, blocks, 1)
+	require.Equal(t, "node1", blocks[0].From)
+	require.Equal(t, "invalid signature", blocks[0].Reason)
+	require.Equal(t, 2, blocks[0].Rejected)
+	require.Nil(t, blocks[0].Raw)
+
+	// the blocks claiming the same hash are told apart by the content
+	q.add(10, []byte{1}, "node3", "invalid rwset", []byte("tampered10"))
+	blocks = q.Blocks(10, true)
+	require.Len(t, blocks, 2)
+	require.Equal(t, blocks[0].Hash, blocks[1].Hash)
+	require.NotEqual(t, blocks[0].Digest, blocks[1].Digest)
+	require.Equal(t, []byte("tampered10"), blocks[1].Raw)
+
+	// the oldest block is evicted
+	q.add(11, []byte{2}, "node1", "invalid hash", []byte("raw11"))
+	blocks = q.Blocks(0, false)
+	require.Len(t, blocks, 2)
+	require.Equal(t, "node3", blocks[0].From)
+
+	require.Equal(t, 1, q.Clear(11))
+	require.Len(t, q.Blocks(0, false), 1)
+	require.Equal(t, 1, q.Clear(0))
+	require.Empty(t, q.Blocks(0, false))
+}
+
+func TestQuarantineBan(t *testing.T) {
+	q := NewQuarantine()
+	require.False(t, q.isBanned("node1"))
+	requireBanned(t, q, "node1", 10, peerBanBaseDuration)
+	requireBanned(t, q, "node1", 10, 2*peerBanBaseDuration)
+	require.True(t, q.isBanned("node1"))
+	require.Len(t, q.BannedPeers(), 1)
+	require.Equal(t, 2, q.BannedPeers()[0].Offenses)
+
+	// the ban doubles until the max duration
+	for i := 0; i < 100; i++ {
+		duration, banned := q.ban("node2", 10)
+		require.True(t, banned)
+		require.LessOrEqual(t, duration, peerBanMaxDuration)
+	}
+	requireBanned(t, q, "node2", 10, peerBanMaxDuration)
+
+	// the offenses are kept after the ban expires
+	q.bans["node1"].until = time.Now().Add(-time.Second)
+	require.False(t, q.isBanned("node1"))
+	requireBanned(t, q, "node1", 10, 4*peerBanBaseDuration)
+
+	q.Clear(0)
+	require.False(t, q.isBanned("node1"))
+	require.Empty(t, q.BannedPeers())
+}
+
+func TestQuarantineBanLimit(t *testing.T) {
+	q := NewQuarantine()
+	// the last peer is never banned
+	_, banned := q.ban("node1", 1)
+	require.False(t, banned)
+	require.False(t, q.isBanned("node1"))
+
+	// one in three peers at most
+	requireBanned(t, q, "node1", 4, peerBanBaseDuration)
+	_, banned = q.ban("node2", 3)
+	require.False(t, banned)
+	requireBanned(t, q, "node2", 5, peerBanBaseDuration)
+
+	// the expired bans are not counted
+	q.bans["node1"].until = time.Now().Add(-time.Second)
+	requireBanned(t, q, "node3", 5, peerBanBaseDuration)
+}
+
+func TestQuarantinePersistBans(t *testing.T) {
+	db := &memQuarantineDB{data: make(map[string][]byte)}
+	q := newPersistentQuarantine(db, "chain1", &test.GoLogger{})
+	requireBanned(t, q, "node1", 10, peerBanBaseDuration)
+	requireBanned(t, q, "node1", 10, 2*peerBanBaseDuration)
+	q.add(10, []byte{1}, "node1", "invalid signature", []byte("raw10"))
+
+	// the bans are loaded after a restart, the blocks are not
+	restarted := newPersistentQuarantine(db, "chain1", &test.GoLogger{})
+	require.Equal(t, q.BannedPeers(), restarted.BannedPeers())
+	require.Empty(t, restarted.Blocks(0, false))
+	requireBanned(t, restarted, "node1", 10, 4*peerBanBaseDuration)
+
+	// the bans of another chain are kept apart
+	require.Empty(t, newPersistentQuarantine(db, "chain2", &test.GoLogger{}).BannedPeers())
+
+	restarted.Clear(0)
+	require.Empty(t, newPersistentQuarantine(db, "chain1", &test.GoLogger{}).BannedPeers())
+}
+
+func requireBanned(t *testing.T, q *Quarantine, id string, peers int, expected time.Duration) {
+	duration, banned := q.ban(id, peers)
+	require.True(t, banned)
+	require.Equal(t, expected, duration)
+}
+
+// memQuarantineDB a local db in memory
+type memQuarantineDB struct {
+	data map[string][]byte
+}
+
+func (db *memQuarantineDB) Get(key []byte) ([]byte, error) {
+	return db.data[string(key)], nil
+}
+
+func (db *memQuarantineDB) Put(key []byte, value []byte) error {
+	db.data[string(key)] = value
+	return nil
+}
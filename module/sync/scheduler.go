@@ -10,7 +10,6 @@ package sync
 import (
 	"fmt"
 	"math"
-	"sort"
 	"time"
 
 	commonPb "chainmaker.org/chainmaker/pb-go/v2/common"
@@ -61,6 +60,16 @@ type scheduler struct {
 	stopSyncBlock bool
 	// the specified nodes which sync blocks from
 	preferenceNodes map[string]struct{}
+	// score the peers and select the peer which the blocks are requested from
+	selector *peerSelector
+	// the byte budget of a response asked for in the requests, 0 asks for a block per response
+	maxBatchBytes uint64
+	// whether the peers know the byte budget, learned from their responses and timeouts
+	budgetPeers map[string]bool
+	// the number of requests that can be outstanding to each peer, 0 sends one request per scheduling
+	windowsPerPeer int
+	// the peers serving invalid blocks are banned in it
+	quarantine *Quarantine
 }
 
 func newScheduler(
@@ -97,6 +106,7 @@ func newScheduler(
 		pendingBlocks:     make(map[uint64]string),
 		pendingTime:       make(map[uint64]time.Time),
 		receivedBlocks:    make(map[uint64]string),
+		budgetPeers:       make(map[string]bool),
 		pendingRecvHeight: currHeight + 1,
 
 		startTime:       time.Now(),
@@ -104,6 +114,8 @@ func newScheduler(
 		thresholdBlocks: minLagThreshold,
 		minLagReachC:    reachC,
 		preferenceNodes: preferenceNodesMap,
+		selector:        newPeerSelector(),
+		quarantine:      NewQuarantine(),
 	}
 }
 
@@ -139,10 +151,18 @@ func (sch *scheduler) handler(event queue.Item) (queue.Item, error) {
 // update the node corresponding to the node id state information includes BlockHeight and ArchivedHeight
 // if peer's ArchivedHeight is gather than local block height,indicates that node cant sync block from this peer,
 // otherwise according to the own block height, mark the block that needs to be synchronized as "newblock"
+// with the preference first strategy, the other nodes are kept to fall back on
 func (sch *scheduler) handleNodeStatus(msg *NodeStatusMsg) {
-	if !sch.isPreferenceNode(msg.from) {
+	if !sch.isPreferenceNode(msg.from) && sch.selector.getStrategy() != PeerSelectPreferenceFirst {
 		sch.log.Debugf("coming node[%s], status[height: %d],not preference node, will ignore it",
 			msg.from, msg.msg.BlockHeight)
+		// kept by the preference first strategy before it was changed
+		delete(sch.peers, msg.from)
+		return
+	}
+	if sch.quarantine.isBanned(msg.from) {
+		sch.log.Debugf("coming node[%s], status[height: %d], banned for serving invalid blocks, will ignore it",
+			msg.from, msg.msg.BlockHeight)
 		return
 	}
 	localCurrBlk := sch.ledger.GetLastCommittedBlock()
@@ -258,18 +278,36 @@ func (sch *scheduler) handleLivinessMsg() {
 			currBlk.Header.BlockHeight < sch.pendingRecvHeight {
 			sch.blockStates[sch.pendingRecvHeight] = newBlock
 		}
+		sch.selector.observeTimeout(id)
+		sch.observeBudgetTimeout(id)
 		delete(sch.peers, id)
 		delete(sch.pendingTime, sch.pendingRecvHeight)
 		delete(sch.pendingBlocks, sch.pendingRecvHeight)
 	}
 }
 
-// handleScheduleMsg find the starting block height that needs to be synchronized an a appropriate peer
+// handleScheduleMsg send a block request, or with windowsPerPeer, send requests until every peer
+// having the blocks to request has windowsPerPeer requests outstanding
+func (sch *scheduler) handleScheduleMsg() (queue.Item, error) {
+	if sch.windowsPerPeer <= 0 {
+		_, err := sch.requestNextWindow()
+		return nil, err
+	}
+	for {
+		// every request marks at least one block as pending, so it stops when no block is left to request
+		if sent, err := sch.requestNextWindow(); err != nil || !sent {
+			return nil, err
+		}
+	}
+}
+
+// requestNextWindow find the starting block height that needs to be synchronized an a appropriate peer
 // if ok, then send a sync request to peer to get the blocks data
 // with height in[pendingHeight, pendingHeight+sch.BatchesizeInEachReq)
 // localconf.ChainMakerConfig.NodeConfig.FastSyncConfig.Enable used to determine
 // whether the response data of the request needs to have a read-write set
-func (sch *scheduler) handleScheduleMsg() (queue.Item, error) {
+// returns whether a request is sent
+func (sch *scheduler) requestNextWindow() (bool, error) {
 	var (
 		err           error
 		bz            []byte
@@ -279,27 +317,27 @@ func (sch *scheduler) handleScheduleMsg() (queue.Item, error) {
 
 	if !sch.isNeedSync() {
 		//sch.log.Debugf("no need to sync block")
-		return nil, nil
+		return false, nil
 	}
 	//get the block height that needs to be synchronized
 	//the pendingHeight reaches math.MaxUint64  m
 	//means that there are currently no blocks that need to be synchronized
 	if pendingHeight = sch.nextHeightToReq(); pendingHeight == math.MaxUint64 {
 		sch.log.Debugf("pendingHeight: %d, block status %v", pendingHeight, sch.blockStates)
-		return nil, nil
+		return false, nil
 	}
 	//select a peer which the 'pendingHeight' can be requested to
 	if peer = sch.selectPeer(pendingHeight); len(peer) == 0 {
 		sch.log.Debugf("no peers have block [%d] ", pendingHeight)
-		return nil, nil
+		return false, nil
 	}
 	var bsr = syncPb.BlockSyncReq{
 		BlockHeight: pendingHeight,
 		BatchSize:   sch.BatchesizeInEachReq,
 		WithRwset:   localconf.ChainMakerConfig.NodeConfig.FastSyncConfig.Enable,
 	}
-	if bz, err = proto.Marshal(&bsr); err != nil {
-		return nil, err
+	if bz, err = encodeBlockSyncReq(&bsr, sch.blockSyncReqBudget(peer)); err != nil {
+		return false, err
 	}
 
 	sch.lastRequest = time.Now()
@@ -314,9 +352,9 @@ func (sch *scheduler) handleScheduleMsg() (queue.Item, error) {
 		sch.BatchesizeInEachReq)
 	if err := sch.sender.sendMsg(syncPb.SyncMsg_BLOCK_SYNC_REQ, bz, peer); err != nil {
 		sch.log.Warnf("send sync block request for height[%d], fail: %s", pendingHeight, err.Error())
-		return nil, nil //retutn nil prevent external printing errors, example:routine
+		return false, nil //retutn nil prevent external printing errors, example:routine
 	}
-	return nil, nil
+	return true, nil
 }
 
 // handleStopSyncMsg mark stop sync block and clean up records
@@ -380,27 +418,27 @@ func (sch *scheduler) isNeedSync() bool {
 	return currHeight+1 < max || (currHeight+1 == max && time.Since(sch.lastRequest) > sch.reqTimeThreshold)
 }
 
-// selectPeer from other peers select one that contains this height and is currently processing the fewest requests
+// selectPeer from other peers select one that contains this height by the strategy of the peer selector,
+// the peers with windowsPerPeer requests outstanding are not selected
 func (sch *scheduler) selectPeer(pendingHeight uint64) string {
 	peers := sch.getHeight(pendingHeight)
 	if len(peers) == 0 {
 		return ""
 	}
 
-	pendingReqInPeers := make(map[int][]string)
-	for i := 0; i < len(peers); i++ {
-		reqNum := sch.getPendingReqInPeer(peers[i])
-		pendingReqInPeers[reqNum] = append(pendingReqInPeers[reqNum], peers[i])
-	}
-	min := math.MaxInt64
-	for num := range pendingReqInPeers {
-		if min > num {
-			min = num
+	pendingReqInPeers := make(map[string]int, len(peers))
+	available := peers[:0]
+	for _, peer := range peers {
+		reqNum := sch.getPendingReqInPeer(peer)
+		// skip the peer which has windowsPerPeer requests outstanding
+		if sch.windowsPerPeer > 0 && sch.BatchesizeInEachReq > 0 &&
+			(uint64(reqNum)+sch.BatchesizeInEachReq-1)/sch.BatchesizeInEachReq >= uint64(sch.windowsPerPeer) {
+			continue
 		}
+		pendingReqInPeers[peer] = reqNum
+		available = append(available, peer)
 	}
-	peers = pendingReqInPeers[min]
-	sort.Strings(peers)
-	return peers[0]
+	return sch.selector.selectPeer(available, pendingReqInPeers, sch.preferenceNodes)
 }
 
 // get all nodes containing this block height
@@ -442,6 +480,7 @@ func (sch *scheduler) handleSyncedBlockMsg(msg *SyncedBlockMsg) (queue.Item, err
 	if err := proto.Unmarshal(msg.msg, &blkBatch); err != nil {
 		return nil, err
 	}
+	sch.observeBudgetResponse(msg.from)
 	needToProcess := false
 	sch.log.Debugf(
 		"isFastSync: %v ,withRWSet: %v",
@@ -470,7 +509,7 @@ func (sch *scheduler) handleSyncedBlockMsg(msg *SyncedBlockMsg) (queue.Item, err
 // according to the result of block verification, the following processing is performed
 // 1. validateFailed，verification failed，mark block state as "newBlock" waiting to be re-requested later
 // at the same time, the node this block from needs to be removed from the locally cached peer data
-// because it maybe a bad guy.
+// because it maybe a bad guy, and it is banned for a while.
 // 2. addErr, failed to submit block data to local ledger，mark block state as "newBlock"
 // waiting to be re-requested later
 func (sch *scheduler) handleProcessedBlockResp(msg *ProcessedBlockResp) (queue.Item, error) {
@@ -488,6 +527,17 @@ func (sch *scheduler) handleProcessedBlockResp(msg *ProcessedBlockResp) (queue.I
 	}
 	if msg.status == validateFailed {
 		sch.blockStates[msg.height] = newBlock
+		sch.selector.observeInvalidBlock(msg.from)
+		peers := len(sch.peers)
+		if _, exist := sch.peers[msg.from]; !exist {
+			peers++
+		}
+		if duration, banned := sch.quarantine.ban(msg.from, peers); banned {
+			sch.log.Warnf("node [%s] served invalid block [height: %d], banned for %s", msg.from, msg.height, duration)
+		} else {
+			sch.log.Warnf("node [%s] served invalid block [height: %d], not banned to keep enough peers to "+
+				"sync from", msg.from, msg.height)
+		}
 		delete(sch.peers, msg.from)
 	}
 	if msg.status == dbErr {
@@ -518,6 +568,7 @@ func (sch *scheduler) updateSchedulerBySyncBlockBatch(msgFrom string, o interfac
 	var height uint64
 	var hash []byte
 	needToProcess := false
+	latencyObserved := false
 	for i := 0; i < size; i++ {
 		switch ty := o.(type) {
 		case []*commonPb.Block:
@@ -530,6 +581,11 @@ func (sch *scheduler) updateSchedulerBySyncBlockBatch(msgFrom string, o interfac
 			sch.log.Errorf("received unrecognized block type: [%t]", ty)
 			continue
 		}
+		// the blocks of a request are sent in one response, the latency is observed once
+		if reqTime, exist := sch.pendingTime[height]; exist && !latencyObserved && sch.pendingBlocks[height] == msgFrom {
+			sch.selector.observeResponse(msgFrom, time.Since(reqTime))
+			latencyObserved = true
+		}
 		delete(sch.pendingBlocks, height)
 		delete(sch.pendingTime, height)
 		if state, exist := sch.blockStates[height]; exist {
@@ -373,3 +373,117 @@ func Test_scheduler_preferenceNode(t *testing.T) {
 		})
 	}
 }
+
+func TestSchedulerPeerScores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSender := NewMockSender()
+	mockLedger := newMockLedgerCache(ctrl, &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 10}})
+	sch := newScheduler(mockSender, mockLedger, 100, time.Second, time.Second*3,
+		2, &test.GoLogger{}, make(chan struct{}), 10, 10, []string{"node1"})
+
+	// 1. with the preference first strategy, the other nodes are kept
+	require.NoError(t, sch.selector.setStrategy(PeerSelectPreferenceFirst))
+	_, _ = sch.handler(&NodeStatusMsg{from: "node1", msg: syncPb.BlockHeightBCM{BlockHeight: 11}})
+	_, _ = sch.handler(&NodeStatusMsg{from: "node2", msg: syncPb.BlockHeightBCM{BlockHeight: 100}})
+	require.EqualValues(t, 2, len(sch.peers))
+	require.Equal(t, "node1", sch.selectPeer(11))
+	require.Equal(t, "node2", sch.selectPeer(12))
+
+	// 2. the latency of the response is observed
+	_, _ = sch.handler(&SchedulerMsg{})
+	require.Equal(t, "node1", sch.pendingBlocks[11])
+	msg := &syncPb.SyncBlockBatch{
+		Data: &syncPb.SyncBlockBatch_BlockinfoBatch{
+			BlockinfoBatch: &syncPb.BlockInfoBatch{
+				Batch: []*commonPb.BlockInfo{
+					{Block: &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 11}}},
+				},
+			},
+		},
+	}
+	bz, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	_, err = sch.handler(&SyncedBlockMsg{from: "node1", msg: bz})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, sch.selector.scores["node1"].responses)
+
+	// 3. the invalid block is observed
+	_, err = sch.handler(&ProcessedBlockResp{height: 11, status: validateFailed, from: "node1"})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, sch.selector.scores["node1"].invalidBlocks)
+
+	// 4. the timeout is observed
+	_, _ = sch.handler(&SchedulerMsg{})
+	require.Equal(t, "node2", sch.pendingBlocks[11])
+	sch.pendingTime[11] = time.Now().Add(-2 * sch.peerReqTimeout)
+	_, _ = sch.handler(&LivenessMsg{})
+	require.EqualValues(t, 1, sch.selector.scores["node2"].timeouts)
+
+	// 5. the other nodes are dropped after the strategy is changed
+	_, _ = sch.handler(&NodeStatusMsg{from: "node2", msg: syncPb.BlockHeightBCM{BlockHeight: 100}})
+	require.EqualValues(t, 100, sch.peers["node2"])
+	require.NoError(t, sch.selector.setStrategy(PeerSelectLeastLoaded))
+	_, _ = sch.handler(&NodeStatusMsg{from: "node2", msg: syncPb.BlockHeightBCM{BlockHeight: 100}})
+	_, exist := sch.peers["node2"]
+	require.False(t, exist)
+}
+
+func TestSchedulerPipelineWindows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSender := NewMockSender()
+	mockLedger := newMockLedgerCache(ctrl, &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 10}})
+	sch := newScheduler(mockSender, mockLedger, 100, time.Second, time.Second*3,
+		2, &test.GoLogger{}, make(chan struct{}), 10, 10, nil)
+	sch.windowsPerPeer = 3
+
+	// 1. every peer gets windowsPerPeer requests in a scheduling
+	_, _ = sch.handler(&NodeStatusMsg{from: "node1", msg: syncPb.BlockHeightBCM{BlockHeight: 100}})
+	_, _ = sch.handler(&NodeStatusMsg{from: "node2", msg: syncPb.BlockHeightBCM{BlockHeight: 100}})
+	_, err := sch.handler(&SchedulerMsg{})
+	require.NoError(t, err)
+	require.Len(t, mockSender.msgs, 6)
+	require.EqualValues(t, 12, len(sch.pendingBlocks))
+	require.EqualValues(t, 6, sch.getPendingReqInPeer("node1"))
+
+	// 2. no more requests until a window is received
+	_, _ = sch.handler(&SchedulerMsg{})
+	require.Len(t, mockSender.msgs, 6)
+	peer := sch.pendingBlocks[11]
+	delete(sch.pendingBlocks, 11)
+	delete(sch.pendingBlocks, 12)
+	_, _ = sch.handler(&SchedulerMsg{})
+	require.Len(t, mockSender.msgs, 7)
+	require.Equal(t, peer, sch.pendingBlocks[23])
+}
+
+func TestSchedulerBanPeer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockLedger := newMockLedgerCache(ctrl, &commonPb.Block{Header: &commonPb.BlockHeader{BlockHeight: 5}})
+	sch := newScheduler(NewMockSender(), mockLedger, 100, time.Second, time.Second*3,
+		2, &test.GoLogger{}, make(chan struct{}), 10, 10, nil)
+
+	for _, id := range []string{"node1", "node2", "node3", "node4"} {
+		_, _ = sch.handler(&NodeStatusMsg{from: id, msg: syncPb.BlockHeightBCM{BlockHeight: 10}})
+	}
+	require.EqualValues(t, 10, sch.peers["node1"])
+
+	// the peer serving an invalid block is banned, its status is ignored
+	_, err := sch.handler(&ProcessedBlockResp{height: 6, status: validateFailed, from: "node1"})
+	require.NoError(t, err)
+	require.True(t, sch.quarantine.isBanned("node1"))
+	_, _ = sch.handler(&NodeStatusMsg{from: "node1", msg: syncPb.BlockHeightBCM{BlockHeight: 10}})
+	_, exist := sch.peers["node1"]
+	require.False(t, exist)
+
+	// a second ban would leave too few peers, the peer is dropped until its next status only
+	_, err = sch.handler(&ProcessedBlockResp{height: 6, status: validateFailed, from: "node2"})
+	require.NoError(t, err)
+	require.False(t, sch.quarantine.isBanned("node2"))
+	_, exist = sch.peers["node2"]
+	require.False(t, exist)
+	_, _ = sch.handler(&NodeStatusMsg{from: "node2", msg: syncPb.BlockHeightBCM{BlockHeight: 10}})
+	require.EqualValues(t, 10, sch.peers["node2"])
+}
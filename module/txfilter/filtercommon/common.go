@@ -10,12 +10,14 @@ package filtercommon
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	bn "chainmaker.org/chainmaker/common/v2/birdsnest"
 	sbn "chainmaker.org/chainmaker/common/v2/shardingbirdsnest"
 	"chainmaker.org/chainmaker/pb-go/v2/common"
 
+	"chainmaker.org/chainmaker-go/module/confreload"
 	"chainmaker.org/chainmaker/localconf/v2"
 	"chainmaker.org/chainmaker/protocol/v2"
 	"chainmaker.org/chainmaker/utils/v2"
@@ -63,7 +65,7 @@ func ChaseBlockHeight(store protocol.BlockchainStore, filter protocol.TxFilter,
 
 // GetConf Get the configuration of the transaction filter
 func GetConf(chainId string) (*TxFilterConfig, error) {
-	return ToPbConfig(localconf.ChainMakerConfig.TxFilter, chainId)
+	return ToPbConfig(confreload.Config().TxFilter, chainId)
 }
 
 // ToPbConfig Convert localconf.TxFilterConfig to config.TxFilterConfig
@@ -113,6 +115,21 @@ func ToPbConfig(conf localconf.TxFilterConfig, chainId string) (*TxFilterConfig,
 	case TxFilterTypeMap:
 		// Returns the map transaction filter if specified in the configuration file
 		return c, nil
+	case TxFilterTypeMapWindow:
+		// Returns the map window transaction filter, which checkpoints under the store path
+		c.MapWindow = &MapWindowConfig{
+			BlockWindow:      DefaultMapWindowBlocks,
+			SnapshotInterval: DefaultMapWindowSnapshotInterval,
+			MaxStoreLookups:  DefaultMapWindowMaxStoreLookups,
+		}
+		if err := confreload.UnmarshalExtension(MapWindowConfigKey, c.MapWindow); err != nil {
+			return nil, err
+		}
+		c.MapWindow.ChainId = chainId
+		if storePath, ok := localconf.ChainMakerConfig.StorageConfig["store_path"].(string); ok && storePath != "" {
+			c.MapWindow.SnapshotPath = filepath.Join(storePath, chainId, "txfilter_map")
+		}
+		return c, nil
 	case TxFilterTypeShardingBirdsNest:
 		// Returns the Sharding Bird's Nest transaction filter if specified in the configuration file
 		// Check the Bird's Nest configuration
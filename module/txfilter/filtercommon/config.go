@@ -24,6 +24,20 @@ const (
 	TxFilterTypeMap TxFilterType = 2
 	// TxFilterTypeShardingBirdsNest Sharding Bird's Nest transaction filter type
 	TxFilterTypeShardingBirdsNest TxFilterType = 3
+	// TxFilterTypeMapWindow Map transaction filter type which only keeps the tx ids of recent blocks
+	TxFilterTypeMapWindow TxFilterType = 4
+)
+
+const (
+	// DefaultMapWindowBlocks the default number of recent blocks whose tx ids the map window filter keeps
+	DefaultMapWindowBlocks = 100000
+	// DefaultMapWindowSnapshotInterval the default number of blocks between two checkpoints of the map window filter
+	DefaultMapWindowSnapshotInterval = 100
+	// DefaultMapWindowMaxStoreLookups the default number of the concurrent store lookups of the map window filter
+	DefaultMapWindowMaxStoreLookups = 64
+
+	// MapWindowConfigKey the key of the map window filter config in chainmaker.yml
+	MapWindowConfigKey = "tx_filter.map_window"
 )
 
 // TxFilterConfig transaction filter config
@@ -34,4 +48,21 @@ type TxFilterConfig struct {
 	BirdsNest *bn.BirdsNestConfig `json:"birds_nest,omitempty"`
 	// Sharding bird's nest configuration
 	ShardingBirdsNest *sbn.ShardingBirdsNestConfig `json:"sharding_birds_nest,omitempty"`
+	// Map window configuration
+	MapWindow *MapWindowConfig `json:"map_window,omitempty"`
+}
+
+// MapWindowConfig map window transaction filter config
+type MapWindowConfig struct {
+	// ChainId chain id
+	ChainId string `json:"chain_id,omitempty" mapstructure:"-"`
+	// BlockWindow keep the tx ids of the last BlockWindow blocks, 0 for no limit
+	BlockWindow uint64 `json:"block_window,omitempty" mapstructure:"block_window"`
+	// SnapshotPath the directory of the checkpoint, empty for no checkpoint
+	SnapshotPath string `json:"snapshot_path,omitempty" mapstructure:"-"`
+	// SnapshotInterval the number of blocks between two checkpoints
+	SnapshotInterval uint64 `json:"snapshot_interval,omitempty" mapstructure:"snapshot_interval"`
+	// MaxStoreLookups the number of the concurrent store lookups for the tx ids out of the window, the others wait,
+	// 0 for no limit
+	MaxStoreLookups int `json:"max_store_lookups,omitempty" mapstructure:"max_store_lookups"`
 }
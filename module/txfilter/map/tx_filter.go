@@ -9,6 +9,7 @@ package mapimpl
 
 import (
 	"sync"
+	"sync/atomic"
 
 	bn "chainmaker.org/chainmaker/common/v2/birdsnest"
 	"chainmaker.org/chainmaker/pb-go/v2/txfilter"
@@ -16,7 +17,7 @@ import (
 
 // TxFilter sync.Map transaction filter
 type TxFilter struct {
-	// blcok height
+	// blcok height, atomic
 	height uint64
 	// m Thread-safe map
 	m sync.Map
@@ -34,12 +35,12 @@ func New() *TxFilter {
 
 // GetHeight get height from transaction filter
 func (f *TxFilter) GetHeight() uint64 {
-	return f.height
+	return atomic.LoadUint64(&f.height)
 }
 
 // SetHeight set height from transaction filter
 func (f *TxFilter) SetHeight(height uint64) {
-	f.height = height
+	atomic.StoreUint64(&f.height, height)
 }
 
 // IsExistsAndReturnHeight is exists and return height
@@ -48,7 +49,7 @@ func (f *TxFilter) IsExistsAndReturnHeight(txId string, _ ...bn.RuleType) (bool,
 	if err != nil {
 		return false, 0, nil, err
 	}
-	return exists, f.height, stat, nil
+	return exists, f.GetHeight(), stat, nil
 }
 
 // Add txId to transaction filter
@@ -60,7 +61,7 @@ func (f *TxFilter) Add(txId string) error {
 // Adds batch Add txId
 func (f *TxFilter) Adds(txIds []string) error {
 	for _, txId := range txIds {
-		go f.m.Store(txId, struct{}{})
+		f.m.Store(txId, struct{}{})
 	}
 	return nil
 }
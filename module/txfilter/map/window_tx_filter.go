@@ -0,0 +1,367 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mapimpl
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/txfilter/filtercommon"
+	bn "chainmaker.org/chainmaker/common/v2/birdsnest"
+	"chainmaker.org/chainmaker/pb-go/v2/txfilter"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+const (
+	// windowSnapshotFile the name of the checkpoint file in MapWindowConfig.SnapshotPath
+	windowSnapshotFile    = "map_window.snapshot"
+	windowSnapshotVersion = 1
+)
+
+// WindowTxFilter map transaction filter which only keeps the tx ids of recent blocks. A tx id older than the
+// window is looked up in the store, so evicting never lets a duplicate tx through. The blocks are evicted by
+// height only, so all the nodes keep the same tx ids.
+type WindowTxFilter struct {
+	config *filtercommon.MapWindowConfig
+	log    protocol.Logger
+	store  protocol.BlockchainStore
+	// lookups bounds the concurrent store lookups, nil for no limit
+	lookups chan struct{}
+
+	// height block height, atomic
+	height uint64
+
+	// mu protects ids, blocks and evicted
+	mu sync.RWMutex
+	// ids tx id => height of the block it was added with
+	ids map[string]uint64
+	// blocks the tx ids of each block, by ascending height
+	blocks []*windowBlock
+	// evicted whether tx ids were ever evicted, or never loaded, so that misses must be looked up in the store
+	evicted bool
+
+	// snapshotLock serializes the writes of the checkpoint
+	snapshotLock   sync.Mutex
+	snapshotHeight uint64
+	snapshotWg     sync.WaitGroup
+}
+
+// windowBlock the tx ids added with one block height
+type windowBlock struct {
+	Height uint64
+	TxIds  []string
+}
+
+// windowSnapshot the checkpoint of WindowTxFilter
+type windowSnapshot struct {
+	Version int
+	Height  uint64
+	Evicted bool
+	Blocks  []*windowBlock
+}
+
+// NewWindow map window transaction filter init, it loads the last checkpoint and chases the blocks after it
+func NewWindow(config *filtercommon.MapWindowConfig, log protocol.Logger, store protocol.BlockchainStore) (
+	protocol.TxFilter, error) {
+	if config == nil {
+		return nil, errors.New("map window filter config is nil")
+	}
+	initLasts := time.Now()
+	f := &WindowTxFilter{
+		config: config,
+		log:    log,
+		store:  store,
+		ids:    make(map[string]uint64),
+	}
+	if config.MaxStoreLookups > 0 {
+		f.lookups = make(chan struct{}, config.MaxStoreLookups)
+	}
+
+	lastBlock, err := store.GetLastBlock()
+	if err != nil {
+		log.Errorf("query last block from db fail, error: %v", err)
+		return nil, err
+	}
+	lastHeight := lastBlock.Header.BlockHeight
+	loaded, err := f.loadSnapshot(lastHeight)
+	if err != nil {
+		log.Warnf("load map window filter checkpoint fail, chase blocks without it, error: %v", err)
+	}
+	if !loaded && config.BlockWindow > 0 && lastHeight > config.BlockWindow {
+		// the blocks before the window would be evicted right after being chased
+		f.height = lastHeight - config.BlockWindow
+		f.evicted = true
+	}
+	f.snapshotHeight = f.GetHeight()
+
+	if err = filtercommon.ChaseBlockHeight(store, f, log); err != nil {
+		return nil, err
+	}
+	log.Infof("map window filter init success, height: %v, keys: %v, from checkpoint: %v, cost: %v",
+		f.GetHeight(), f.size(), loaded, time.Since(initLasts))
+	return f, nil
+}
+
+// ValidateRule validate rules
+func (f *WindowTxFilter) ValidateRule(_ string, _ ...bn.RuleType) error {
+	return nil
+}
+
+// GetHeight get height from transaction filter
+func (f *WindowTxFilter) GetHeight() uint64 {
+	return atomic.LoadUint64(&f.height)
+}
+
+// SetHeight set height from transaction filter, evicting the blocks out of the window
+func (f *WindowTxFilter) SetHeight(height uint64) {
+	atomic.StoreUint64(&f.height, height)
+
+	f.mu.Lock()
+	f.evict(height)
+	f.mu.Unlock()
+
+	f.trySnapshot(height)
+}
+
+// IsExistsAndReturnHeight is exists and return height
+func (f *WindowTxFilter) IsExistsAndReturnHeight(txId string, _ ...bn.RuleType) (bool, uint64, *txfilter.Stat,
+	error) {
+	exists, stat, err := f.IsExists(txId)
+	if err != nil {
+		return false, 0, nil, err
+	}
+	return exists, f.GetHeight(), stat, nil
+}
+
+// Add txId to transaction filter, it belongs to the block after the current height
+func (f *WindowTxFilter) Add(txId string) error {
+	return f.Adds([]string{txId})
+}
+
+// Adds batch Add txId, they belong to the block after the current height
+func (f *WindowTxFilter) Adds(txIds []string) error {
+	f.add(txIds, f.GetHeight()+1)
+	return nil
+}
+
+// AddsAndSetHeight batch add tx id and set height
+func (f *WindowTxFilter) AddsAndSetHeight(txIds []string, height uint64) error {
+	f.add(txIds, height)
+	f.SetHeight(height)
+	return nil
+}
+
+// IsExists Check whether TxId exists in the transaction filter, or in the store if it may have been evicted
+func (f *WindowTxFilter) IsExists(txId string, _ ...bn.RuleType) (bool, *txfilter.Stat, error) {
+	start := time.Now()
+	f.mu.RLock()
+	_, ok := f.ids[txId]
+	evicted := f.evicted
+	f.mu.RUnlock()
+	if ok || !evicted {
+		return ok, nil, nil
+	}
+
+	filterCosts := time.Since(start)
+	if f.lookups != nil {
+		f.lookups <- struct{}{}
+		defer func() { <-f.lookups }()
+	}
+	exists, err := f.store.TxExists(txId)
+	if err != nil {
+		return false, nil, err
+	}
+	return exists, filtercommon.NewStat0(filterCosts, time.Since(start)-filterCosts), nil
+}
+
+// Close transaction filter, writing the last checkpoint
+func (f *WindowTxFilter) Close() {
+	f.snapshotWg.Wait()
+	if err := f.snapshot(); err != nil {
+		f.log.Errorf("write map window filter checkpoint fail, error: %v", err)
+	}
+}
+
+func (f *WindowTxFilter) add(txIds []string, height uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var block *windowBlock
+	for i := len(f.blocks) - 1; i >= 0 && f.blocks[i].Height >= height; i-- {
+		if f.blocks[i].Height == height {
+			block = f.blocks[i]
+			break
+		}
+	}
+	if block == nil {
+		block = &windowBlock{Height: height}
+		f.blocks = append(f.blocks, block)
+		if n := len(f.blocks); n > 1 && f.blocks[n-2].Height > height {
+			// out of order, keep the blocks sorted so that eviction stops at the first one in the window
+			for i := n - 1; i > 0 && f.blocks[i-1].Height > height; i-- {
+				f.blocks[i-1], f.blocks[i] = f.blocks[i], f.blocks[i-1]
+			}
+		}
+	}
+	for _, txId := range txIds {
+		if _, ok := f.ids[txId]; ok {
+			continue
+		}
+		f.ids[txId] = height
+		block.TxIds = append(block.TxIds, txId)
+	}
+}
+
+// evict drop the blocks out of the block window, the caller holds mu
+func (f *WindowTxFilter) evict(height uint64) {
+	if f.config.BlockWindow == 0 {
+		return
+	}
+	n := 0
+	for ; n < len(f.blocks); n++ {
+		block := f.blocks[n]
+		if block.Height+f.config.BlockWindow > height {
+			break
+		}
+		for _, txId := range block.TxIds {
+			delete(f.ids, txId)
+		}
+		f.blocks[n] = nil
+	}
+	if n > 0 {
+		f.blocks = f.blocks[n:]
+		f.evicted = true
+	}
+}
+
+func (f *WindowTxFilter) size() int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.ids)
+}
+
+// trySnapshot write a checkpoint in background once every SnapshotInterval blocks
+func (f *WindowTxFilter) trySnapshot(height uint64) {
+	if f.config.SnapshotPath == "" || f.config.SnapshotInterval == 0 {
+		return
+	}
+	if !f.snapshotLock.TryLock() {
+		return
+	}
+	if height < f.snapshotHeight+f.config.SnapshotInterval {
+		f.snapshotLock.Unlock()
+		return
+	}
+	f.snapshotWg.Add(1)
+	go func() {
+		defer f.snapshotWg.Done()
+		defer f.snapshotLock.Unlock()
+		if err := f.snapshotLocked(); err != nil {
+			f.log.Errorf("write map window filter checkpoint fail, error: %v", err)
+		}
+	}()
+}
+
+func (f *WindowTxFilter) snapshot() error {
+	if f.config.SnapshotPath == "" {
+		return nil
+	}
+	f.snapshotLock.Lock()
+	defer f.snapshotLock.Unlock()
+	return f.snapshotLocked()
+}
+
+// snapshotLocked write the checkpoint to a temporary file and rename it, the caller holds snapshotLock
+func (f *WindowTxFilter) snapshotLocked() error {
+	f.mu.RLock()
+	snap := &windowSnapshot{
+		Version: windowSnapshotVersion,
+		Height:  f.GetHeight(),
+		Evicted: f.evicted,
+		Blocks:  make([]*windowBlock, 0, len(f.blocks)),
+	}
+	for _, block := range f.blocks {
+		if block.Height > snap.Height {
+			// pending tx ids are chased again after a restart
+			continue
+		}
+		snap.Blocks = append(snap.Blocks, &windowBlock{
+			Height: block.Height,
+			TxIds:  append([]string(nil), block.TxIds...),
+		})
+	}
+	f.mu.RUnlock()
+
+	if err := os.MkdirAll(f.config.SnapshotPath, 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(f.config.SnapshotPath, windowSnapshotFile)
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if err = gob.NewEncoder(file).Encode(snap); err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return err
+	}
+	f.snapshotHeight = snap.Height
+	f.log.Debugf("map window filter checkpoint written, height: %v, blocks: %v", snap.Height, len(snap.Blocks))
+	return nil
+}
+
+// loadSnapshot load the checkpoint unless it is ahead of the store, which happens when the store is restored
+func (f *WindowTxFilter) loadSnapshot(lastHeight uint64) (bool, error) {
+	if f.config.SnapshotPath == "" {
+		return false, nil
+	}
+	file, err := os.Open(filepath.Join(f.config.SnapshotPath, windowSnapshotFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer file.Close()
+
+	snap := &windowSnapshot{}
+	if err = gob.NewDecoder(file).Decode(snap); err != nil {
+		return false, err
+	}
+	if snap.Version != windowSnapshotVersion {
+		return false, fmt.Errorf("unknown checkpoint version %d", snap.Version)
+	}
+	if snap.Height > lastHeight {
+		return false, fmt.Errorf("checkpoint height %d is ahead of the last block %d", snap.Height, lastHeight)
+	}
+
+	f.height = snap.Height
+	f.evicted = snap.Evicted
+	f.blocks = snap.Blocks
+	for _, block := range f.blocks {
+		for _, txId := range block.TxIds {
+			f.ids[txId] = block.Height
+		}
+	}
+	// the window may have been changed since the checkpoint
+	f.evict(snap.Height)
+	return true, nil
+}
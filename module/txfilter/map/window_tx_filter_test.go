@@ -0,0 +1,131 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package mapimpl
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/txfilter/filtercommon"
+	commonpb "chainmaker.org/chainmaker/pb-go/v2/common"
+	"chainmaker.org/chainmaker/protocol/v2/mock"
+	"chainmaker.org/chainmaker/protocol/v2/test"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newWindowTestBlock(height uint64) *commonpb.Block {
+	return &commonpb.Block{
+		Header: &commonpb.BlockHeader{BlockHeight: height},
+		Txs: []*commonpb.Transaction{
+			{Payload: &commonpb.Payload{TxId: fmt.Sprintf("tx-%d", height)}},
+		},
+	}
+}
+
+func newWindowTestStore(t *testing.T, lastHeight uint64) *mock.MockBlockchainStore {
+	store := mock.NewMockBlockchainStore(gomock.NewController(t))
+	store.EXPECT().GetLastBlock().Return(newWindowTestBlock(lastHeight), nil).AnyTimes()
+	store.EXPECT().GetBlock(gomock.Any()).DoAndReturn(func(height uint64) (*commonpb.Block, error) {
+		return newWindowTestBlock(height), nil
+	}).AnyTimes()
+	return store
+}
+
+func TestWindowTxFilter_BlockWindow(t *testing.T) {
+	store := newWindowTestStore(t, 0)
+	store.EXPECT().TxExists("tx-1").Return(true, nil)
+	store.EXPECT().TxExists("tx-new").Return(false, nil)
+
+	filter, err := NewWindow(&filtercommon.MapWindowConfig{BlockWindow: 2}, &test.GoLogger{}, store)
+	require.NoError(t, err)
+
+	// nothing evicted yet, misses never reach the store
+	require.NoError(t, filter.AddsAndSetHeight([]string{"tx-1"}, 1))
+	exists, _, err := filter.IsExists("tx-other")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	for height := uint64(2); height <= 3; height++ {
+		require.NoError(t, filter.AddsAndSetHeight([]string{fmt.Sprintf("tx-%d", height)}, height))
+	}
+	require.Equal(t, uint64(3), filter.GetHeight())
+	require.Equal(t, 2, filter.(*WindowTxFilter).size())
+
+	// evicted ids are found in the store
+	exists, _, err = filter.IsExists("tx-1")
+	require.NoError(t, err)
+	require.True(t, exists)
+	exists, _, err = filter.IsExists("tx-new")
+	require.NoError(t, err)
+	require.False(t, exists)
+	exists, height, _, err := filter.IsExistsAndReturnHeight("tx-3")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, uint64(3), height)
+}
+
+func TestWindowTxFilter_MaxStoreLookups(t *testing.T) {
+	store := newWindowTestStore(t, 0)
+	release := make(chan struct{})
+	store.EXPECT().TxExists(gomock.Any()).DoAndReturn(func(string) (bool, error) {
+		<-release
+		return false, nil
+	}).Times(2)
+	filter, err := NewWindow(&filtercommon.MapWindowConfig{BlockWindow: 1, MaxStoreLookups: 1}, &test.GoLogger{},
+		store)
+	require.NoError(t, err)
+	f := filter.(*WindowTxFilter)
+	require.NoError(t, f.AddsAndSetHeight([]string{"tx-1"}, 1))
+	require.NoError(t, f.AddsAndSetHeight([]string{"tx-2"}, 2))
+	require.True(t, f.evicted)
+
+	done := make(chan struct{}, 2)
+	for i := 0; i < 2; i++ {
+		go func(i int) {
+			_, _, err := f.IsExists(fmt.Sprintf("tx-new-%d", i))
+			require.NoError(t, err)
+			done <- struct{}{}
+		}(i)
+	}
+	// the second lookup waits for the first one
+	require.Eventually(t, func() bool { return len(f.lookups) == 1 }, time.Second, time.Millisecond)
+	release <- struct{}{}
+	<-done
+	require.Eventually(t, func() bool { return len(f.lookups) == 1 }, time.Second, time.Millisecond)
+	release <- struct{}{}
+	<-done
+	require.Zero(t, len(f.lookups))
+}
+
+func TestWindowTxFilter_Checkpoint(t *testing.T) {
+	config := &filtercommon.MapWindowConfig{BlockWindow: 10, SnapshotPath: t.TempDir(), SnapshotInterval: 2}
+	filter, err := NewWindow(config, &test.GoLogger{}, newWindowTestStore(t, 5))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), filter.GetHeight())
+	filter.Close()
+
+	// a restart only chases the blocks after the checkpoint
+	store := newWindowTestStore(t, 7)
+	filter, err = NewWindow(config, &test.GoLogger{}, store)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), filter.GetHeight())
+	for height := 1; height <= 7; height++ {
+		exists, _, err := filter.IsExists(fmt.Sprintf("tx-%d", height))
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+	filter.Close()
+
+	// a checkpoint ahead of the store is ignored
+	filter, err = NewWindow(config, &test.GoLogger{}, newWindowTestStore(t, 3))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), filter.GetHeight())
+	require.Equal(t, 3, filter.(*WindowTxFilter).size())
+	filter.Close()
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txfilter
+
+import (
+	"sync"
+	"sync/atomic"
+
+	bn "chainmaker.org/chainmaker/common/v2/birdsnest"
+	"chainmaker.org/chainmaker/pb-go/v2/txfilter"
+	"chainmaker.org/chainmaker/protocol/v2"
+)
+
+// ReloadableTxFilter a transaction filter whose implementation can be replaced at runtime, so that the modules
+// holding it follow a reload of the tx filter config
+type ReloadableTxFilter struct {
+	current atomic.Value // *txFilterHolder
+	// addLock is held for read by the adds and for write by a replace, so no committed block is added to the
+	// replaced implementation after the new one caught up with the store
+	addLock sync.RWMutex
+}
+
+// txFilterHolder keeps the concrete type stored in the atomic.Value the same
+type txFilterHolder struct {
+	protocol.TxFilter
+}
+
+// NewReloadableTxFilter wrap a transaction filter
+func NewReloadableTxFilter(filter protocol.TxFilter) *ReloadableTxFilter {
+	f := &ReloadableTxFilter{}
+	f.current.Store(&txFilterHolder{TxFilter: filter})
+	return f
+}
+
+// Replace the implementation, and returns the replaced one, which is not closed
+func (f *ReloadableTxFilter) Replace(filter protocol.TxFilter) protocol.TxFilter {
+	f.addLock.Lock()
+	defer f.addLock.Unlock()
+	return f.current.Swap(&txFilterHolder{TxFilter: filter}).(*txFilterHolder).TxFilter
+}
+
+// ReplaceAfter replace the implementation once catchUp returns, the adds are blocked meanwhile, so catchUp brings
+// the new one up to the committed blocks for good. The implementation is not replaced if catchUp fails.
+// It returns the replaced one, which is not closed.
+func (f *ReloadableTxFilter) ReplaceAfter(filter protocol.TxFilter,
+	catchUp func(filter protocol.TxFilter) error) (protocol.TxFilter, error) {
+	f.addLock.Lock()
+	defer f.addLock.Unlock()
+	if err := catchUp(filter); err != nil {
+		return nil, err
+	}
+	return f.current.Swap(&txFilterHolder{TxFilter: filter}).(*txFilterHolder).TxFilter, nil
+}
+
+// Current returns the implementation in use
+func (f *ReloadableTxFilter) Current() protocol.TxFilter {
+	return f.current.Load().(*txFilterHolder).TxFilter
+}
+
+// ValidateRule validate transaction rules
+func (f *ReloadableTxFilter) ValidateRule(txId string, ruleType ...bn.RuleType) error {
+	return f.Current().ValidateRule(txId, ruleType...)
+}
+
+// GetHeight get height from transaction filter
+func (f *ReloadableTxFilter) GetHeight() uint64 {
+	return f.Current().GetHeight()
+}
+
+// SetHeight set height from transaction filter
+func (f *ReloadableTxFilter) SetHeight(height uint64) {
+	f.addLock.RLock()
+	defer f.addLock.RUnlock()
+	f.Current().SetHeight(height)
+}
+
+// IsExistsAndReturnHeight is exists and return height
+func (f *ReloadableTxFilter) IsExistsAndReturnHeight(txId string, ruleType ...bn.RuleType) (bool, uint64,
+	*txfilter.Stat, error) {
+	return f.Current().IsExistsAndReturnHeight(txId, ruleType...)
+}
+
+// Add txId to transaction filter
+func (f *ReloadableTxFilter) Add(txId string) error {
+	f.addLock.RLock()
+	defer f.addLock.RUnlock()
+	return f.Current().Add(txId)
+}
+
+// Adds batch Add txId
+func (f *ReloadableTxFilter) Adds(txIds []string) error {
+	f.addLock.RLock()
+	defer f.addLock.RUnlock()
+	return f.Current().Adds(txIds)
+}
+
+// AddsAndSetHeight batch add tx id and set height
+func (f *ReloadableTxFilter) AddsAndSetHeight(txIds []string, height uint64) error {
+	f.addLock.RLock()
+	defer f.addLock.RUnlock()
+	return f.Current().AddsAndSetHeight(txIds, height)
+}
+
+// IsExists Check whether TxId exists in the transaction filter
+func (f *ReloadableTxFilter) IsExists(txId string, ruleType ...bn.RuleType) (bool, *txfilter.Stat, error) {
+	return f.Current().IsExists(txId, ruleType...)
+}
+
+// Close transaction filter
+func (f *ReloadableTxFilter) Close() {
+	f.Current().Close()
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package txfilter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mapimpl "chainmaker.org/chainmaker-go/module/txfilter/map"
+	"chainmaker.org/chainmaker/protocol/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadableTxFilter(t *testing.T) {
+	old := mapimpl.New()
+	filter := NewReloadableTxFilter(old)
+	require.NoError(t, filter.AddsAndSetHeight([]string{"tx1"}, 1))
+	exists, _, err := filter.IsExists("tx1")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	replaced := filter.Replace(mapimpl.New())
+	require.Same(t, old, replaced)
+	require.NotSame(t, old, filter.Current())
+	exists, _, err = filter.IsExists("tx1")
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.Zero(t, filter.GetHeight())
+
+	require.NoError(t, filter.AddsAndSetHeight([]string{"tx2"}, 2))
+	exists, height, _, err := filter.IsExistsAndReturnHeight("tx2")
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.EqualValues(t, 2, height)
+}
+
+func TestReloadableTxFilter_ReplaceAfter(t *testing.T) {
+	old := mapimpl.New()
+	filter := NewReloadableTxFilter(old)
+
+	_, err := filter.ReplaceAfter(mapimpl.New(), func(protocol.TxFilter) error {
+		return errors.New("store closed")
+	})
+	require.Error(t, err)
+	require.Same(t, old, filter.Current())
+
+	added := make(chan struct{})
+	replaced, err := filter.ReplaceAfter(mapimpl.New(), func(caught protocol.TxFilter) error {
+		go func() {
+			// a block committed while catching up is added to the new implementation
+			require.NoError(t, filter.AddsAndSetHeight([]string{"tx2"}, 2))
+			close(added)
+		}()
+		select {
+		case <-added:
+			t.Error("added before the implementation is replaced")
+		case <-time.After(50 * time.Millisecond):
+		}
+		return caught.AddsAndSetHeight([]string{"tx1"}, 1)
+	})
+	require.NoError(t, err)
+	require.Same(t, old, replaced)
+	<-added
+	for _, txId := range []string{"tx1", "tx2"} {
+		exists, _, err := filter.IsExists(txId)
+		require.NoError(t, err)
+		require.True(t, exists)
+	}
+	exists, _, err := old.IsExists("tx2")
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.EqualValues(t, 2, filter.GetHeight())
+}
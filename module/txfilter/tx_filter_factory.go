@@ -54,6 +54,9 @@ func (cf *txFilterFactory) NewTxFilter(conf *filtercommon.TxFilterConfig, log pr
 		// sharding bird's nest txfilter
 	case filtercommon.TxFilterTypeShardingBirdsNest:
 		return shardingbirdsnest.New(conf.ShardingBirdsNest, log, store)
+		// map txfilter keeping the tx ids of recent blocks
+	case filtercommon.TxFilterTypeMapWindow:
+		return mapimpl.NewWindow(conf.MapWindow, log, store)
 	default:
 		log.Warnf("txfilter type: %v not support, use default type: store", conf.Type)
 		return filterdefault.New(store), nil
@@ -0,0 +1,189 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"chainmaker.org/chainmaker-go/module/rpcserver"
+	"chainmaker.org/chainmaker-go/tools/cmc/util"
+	"chainmaker.org/chainmaker/common/v2/ca"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+var (
+	chainId     string
+	height      uint64
+	dagFormat   string
+	startHeight uint64
+	endHeight   uint64
+	hotKeysTop  int
+)
+
+const (
+	flagChainId     = "chain-id"
+	flagHeight      = "height"
+	flagFormat      = "format"
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+	flagTop         = "top"
+)
+
+// initDAGFlags register the flags of the dag and hotkeys commands, flags must be created first
+func initDAGFlags() {
+	flags.StringVar(&chainId, flagChainId, "", "specify the chain id, the one of the sdk config if it is empty")
+	flags.Uint64Var(&height, flagHeight, 0, "specify the block height")
+	flags.StringVar(&dagFormat, flagFormat, rpcserver.DAGFormatJson, fmt.Sprintf(
+		"specify the output format, %s or %s", rpcserver.DAGFormatJson, rpcserver.DAGFormatDot))
+	flags.Uint64Var(&startHeight, flagStartHeight, 0, "specify the start block height")
+	flags.Uint64Var(&endHeight, flagEndHeight, 0, "specify the end block height, 0 for the last block")
+	flags.IntVar(&hotKeysTop, flagTop, rpcserver.DefaultHotKeysTop, "specify the number of the hottest keys to show")
+}
+
+func newDAGCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dag",
+		Short: "analyze the DAG of a block",
+		Long: "analyze the DAG of a block rebuilt from the stored read-write sets, the conflicts between the txs, " +
+			"the critical path, the parallelism width and the conflict rate, as json or graphviz dot. The user tls " +
+			"cert of the sdk config must be of a node admin, or of an admin of the chain in the org of the node",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if dagFormat != rpcserver.DAGFormatJson && dagFormat != rpcserver.DAGFormatDot {
+				return fmt.Errorf("invalid format %s, %s or %s is expected", dagFormat, rpcserver.DAGFormatJson,
+					rpcserver.DAGFormatDot)
+			}
+			return invokeChainAdmin(func(ctx context.Context, client rpcserver.RpcChainAdminClient) error {
+				analysis, err := client.AnalyzeBlockDAG(ctx, &rpcserver.AnalyzeBlockDAGRequest{
+					ChainId: chainId,
+					Height:  height,
+					Format:  dagFormat,
+				})
+				if err != nil {
+					return err
+				}
+				if dagFormat == rpcserver.DAGFormatDot {
+					fmt.Print(analysis.Dot)
+					return nil
+				}
+				util.PrintPrettyJson(analysis)
+				return nil
+			})
+		},
+	}
+
+	util.AttachAndRequiredFlags(cmd, flags, []string{flagSdkConfPath, flagHeight})
+	util.AttachFlags(cmd, flags, []string{flagChainId, flagFormat})
+	return cmd
+}
+
+func newHotKeysCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hotkeys",
+		Short: "show the hot keys of blocks",
+		Long: "aggregate the reads, writes and conflicts of the keys accessed by the txs of a range of blocks, " +
+			"and show the keys with the most conflicts. The user tls cert of the sdk config must be of a node " +
+			"admin, or of an admin of the chain in the org of the node",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return invokeChainAdmin(func(ctx context.Context, client rpcserver.RpcChainAdminClient) error {
+				report, err := client.GetHotKeys(ctx, &rpcserver.GetHotKeysRequest{
+					ChainId:     chainId,
+					StartHeight: startHeight,
+					EndHeight:   endHeight,
+					Top:         uint64(hotKeysTop),
+				})
+				if err != nil {
+					return err
+				}
+				util.PrintPrettyJson(report)
+				return nil
+			})
+		},
+	}
+
+	util.AttachAndRequiredFlags(cmd, flags, []string{flagSdkConfPath, flagStartHeight})
+	util.AttachFlags(cmd, flags, []string{flagChainId, flagEndHeight, flagTop})
+	return cmd
+}
+
+// sdkNodeConfig the connection settings of a node in the sdk config
+type sdkNodeConfig struct {
+	NodeAddr       string   `mapstructure:"node_addr"`
+	EnableTLS      bool     `mapstructure:"enable_tls"`
+	TrustRootPaths []string `mapstructure:"trust_root_paths"`
+	TLSHostName    string   `mapstructure:"tls_host_name"`
+}
+
+// sdkClientConfig the chain_client of the sdk config, the chain admin service is called with the user tls cert
+// and the first node
+type sdkClientConfig struct {
+	ChainId         string           `mapstructure:"chain_id"`
+	UserKeyFilePath string           `mapstructure:"user_key_file_path"`
+	UserCrtFilePath string           `mapstructure:"user_crt_file_path"`
+	Nodes           []*sdkNodeConfig `mapstructure:"nodes"`
+}
+
+// loadSdkClientConfig read the chain_client of the sdk config file
+func loadSdkClientConfig(path string) (*sdkClientConfig, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("read the sdk config %s failed, %s", path, err)
+	}
+	config := &sdkClientConfig{}
+	if err := v.UnmarshalKey("chain_client", config); err != nil {
+		return nil, fmt.Errorf("parse the sdk config %s failed, %s", path, err)
+	}
+	if len(config.Nodes) == 0 {
+		return nil, errors.New("no nodes in the sdk config")
+	}
+	return config, nil
+}
+
+// invokeChainAdmin call the chain admin service of the node of the sdk config by fn, the chain id is that of the
+// sdk config if the flag is not given
+func invokeChainAdmin(fn func(ctx context.Context, client rpcserver.RpcChainAdminClient) error) error {
+	config, err := loadSdkClientConfig(sdkConfPath)
+	if err != nil {
+		return err
+	}
+	if chainId == "" {
+		chainId = config.ChainId
+	}
+	conn, err := dialNode(config)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	return fn(ctx, rpcserver.NewRpcChainAdminClient(conn))
+}
+
+// dialNode connect to the first node of the sdk config, with the user tls cert if tls is enabled
+func dialNode(config *sdkClientConfig) (*grpc.ClientConn, error) {
+	node := config.Nodes[0]
+	if !node.EnableTLS {
+		return grpc.Dial(node.NodeAddr, grpc.WithInsecure())
+	}
+	tlsClient := ca.CAClient{
+		ServerName: node.TLSHostName,
+		CaPaths:    node.TrustRootPaths,
+		CertFile:   config.UserCrtFilePath,
+		KeyFile:    config.UserKeyFilePath,
+	}
+	c, err := tlsClient.GetCredentialsByCA()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.Dial(node.NodeAddr, grpc.WithTransportCredentials(*c))
+}
@@ -25,6 +25,7 @@ func init() {
 	flags = &pflag.FlagSet{}
 
 	flags.StringVar(&sdkConfPath, flagSdkConfPath, "", "specify sdk config path")
+	initDAGFlags()
 }
 
 // NewNodeCMD new command for node
@@ -36,6 +37,8 @@ func NewNodeCMD() *cobra.Command {
 	}
 
 	cmd.AddCommand(newSyncStateCMD())
+	cmd.AddCommand(newDAGCMD())
+	cmd.AddCommand(newHotKeysCMD())
 	return cmd
 }
 
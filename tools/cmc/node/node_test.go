@@ -0,0 +1,51 @@
+/*
+Copyright (C) BABEC. All rights reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNodeCMD(t *testing.T) {
+	cmd := NewNodeCMD()
+	for _, name := range []string{"syncstate", "dag", "hotkeys"} {
+		sub, _, err := cmd.Find([]string{name})
+		require.NoError(t, err)
+		require.Equal(t, name, sub.Name())
+	}
+
+	dagCmd, _, err := cmd.Find([]string{"dag"})
+	require.NoError(t, err)
+	for _, name := range []string{flagSdkConfPath, flagChainId, flagHeight, flagFormat} {
+		require.NotNil(t, dagCmd.Flags().Lookup(name), name)
+	}
+	hotKeysCmd, _, err := cmd.Find([]string{"hotkeys"})
+	require.NoError(t, err)
+	for _, name := range []string{flagSdkConfPath, flagChainId, flagStartHeight, flagEndHeight, flagTop} {
+		require.NotNil(t, hotKeysCmd.Flags().Lookup(name), name)
+	}
+}
+
+func TestLoadSdkClientConfig(t *testing.T) {
+	config, err := loadSdkClientConfig("../testdata/sdk_config.yml")
+	require.NoError(t, err)
+	require.Equal(t, "chain1", config.ChainId)
+	require.Equal(t, "./testdata/crypto-config/wx-org1.chainmaker.org/user/client1/client1.tls.crt",
+		config.UserCrtFilePath)
+	require.Len(t, config.Nodes, 1)
+	require.Equal(t, &sdkNodeConfig{
+		NodeAddr:       "127.0.0.1:12301",
+		EnableTLS:      true,
+		TrustRootPaths: []string{"./testdata/crypto-config/wx-org1.chainmaker.org/ca"},
+		TLSHostName:    "chainmaker.org",
+	}, config.Nodes[0])
+
+	_, err = loadSdkClientConfig("../testdata/not_exist.yml")
+	require.Error(t, err)
+}